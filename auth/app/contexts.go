@@ -18,82 +18,52 @@ import (
 	"strconv"
 )
 
-// AttachToAccountAmazonContext provides the amazon attach-to-account action context.
-type AttachToAccountAmazonContext struct {
+// AddPostBookmarkContext provides the bookmark addPost action context.
+type AddPostBookmarkContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
+	Payload *BookmarkParams
 }
 
-// NewAttachToAccountAmazonContext parses the incoming request URL and body, performs validations and creates the
-// context used by the amazon controller attach-to-account action.
-func NewAttachToAccountAmazonContext(ctx context.Context, r *http.Request, service *goa.Service) (*AttachToAccountAmazonContext, error) {
+// NewAddPostBookmarkContext parses the incoming request URL and body, performs validations and creates the
+// context used by the bookmark controller addPost action.
+func NewAddPostBookmarkContext(ctx context.Context, r *http.Request, service *goa.Service) (*AddPostBookmarkContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := AttachToAccountAmazonContext{Context: ctx, ResponseData: resp, RequestData: req}
+	rctx := AddPostBookmarkContext{Context: ctx, ResponseData: resp, RequestData: req}
 	return &rctx, err
 }
 
 // OK sends a HTTP response with status code 200.
-func (ctx *AttachToAccountAmazonContext) OK(resp []byte) error {
+func (ctx *AddPostBookmarkContext) OK(r *Bookmark) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "text/plain")
+		ctx.ResponseData.Header().Set("Content-Type", "application/json")
 	}
-	ctx.ResponseData.WriteHeader(200)
-	_, err := ctx.ResponseData.Write(resp)
-	return err
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
 }
 
-// InternalServerError sends a HTTP response with status code 500.
-func (ctx *AttachToAccountAmazonContext) InternalServerError(r error) error {
+// BadRequest sends a HTTP response with status code 400.
+func (ctx *AddPostBookmarkContext) BadRequest(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
-}
-
-// DetachFromAccountAmazonContext provides the amazon detach-from-account action context.
-type DetachFromAccountAmazonContext struct {
-	context.Context
-	*goa.ResponseData
-	*goa.RequestData
-}
-
-// NewDetachFromAccountAmazonContext parses the incoming request URL and body, performs validations and creates the
-// context used by the amazon controller detach-from-account action.
-func NewDetachFromAccountAmazonContext(ctx context.Context, r *http.Request, service *goa.Service) (*DetachFromAccountAmazonContext, error) {
-	var err error
-	resp := goa.ContextResponse(ctx)
-	resp.Service = service
-	req := goa.ContextRequest(ctx)
-	req.Request = r
-	rctx := DetachFromAccountAmazonContext{Context: ctx, ResponseData: resp, RequestData: req}
-	return &rctx, err
-}
-
-// OK sends a HTTP response with status code 200.
-func (ctx *DetachFromAccountAmazonContext) OK(resp []byte) error {
-	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "OK")
-	}
-	ctx.ResponseData.WriteHeader(200)
-	_, err := ctx.ResponseData.Write(resp)
-	return err
+	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
 }
 
-// Forbidden sends a HTTP response with status code 403.
-func (ctx *DetachFromAccountAmazonContext) Forbidden(r error) error {
+// Unauthorized sends a HTTP response with status code 401.
+func (ctx *AddPostBookmarkContext) Unauthorized(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 403, r)
+	return ctx.ResponseData.Service.Send(ctx.Context, 401, r)
 }
 
 // NotFound sends a HTTP response with status code 404.
-func (ctx *DetachFromAccountAmazonContext) NotFound(r error) error {
+func (ctx *AddPostBookmarkContext) NotFound(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
@@ -101,110 +71,43 @@ func (ctx *DetachFromAccountAmazonContext) NotFound(r error) error {
 }
 
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *DetachFromAccountAmazonContext) InternalServerError(r error) error {
-	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
-	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
-}
-
-// LoginAmazonContext provides the amazon login action context.
-type LoginAmazonContext struct {
-	context.Context
-	*goa.ResponseData
-	*goa.RequestData
-	Token *uuid.UUID
-}
-
-// NewLoginAmazonContext parses the incoming request URL and body, performs validations and creates the
-// context used by the amazon controller login action.
-func NewLoginAmazonContext(ctx context.Context, r *http.Request, service *goa.Service) (*LoginAmazonContext, error) {
-	var err error
-	resp := goa.ContextResponse(ctx)
-	resp.Service = service
-	req := goa.ContextRequest(ctx)
-	req.Request = r
-	rctx := LoginAmazonContext{Context: ctx, ResponseData: resp, RequestData: req}
-	paramToken := req.Params["token"]
-	if len(paramToken) > 0 {
-		rawToken := paramToken[0]
-		if token, err2 := uuid.FromString(rawToken); err2 == nil {
-			tmp1 := &token
-			rctx.Token = tmp1
-		} else {
-			err = goa.MergeErrors(err, goa.InvalidParamTypeError("token", rawToken, "uuid"))
-		}
-	}
-	return &rctx, err
-}
-
-// OK sends a HTTP response with status code 200.
-func (ctx *LoginAmazonContext) OK(resp []byte) error {
-	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "text/plain")
-	}
-	ctx.ResponseData.WriteHeader(200)
-	_, err := ctx.ResponseData.Write(resp)
-	return err
-}
-
-// InternalServerError sends a HTTP response with status code 500.
-func (ctx *LoginAmazonContext) InternalServerError(r error) error {
+func (ctx *AddPostBookmarkContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// ReceiveAmazonContext provides the amazon receive action context.
-type ReceiveAmazonContext struct {
+// AddVideoBookmarkContext provides the bookmark addVideo action context.
+type AddVideoBookmarkContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
-	Code  string
-	State uuid.UUID
+	Payload *BookmarkParams
 }
 
-// NewReceiveAmazonContext parses the incoming request URL and body, performs validations and creates the
-// context used by the amazon controller receive action.
-func NewReceiveAmazonContext(ctx context.Context, r *http.Request, service *goa.Service) (*ReceiveAmazonContext, error) {
+// NewAddVideoBookmarkContext parses the incoming request URL and body, performs validations and creates the
+// context used by the bookmark controller addVideo action.
+func NewAddVideoBookmarkContext(ctx context.Context, r *http.Request, service *goa.Service) (*AddVideoBookmarkContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := ReceiveAmazonContext{Context: ctx, ResponseData: resp, RequestData: req}
-	paramCode := req.Params["code"]
-	if len(paramCode) == 0 {
-		err = goa.MergeErrors(err, goa.MissingParamError("code"))
-	} else {
-		rawCode := paramCode[0]
-		rctx.Code = rawCode
-	}
-	paramState := req.Params["state"]
-	if len(paramState) == 0 {
-		err = goa.MergeErrors(err, goa.MissingParamError("state"))
-	} else {
-		rawState := paramState[0]
-		if state, err2 := uuid.FromString(rawState); err2 == nil {
-			rctx.State = state
-		} else {
-			err = goa.MergeErrors(err, goa.InvalidParamTypeError("state", rawState, "uuid"))
-		}
-	}
+	rctx := AddVideoBookmarkContext{Context: ctx, ResponseData: resp, RequestData: req}
 	return &rctx, err
 }
 
 // OK sends a HTTP response with status code 200.
-func (ctx *ReceiveAmazonContext) OK(r interface{}) error {
+func (ctx *AddVideoBookmarkContext) OK(r *Bookmark) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "text/plain")
+		ctx.ResponseData.Header().Set("Content-Type", "application/json")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
 }
 
 // BadRequest sends a HTTP response with status code 400.
-func (ctx *ReceiveAmazonContext) BadRequest(r error) error {
+func (ctx *AddVideoBookmarkContext) BadRequest(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
@@ -212,83 +115,74 @@ func (ctx *ReceiveAmazonContext) BadRequest(r error) error {
 }
 
 // Unauthorized sends a HTTP response with status code 401.
-func (ctx *ReceiveAmazonContext) Unauthorized(r error) error {
+func (ctx *AddVideoBookmarkContext) Unauthorized(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 401, r)
 }
 
+// NotFound sends a HTTP response with status code 404.
+func (ctx *AddVideoBookmarkContext) NotFound(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 404, r)
+}
+
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *ReceiveAmazonContext) InternalServerError(r error) error {
+func (ctx *AddVideoBookmarkContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// RegisterAmazonContext provides the amazon register action context.
-type RegisterAmazonContext struct {
+// GetBookmarksBookmarkContext provides the bookmark getBookmarks action context.
+type GetBookmarksBookmarkContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
-	Payload *AmazonRegisterParams
 }
 
-// NewRegisterAmazonContext parses the incoming request URL and body, performs validations and creates the
-// context used by the amazon controller register action.
-func NewRegisterAmazonContext(ctx context.Context, r *http.Request, service *goa.Service) (*RegisterAmazonContext, error) {
+// NewGetBookmarksBookmarkContext parses the incoming request URL and body, performs validations and creates the
+// context used by the bookmark controller getBookmarks action.
+func NewGetBookmarksBookmarkContext(ctx context.Context, r *http.Request, service *goa.Service) (*GetBookmarksBookmarkContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := RegisterAmazonContext{Context: ctx, ResponseData: resp, RequestData: req}
+	rctx := GetBookmarksBookmarkContext{Context: ctx, ResponseData: resp, RequestData: req}
 	return &rctx, err
 }
 
-// OKAdmin sends a HTTP response with status code 200.
-func (ctx *RegisterAmazonContext) OKAdmin(r *UserAdmin) error {
-	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/json")
-	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
-}
-
 // OK sends a HTTP response with status code 200.
-func (ctx *RegisterAmazonContext) OK(r *User) error {
-	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/json")
-	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
-}
-
-// OKOwner sends a HTTP response with status code 200.
-func (ctx *RegisterAmazonContext) OKOwner(r *UserOwner) error {
+func (ctx *GetBookmarksBookmarkContext) OK(r []*Bookmark) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/json")
+		ctx.ResponseData.Header().Set("Content-Type", "bookmark")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
 }
 
 // BadRequest sends a HTTP response with status code 400.
-func (ctx *RegisterAmazonContext) BadRequest(r error) error {
+func (ctx *GetBookmarksBookmarkContext) BadRequest(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
 }
 
-// Forbidden sends a HTTP response with status code 403.
-func (ctx *RegisterAmazonContext) Forbidden(r error) error {
+// Unauthorized sends a HTTP response with status code 401.
+func (ctx *GetBookmarksBookmarkContext) Unauthorized(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 403, r)
+	return ctx.ResponseData.Service.Send(ctx.Context, 401, r)
 }
 
 // NotFound sends a HTTP response with status code 404.
-func (ctx *RegisterAmazonContext) NotFound(r error) error {
+func (ctx *GetBookmarksBookmarkContext) NotFound(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
@@ -296,80 +190,101 @@ func (ctx *RegisterAmazonContext) NotFound(r error) error {
 }
 
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *RegisterAmazonContext) InternalServerError(r error) error {
+func (ctx *GetBookmarksBookmarkContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// RegisterURLAmazonContext provides the amazon register-url action context.
-type RegisterURLAmazonContext struct {
+// GetPostBookmarksBookmarkContext provides the bookmark getPostBookmarks action context.
+type GetPostBookmarksBookmarkContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
 }
 
-// NewRegisterURLAmazonContext parses the incoming request URL and body, performs validations and creates the
-// context used by the amazon controller register-url action.
-func NewRegisterURLAmazonContext(ctx context.Context, r *http.Request, service *goa.Service) (*RegisterURLAmazonContext, error) {
+// NewGetPostBookmarksBookmarkContext parses the incoming request URL and body, performs validations and creates the
+// context used by the bookmark controller getPostBookmarks action.
+func NewGetPostBookmarksBookmarkContext(ctx context.Context, r *http.Request, service *goa.Service) (*GetPostBookmarksBookmarkContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := RegisterURLAmazonContext{Context: ctx, ResponseData: resp, RequestData: req}
+	rctx := GetPostBookmarksBookmarkContext{Context: ctx, ResponseData: resp, RequestData: req}
 	return &rctx, err
 }
 
 // OK sends a HTTP response with status code 200.
-func (ctx *RegisterURLAmazonContext) OK(resp []byte) error {
+func (ctx *GetPostBookmarksBookmarkContext) OK(r []*Bookmark) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "text/plain")
+		ctx.ResponseData.Header().Set("Content-Type", "bookmark")
 	}
-	ctx.ResponseData.WriteHeader(200)
-	_, err := ctx.ResponseData.Write(resp)
-	return err
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// BadRequest sends a HTTP response with status code 400.
+func (ctx *GetPostBookmarksBookmarkContext) BadRequest(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
+}
+
+// Unauthorized sends a HTTP response with status code 401.
+func (ctx *GetPostBookmarksBookmarkContext) Unauthorized(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 401, r)
+}
+
+// NotFound sends a HTTP response with status code 404.
+func (ctx *GetPostBookmarksBookmarkContext) NotFound(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 404, r)
 }
 
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *RegisterURLAmazonContext) InternalServerError(r error) error {
+func (ctx *GetPostBookmarksBookmarkContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// AddPostBookmarkContext provides the bookmark addPost action context.
-type AddPostBookmarkContext struct {
+// GetVideoBookmarksBookmarkContext provides the bookmark getVideoBookmarks action context.
+type GetVideoBookmarksBookmarkContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
-	Payload *BookmarkParams
 }
 
-// NewAddPostBookmarkContext parses the incoming request URL and body, performs validations and creates the
-// context used by the bookmark controller addPost action.
-func NewAddPostBookmarkContext(ctx context.Context, r *http.Request, service *goa.Service) (*AddPostBookmarkContext, error) {
+// NewGetVideoBookmarksBookmarkContext parses the incoming request URL and body, performs validations and creates the
+// context used by the bookmark controller getVideoBookmarks action.
+func NewGetVideoBookmarksBookmarkContext(ctx context.Context, r *http.Request, service *goa.Service) (*GetVideoBookmarksBookmarkContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := AddPostBookmarkContext{Context: ctx, ResponseData: resp, RequestData: req}
+	rctx := GetVideoBookmarksBookmarkContext{Context: ctx, ResponseData: resp, RequestData: req}
 	return &rctx, err
 }
 
 // OK sends a HTTP response with status code 200.
-func (ctx *AddPostBookmarkContext) OK(r *Bookmark) error {
+func (ctx *GetVideoBookmarksBookmarkContext) OK(r []*Bookmark) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/json")
+		ctx.ResponseData.Header().Set("Content-Type", "bookmark")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
 }
 
 // BadRequest sends a HTTP response with status code 400.
-func (ctx *AddPostBookmarkContext) BadRequest(r error) error {
+func (ctx *GetVideoBookmarksBookmarkContext) BadRequest(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
@@ -377,7 +292,7 @@ func (ctx *AddPostBookmarkContext) BadRequest(r error) error {
 }
 
 // Unauthorized sends a HTTP response with status code 401.
-func (ctx *AddPostBookmarkContext) Unauthorized(r error) error {
+func (ctx *GetVideoBookmarksBookmarkContext) Unauthorized(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
@@ -385,7 +300,7 @@ func (ctx *AddPostBookmarkContext) Unauthorized(r error) error {
 }
 
 // NotFound sends a HTTP response with status code 404.
-func (ctx *AddPostBookmarkContext) NotFound(r error) error {
+func (ctx *GetVideoBookmarksBookmarkContext) NotFound(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
@@ -393,35 +308,40 @@ func (ctx *AddPostBookmarkContext) NotFound(r error) error {
 }
 
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *AddPostBookmarkContext) InternalServerError(r error) error {
+func (ctx *GetVideoBookmarksBookmarkContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// AddVideoBookmarkContext provides the bookmark addVideo action context.
-type AddVideoBookmarkContext struct {
+// RemoveFromBookmarkBookmarkContext provides the bookmark removeFromBookmark action context.
+type RemoveFromBookmarkBookmarkContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
-	Payload *BookmarkParams
+	ID *string
 }
 
-// NewAddVideoBookmarkContext parses the incoming request URL and body, performs validations and creates the
-// context used by the bookmark controller addVideo action.
-func NewAddVideoBookmarkContext(ctx context.Context, r *http.Request, service *goa.Service) (*AddVideoBookmarkContext, error) {
+// NewRemoveFromBookmarkBookmarkContext parses the incoming request URL and body, performs validations and creates the
+// context used by the bookmark controller removeFromBookmark action.
+func NewRemoveFromBookmarkBookmarkContext(ctx context.Context, r *http.Request, service *goa.Service) (*RemoveFromBookmarkBookmarkContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := AddVideoBookmarkContext{Context: ctx, ResponseData: resp, RequestData: req}
+	rctx := RemoveFromBookmarkBookmarkContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramID := req.Params["id"]
+	if len(paramID) > 0 {
+		rawID := paramID[0]
+		rctx.ID = &rawID
+	}
 	return &rctx, err
 }
 
 // OK sends a HTTP response with status code 200.
-func (ctx *AddVideoBookmarkContext) OK(r *Bookmark) error {
+func (ctx *RemoveFromBookmarkBookmarkContext) OK(r *Bookmark) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/json")
 	}
@@ -429,7 +349,7 @@ func (ctx *AddVideoBookmarkContext) OK(r *Bookmark) error {
 }
 
 // BadRequest sends a HTTP response with status code 400.
-func (ctx *AddVideoBookmarkContext) BadRequest(r error) error {
+func (ctx *RemoveFromBookmarkBookmarkContext) BadRequest(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
@@ -437,7 +357,7 @@ func (ctx *AddVideoBookmarkContext) BadRequest(r error) error {
 }
 
 // Unauthorized sends a HTTP response with status code 401.
-func (ctx *AddVideoBookmarkContext) Unauthorized(r error) error {
+func (ctx *RemoveFromBookmarkBookmarkContext) Unauthorized(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
@@ -445,7 +365,7 @@ func (ctx *AddVideoBookmarkContext) Unauthorized(r error) error {
 }
 
 // NotFound sends a HTTP response with status code 404.
-func (ctx *AddVideoBookmarkContext) NotFound(r error) error {
+func (ctx *RemoveFromBookmarkBookmarkContext) NotFound(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
@@ -453,117 +373,112 @@ func (ctx *AddVideoBookmarkContext) NotFound(r error) error {
 }
 
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *AddVideoBookmarkContext) InternalServerError(r error) error {
+func (ctx *RemoveFromBookmarkBookmarkContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// GetBookmarksBookmarkContext provides the bookmark getBookmarks action context.
-type GetBookmarksBookmarkContext struct {
+// CreateClientContext provides the client create action context.
+type CreateClientContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
+	Payload *OauthClientParams
 }
 
-// NewGetBookmarksBookmarkContext parses the incoming request URL and body, performs validations and creates the
-// context used by the bookmark controller getBookmarks action.
-func NewGetBookmarksBookmarkContext(ctx context.Context, r *http.Request, service *goa.Service) (*GetBookmarksBookmarkContext, error) {
+// NewCreateClientContext parses the incoming request URL and body, performs validations and creates the
+// context used by the client controller create action.
+func NewCreateClientContext(ctx context.Context, r *http.Request, service *goa.Service) (*CreateClientContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := GetBookmarksBookmarkContext{Context: ctx, ResponseData: resp, RequestData: req}
+	rctx := CreateClientContext{Context: ctx, ResponseData: resp, RequestData: req}
 	return &rctx, err
 }
 
-// OK sends a HTTP response with status code 200.
-func (ctx *GetBookmarksBookmarkContext) OK(r []*Bookmark) error {
+// Created sends a HTTP response with status code 201.
+func (ctx *CreateClientContext) Created(r *OauthClient) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "bookmark")
+		ctx.ResponseData.Header().Set("Content-Type", "application/json")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+	return ctx.ResponseData.Service.Send(ctx.Context, 201, r)
 }
 
 // BadRequest sends a HTTP response with status code 400.
-func (ctx *GetBookmarksBookmarkContext) BadRequest(r error) error {
+func (ctx *CreateClientContext) BadRequest(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
 }
 
-// Unauthorized sends a HTTP response with status code 401.
-func (ctx *GetBookmarksBookmarkContext) Unauthorized(r error) error {
-	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
-	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 401, r)
-}
-
-// NotFound sends a HTTP response with status code 404.
-func (ctx *GetBookmarksBookmarkContext) NotFound(r error) error {
+// Forbidden sends a HTTP response with status code 403.
+func (ctx *CreateClientContext) Forbidden(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 404, r)
+	return ctx.ResponseData.Service.Send(ctx.Context, 403, r)
 }
 
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *GetBookmarksBookmarkContext) InternalServerError(r error) error {
+func (ctx *CreateClientContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// GetPostBookmarksBookmarkContext provides the bookmark getPostBookmarks action context.
-type GetPostBookmarksBookmarkContext struct {
+// DeleteClientContext provides the client delete action context.
+type DeleteClientContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
+	ClientID string
 }
 
-// NewGetPostBookmarksBookmarkContext parses the incoming request URL and body, performs validations and creates the
-// context used by the bookmark controller getPostBookmarks action.
-func NewGetPostBookmarksBookmarkContext(ctx context.Context, r *http.Request, service *goa.Service) (*GetPostBookmarksBookmarkContext, error) {
+// NewDeleteClientContext parses the incoming request URL and body, performs validations and creates the
+// context used by the client controller delete action.
+func NewDeleteClientContext(ctx context.Context, r *http.Request, service *goa.Service) (*DeleteClientContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := GetPostBookmarksBookmarkContext{Context: ctx, ResponseData: resp, RequestData: req}
+	rctx := DeleteClientContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramClientID := req.Params["clientID"]
+	if len(paramClientID) == 0 {
+		err = goa.MergeErrors(err, goa.MissingParamError("clientID"))
+	} else {
+		rawClientID := paramClientID[0]
+		rctx.ClientID = rawClientID
+	}
 	return &rctx, err
 }
 
 // OK sends a HTTP response with status code 200.
-func (ctx *GetPostBookmarksBookmarkContext) OK(r []*Bookmark) error {
-	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "bookmark")
-	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
-}
-
-// BadRequest sends a HTTP response with status code 400.
-func (ctx *GetPostBookmarksBookmarkContext) BadRequest(r error) error {
+func (ctx *DeleteClientContext) OK(resp []byte) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+		ctx.ResponseData.Header().Set("Content-Type", "OK")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
+	ctx.ResponseData.WriteHeader(200)
+	_, err := ctx.ResponseData.Write(resp)
+	return err
 }
 
-// Unauthorized sends a HTTP response with status code 401.
-func (ctx *GetPostBookmarksBookmarkContext) Unauthorized(r error) error {
+// Forbidden sends a HTTP response with status code 403.
+func (ctx *DeleteClientContext) Forbidden(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 401, r)
+	return ctx.ResponseData.Service.Send(ctx.Context, 403, r)
 }
 
 // NotFound sends a HTTP response with status code 404.
-func (ctx *GetPostBookmarksBookmarkContext) NotFound(r error) error {
+func (ctx *DeleteClientContext) NotFound(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
@@ -571,58 +486,58 @@ func (ctx *GetPostBookmarksBookmarkContext) NotFound(r error) error {
 }
 
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *GetPostBookmarksBookmarkContext) InternalServerError(r error) error {
+func (ctx *DeleteClientContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// GetVideoBookmarksBookmarkContext provides the bookmark getVideoBookmarks action context.
-type GetVideoBookmarksBookmarkContext struct {
+// GetClientContext provides the client get action context.
+type GetClientContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
+	ClientID string
 }
 
-// NewGetVideoBookmarksBookmarkContext parses the incoming request URL and body, performs validations and creates the
-// context used by the bookmark controller getVideoBookmarks action.
-func NewGetVideoBookmarksBookmarkContext(ctx context.Context, r *http.Request, service *goa.Service) (*GetVideoBookmarksBookmarkContext, error) {
+// NewGetClientContext parses the incoming request URL and body, performs validations and creates the
+// context used by the client controller get action.
+func NewGetClientContext(ctx context.Context, r *http.Request, service *goa.Service) (*GetClientContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := GetVideoBookmarksBookmarkContext{Context: ctx, ResponseData: resp, RequestData: req}
+	rctx := GetClientContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramClientID := req.Params["clientID"]
+	if len(paramClientID) == 0 {
+		err = goa.MergeErrors(err, goa.MissingParamError("clientID"))
+	} else {
+		rawClientID := paramClientID[0]
+		rctx.ClientID = rawClientID
+	}
 	return &rctx, err
 }
 
 // OK sends a HTTP response with status code 200.
-func (ctx *GetVideoBookmarksBookmarkContext) OK(r []*Bookmark) error {
+func (ctx *GetClientContext) OK(r *OauthClient) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "bookmark")
+		ctx.ResponseData.Header().Set("Content-Type", "application/json")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
 }
 
-// BadRequest sends a HTTP response with status code 400.
-func (ctx *GetVideoBookmarksBookmarkContext) BadRequest(r error) error {
-	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
-	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
-}
-
-// Unauthorized sends a HTTP response with status code 401.
-func (ctx *GetVideoBookmarksBookmarkContext) Unauthorized(r error) error {
+// Forbidden sends a HTTP response with status code 403.
+func (ctx *GetClientContext) Forbidden(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 401, r)
+	return ctx.ResponseData.Service.Send(ctx.Context, 403, r)
 }
 
 // NotFound sends a HTTP response with status code 404.
-func (ctx *GetVideoBookmarksBookmarkContext) NotFound(r error) error {
+func (ctx *GetClientContext) NotFound(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
@@ -630,146 +545,172 @@ func (ctx *GetVideoBookmarksBookmarkContext) NotFound(r error) error {
 }
 
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *GetVideoBookmarksBookmarkContext) InternalServerError(r error) error {
+func (ctx *GetClientContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// RemoveFromBookmarkBookmarkContext provides the bookmark removeFromBookmark action context.
-type RemoveFromBookmarkBookmarkContext struct {
+// ListClientContext provides the client list action context.
+type ListClientContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
-	ID *string
 }
 
-// NewRemoveFromBookmarkBookmarkContext parses the incoming request URL and body, performs validations and creates the
-// context used by the bookmark controller removeFromBookmark action.
-func NewRemoveFromBookmarkBookmarkContext(ctx context.Context, r *http.Request, service *goa.Service) (*RemoveFromBookmarkBookmarkContext, error) {
+// NewListClientContext parses the incoming request URL and body, performs validations and creates the
+// context used by the client controller list action.
+func NewListClientContext(ctx context.Context, r *http.Request, service *goa.Service) (*ListClientContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := RemoveFromBookmarkBookmarkContext{Context: ctx, ResponseData: resp, RequestData: req}
-	paramID := req.Params["id"]
-	if len(paramID) > 0 {
-		rawID := paramID[0]
-		rctx.ID = &rawID
-	}
+	rctx := ListClientContext{Context: ctx, ResponseData: resp, RequestData: req}
 	return &rctx, err
 }
 
 // OK sends a HTTP response with status code 200.
-func (ctx *RemoveFromBookmarkBookmarkContext) OK(r *Bookmark) error {
+func (ctx *ListClientContext) OK(r OauthClientCollection) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/json")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
-}
-
-// BadRequest sends a HTTP response with status code 400.
-func (ctx *RemoveFromBookmarkBookmarkContext) BadRequest(r error) error {
-	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
-	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
-}
-
-// Unauthorized sends a HTTP response with status code 401.
-func (ctx *RemoveFromBookmarkBookmarkContext) Unauthorized(r error) error {
-	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	if r == nil {
+		r = OauthClientCollection{}
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 401, r)
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
 }
 
-// NotFound sends a HTTP response with status code 404.
-func (ctx *RemoveFromBookmarkBookmarkContext) NotFound(r error) error {
+// Forbidden sends a HTTP response with status code 403.
+func (ctx *ListClientContext) Forbidden(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 404, r)
+	return ctx.ResponseData.Service.Send(ctx.Context, 403, r)
 }
 
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *RemoveFromBookmarkBookmarkContext) InternalServerError(r error) error {
+func (ctx *ListClientContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// AttachToAccountFacebookContext provides the facebook attach-to-account action context.
-type AttachToAccountFacebookContext struct {
+// RotateSecretClientContext provides the client rotate-secret action context.
+type RotateSecretClientContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
+	ClientID string
 }
 
-// NewAttachToAccountFacebookContext parses the incoming request URL and body, performs validations and creates the
-// context used by the facebook controller attach-to-account action.
-func NewAttachToAccountFacebookContext(ctx context.Context, r *http.Request, service *goa.Service) (*AttachToAccountFacebookContext, error) {
+// NewRotateSecretClientContext parses the incoming request URL and body, performs validations and creates the
+// context used by the client controller rotate-secret action.
+func NewRotateSecretClientContext(ctx context.Context, r *http.Request, service *goa.Service) (*RotateSecretClientContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := AttachToAccountFacebookContext{Context: ctx, ResponseData: resp, RequestData: req}
+	rctx := RotateSecretClientContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramClientID := req.Params["clientID"]
+	if len(paramClientID) == 0 {
+		err = goa.MergeErrors(err, goa.MissingParamError("clientID"))
+	} else {
+		rawClientID := paramClientID[0]
+		rctx.ClientID = rawClientID
+	}
 	return &rctx, err
 }
 
 // OK sends a HTTP response with status code 200.
-func (ctx *AttachToAccountFacebookContext) OK(resp []byte) error {
+func (ctx *RotateSecretClientContext) OK(r *OauthClient) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "text/plain")
+		ctx.ResponseData.Header().Set("Content-Type", "application/json")
 	}
-	ctx.ResponseData.WriteHeader(200)
-	_, err := ctx.ResponseData.Write(resp)
-	return err
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// BadRequest sends a HTTP response with status code 400.
+func (ctx *RotateSecretClientContext) BadRequest(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
+}
+
+// Forbidden sends a HTTP response with status code 403.
+func (ctx *RotateSecretClientContext) Forbidden(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 403, r)
+}
+
+// NotFound sends a HTTP response with status code 404.
+func (ctx *RotateSecretClientContext) NotFound(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 404, r)
 }
 
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *AttachToAccountFacebookContext) InternalServerError(r error) error {
+func (ctx *RotateSecretClientContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// DetachFromAccountFacebookContext provides the facebook detach-from-account action context.
-type DetachFromAccountFacebookContext struct {
+// UpdateClientContext provides the client update action context.
+type UpdateClientContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
+	ClientID string
+	Payload  *OauthClientParams
 }
 
-// NewDetachFromAccountFacebookContext parses the incoming request URL and body, performs validations and creates the
-// context used by the facebook controller detach-from-account action.
-func NewDetachFromAccountFacebookContext(ctx context.Context, r *http.Request, service *goa.Service) (*DetachFromAccountFacebookContext, error) {
+// NewUpdateClientContext parses the incoming request URL and body, performs validations and creates the
+// context used by the client controller update action.
+func NewUpdateClientContext(ctx context.Context, r *http.Request, service *goa.Service) (*UpdateClientContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := DetachFromAccountFacebookContext{Context: ctx, ResponseData: resp, RequestData: req}
+	rctx := UpdateClientContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramClientID := req.Params["clientID"]
+	if len(paramClientID) == 0 {
+		err = goa.MergeErrors(err, goa.MissingParamError("clientID"))
+	} else {
+		rawClientID := paramClientID[0]
+		rctx.ClientID = rawClientID
+	}
 	return &rctx, err
 }
 
 // OK sends a HTTP response with status code 200.
-func (ctx *DetachFromAccountFacebookContext) OK(resp []byte) error {
+func (ctx *UpdateClientContext) OK(r *OauthClient) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "OK")
+		ctx.ResponseData.Header().Set("Content-Type", "application/json")
 	}
-	ctx.ResponseData.WriteHeader(200)
-	_, err := ctx.ResponseData.Write(resp)
-	return err
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// BadRequest sends a HTTP response with status code 400.
+func (ctx *UpdateClientContext) BadRequest(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
 }
 
 // Forbidden sends a HTTP response with status code 403.
-func (ctx *DetachFromAccountFacebookContext) Forbidden(r error) error {
+func (ctx *UpdateClientContext) Forbidden(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
@@ -777,7 +718,7 @@ func (ctx *DetachFromAccountFacebookContext) Forbidden(r error) error {
 }
 
 // NotFound sends a HTTP response with status code 404.
-func (ctx *DetachFromAccountFacebookContext) NotFound(r error) error {
+func (ctx *UpdateClientContext) NotFound(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
@@ -785,194 +726,169 @@ func (ctx *DetachFromAccountFacebookContext) NotFound(r error) error {
 }
 
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *DetachFromAccountFacebookContext) InternalServerError(r error) error {
+func (ctx *UpdateClientContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// LoginFacebookContext provides the facebook login action context.
-type LoginFacebookContext struct {
+// AddSubscriberNewsletterContext provides the newsletter add-subscriber action context.
+type AddSubscriberNewsletterContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
-	Token *uuid.UUID
+	Payload *NewsletterParam
 }
 
-// NewLoginFacebookContext parses the incoming request URL and body, performs validations and creates the
-// context used by the facebook controller login action.
-func NewLoginFacebookContext(ctx context.Context, r *http.Request, service *goa.Service) (*LoginFacebookContext, error) {
+// NewAddSubscriberNewsletterContext parses the incoming request URL and body, performs validations and creates the
+// context used by the newsletter controller add-subscriber action.
+func NewAddSubscriberNewsletterContext(ctx context.Context, r *http.Request, service *goa.Service) (*AddSubscriberNewsletterContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := LoginFacebookContext{Context: ctx, ResponseData: resp, RequestData: req}
-	paramToken := req.Params["token"]
-	if len(paramToken) > 0 {
-		rawToken := paramToken[0]
-		if token, err2 := uuid.FromString(rawToken); err2 == nil {
-			tmp3 := &token
-			rctx.Token = tmp3
-		} else {
-			err = goa.MergeErrors(err, goa.InvalidParamTypeError("token", rawToken, "uuid"))
-		}
-	}
+	rctx := AddSubscriberNewsletterContext{Context: ctx, ResponseData: resp, RequestData: req}
 	return &rctx, err
 }
 
 // OK sends a HTTP response with status code 200.
-func (ctx *LoginFacebookContext) OK(resp []byte) error {
+func (ctx *AddSubscriberNewsletterContext) OK(resp []byte) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "text/plain")
+		ctx.ResponseData.Header().Set("Content-Type", "OK")
 	}
 	ctx.ResponseData.WriteHeader(200)
 	_, err := ctx.ResponseData.Write(resp)
 	return err
 }
 
+// BadRequest sends a HTTP response with status code 400.
+func (ctx *AddSubscriberNewsletterContext) BadRequest(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
+}
+
+// NotFound sends a HTTP response with status code 404.
+func (ctx *AddSubscriberNewsletterContext) NotFound(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 404, r)
+}
+
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *LoginFacebookContext) InternalServerError(r error) error {
+func (ctx *AddSubscriberNewsletterContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// ReceiveFacebookContext provides the facebook receive action context.
-type ReceiveFacebookContext struct {
+// GetSubscriberByEmailNewsletterContext provides the newsletter get-subscriber-by-email action context.
+type GetSubscriberByEmailNewsletterContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
-	Code  string
-	State uuid.UUID
+	Email *string
 }
 
-// NewReceiveFacebookContext parses the incoming request URL and body, performs validations and creates the
-// context used by the facebook controller receive action.
-func NewReceiveFacebookContext(ctx context.Context, r *http.Request, service *goa.Service) (*ReceiveFacebookContext, error) {
+// NewGetSubscriberByEmailNewsletterContext parses the incoming request URL and body, performs validations and creates the
+// context used by the newsletter controller get-subscriber-by-email action.
+func NewGetSubscriberByEmailNewsletterContext(ctx context.Context, r *http.Request, service *goa.Service) (*GetSubscriberByEmailNewsletterContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := ReceiveFacebookContext{Context: ctx, ResponseData: resp, RequestData: req}
-	paramCode := req.Params["code"]
-	if len(paramCode) == 0 {
-		err = goa.MergeErrors(err, goa.MissingParamError("code"))
-	} else {
-		rawCode := paramCode[0]
-		rctx.Code = rawCode
-	}
-	paramState := req.Params["state"]
-	if len(paramState) == 0 {
-		err = goa.MergeErrors(err, goa.MissingParamError("state"))
-	} else {
-		rawState := paramState[0]
-		if state, err2 := uuid.FromString(rawState); err2 == nil {
-			rctx.State = state
-		} else {
-			err = goa.MergeErrors(err, goa.InvalidParamTypeError("state", rawState, "uuid"))
+	rctx := GetSubscriberByEmailNewsletterContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramEmail := req.Params["email"]
+	if len(paramEmail) > 0 {
+		rawEmail := paramEmail[0]
+		rctx.Email = &rawEmail
+		if rctx.Email != nil {
+			if err2 := goa.ValidateFormat(goa.FormatEmail, *rctx.Email); err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFormatError(`email`, *rctx.Email, goa.FormatEmail, err2))
+			}
 		}
 	}
 	return &rctx, err
 }
 
 // OK sends a HTTP response with status code 200.
-func (ctx *ReceiveFacebookContext) OK(r interface{}) error {
+func (ctx *GetSubscriberByEmailNewsletterContext) OK(r *NewsletterSubscriber) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "text/plain")
+		ctx.ResponseData.Header().Set("Content-Type", "application/json")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
 }
 
 // BadRequest sends a HTTP response with status code 400.
-func (ctx *ReceiveFacebookContext) BadRequest(r error) error {
+func (ctx *GetSubscriberByEmailNewsletterContext) BadRequest(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
 }
 
-// Unauthorized sends a HTTP response with status code 401.
-func (ctx *ReceiveFacebookContext) Unauthorized(r error) error {
+// NotFound sends a HTTP response with status code 404.
+func (ctx *GetSubscriberByEmailNewsletterContext) NotFound(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 401, r)
+	return ctx.ResponseData.Service.Send(ctx.Context, 404, r)
 }
 
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *ReceiveFacebookContext) InternalServerError(r error) error {
+func (ctx *GetSubscriberByEmailNewsletterContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// RegisterFacebookContext provides the facebook register action context.
-type RegisterFacebookContext struct {
+// GetSubscribersNewsletterContext provides the newsletter get-subscribers action context.
+type GetSubscribersNewsletterContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
-	Payload *FacebookRegisterParams
 }
 
-// NewRegisterFacebookContext parses the incoming request URL and body, performs validations and creates the
-// context used by the facebook controller register action.
-func NewRegisterFacebookContext(ctx context.Context, r *http.Request, service *goa.Service) (*RegisterFacebookContext, error) {
+// NewGetSubscribersNewsletterContext parses the incoming request URL and body, performs validations and creates the
+// context used by the newsletter controller get-subscribers action.
+func NewGetSubscribersNewsletterContext(ctx context.Context, r *http.Request, service *goa.Service) (*GetSubscribersNewsletterContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := RegisterFacebookContext{Context: ctx, ResponseData: resp, RequestData: req}
+	rctx := GetSubscribersNewsletterContext{Context: ctx, ResponseData: resp, RequestData: req}
 	return &rctx, err
 }
 
-// OKAdmin sends a HTTP response with status code 200.
-func (ctx *RegisterFacebookContext) OKAdmin(r *UserAdmin) error {
+// OK sends a HTTP response with status code 200.
+func (ctx *GetSubscribersNewsletterContext) OK(r NewsletterSubscriberCollection) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/json")
+		ctx.ResponseData.Header().Set("Content-Type", "newsletter-subscriber; type=collection")
+	}
+	if r == nil {
+		r = NewsletterSubscriberCollection{}
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
 }
 
-// OK sends a HTTP response with status code 200.
-func (ctx *RegisterFacebookContext) OK(r *User) error {
+// BadRequest sends a HTTP response with status code 400.
+func (ctx *GetSubscribersNewsletterContext) BadRequest(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/json")
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
-}
-
-// OKOwner sends a HTTP response with status code 200.
-func (ctx *RegisterFacebookContext) OKOwner(r *UserOwner) error {
-	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/json")
-	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
-}
-
-// BadRequest sends a HTTP response with status code 400.
-func (ctx *RegisterFacebookContext) BadRequest(r error) error {
-	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
-	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
-}
-
-// Forbidden sends a HTTP response with status code 403.
-func (ctx *RegisterFacebookContext) Forbidden(r error) error {
-	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
-	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 403, r)
+	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
 }
 
 // NotFound sends a HTTP response with status code 404.
-func (ctx *RegisterFacebookContext) NotFound(r error) error {
+func (ctx *GetSubscribersNewsletterContext) NotFound(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
@@ -980,108 +896,99 @@ func (ctx *RegisterFacebookContext) NotFound(r error) error {
 }
 
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *RegisterFacebookContext) InternalServerError(r error) error {
+func (ctx *GetSubscribersNewsletterContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// RegisterURLFacebookContext provides the facebook register-url action context.
-type RegisterURLFacebookContext struct {
+// RemoveSubscriberNewsletterContext provides the newsletter remove-subscriber action context.
+type RemoveSubscriberNewsletterContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
+	Email *string
 }
 
-// NewRegisterURLFacebookContext parses the incoming request URL and body, performs validations and creates the
-// context used by the facebook controller register-url action.
-func NewRegisterURLFacebookContext(ctx context.Context, r *http.Request, service *goa.Service) (*RegisterURLFacebookContext, error) {
+// NewRemoveSubscriberNewsletterContext parses the incoming request URL and body, performs validations and creates the
+// context used by the newsletter controller remove-subscriber action.
+func NewRemoveSubscriberNewsletterContext(ctx context.Context, r *http.Request, service *goa.Service) (*RemoveSubscriberNewsletterContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := RegisterURLFacebookContext{Context: ctx, ResponseData: resp, RequestData: req}
+	rctx := RemoveSubscriberNewsletterContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramEmail := req.Params["email"]
+	if len(paramEmail) > 0 {
+		rawEmail := paramEmail[0]
+		rctx.Email = &rawEmail
+		if rctx.Email != nil {
+			if err2 := goa.ValidateFormat(goa.FormatEmail, *rctx.Email); err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFormatError(`email`, *rctx.Email, goa.FormatEmail, err2))
+			}
+		}
+	}
 	return &rctx, err
 }
 
 // OK sends a HTTP response with status code 200.
-func (ctx *RegisterURLFacebookContext) OK(resp []byte) error {
+func (ctx *RemoveSubscriberNewsletterContext) OK(resp []byte) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "text/plain")
+		ctx.ResponseData.Header().Set("Content-Type", "OK")
 	}
 	ctx.ResponseData.WriteHeader(200)
 	_, err := ctx.ResponseData.Write(resp)
 	return err
 }
 
-// InternalServerError sends a HTTP response with status code 500.
-func (ctx *RegisterURLFacebookContext) InternalServerError(r error) error {
+// BadRequest sends a HTTP response with status code 400.
+func (ctx *RemoveSubscriberNewsletterContext) BadRequest(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
-}
-
-// AttachToAccountGoogleContext provides the google attach-to-account action context.
-type AttachToAccountGoogleContext struct {
-	context.Context
-	*goa.ResponseData
-	*goa.RequestData
-}
-
-// NewAttachToAccountGoogleContext parses the incoming request URL and body, performs validations and creates the
-// context used by the google controller attach-to-account action.
-func NewAttachToAccountGoogleContext(ctx context.Context, r *http.Request, service *goa.Service) (*AttachToAccountGoogleContext, error) {
-	var err error
-	resp := goa.ContextResponse(ctx)
-	resp.Service = service
-	req := goa.ContextRequest(ctx)
-	req.Request = r
-	rctx := AttachToAccountGoogleContext{Context: ctx, ResponseData: resp, RequestData: req}
-	return &rctx, err
+	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
 }
 
-// OK sends a HTTP response with status code 200.
-func (ctx *AttachToAccountGoogleContext) OK(resp []byte) error {
+// NotFound sends a HTTP response with status code 404.
+func (ctx *RemoveSubscriberNewsletterContext) NotFound(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "text/plain")
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
-	ctx.ResponseData.WriteHeader(200)
-	_, err := ctx.ResponseData.Write(resp)
-	return err
+	return ctx.ResponseData.Service.Send(ctx.Context, 404, r)
 }
 
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *AttachToAccountGoogleContext) InternalServerError(r error) error {
+func (ctx *RemoveSubscriberNewsletterContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// DetachFromAccountGoogleContext provides the google detach-from-account action context.
-type DetachFromAccountGoogleContext struct {
+// UpdateSubscriberNewsletterContext provides the newsletter update-subscriber action context.
+type UpdateSubscriberNewsletterContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
+	Payload *NewsletterParam
 }
 
-// NewDetachFromAccountGoogleContext parses the incoming request URL and body, performs validations and creates the
-// context used by the google controller detach-from-account action.
-func NewDetachFromAccountGoogleContext(ctx context.Context, r *http.Request, service *goa.Service) (*DetachFromAccountGoogleContext, error) {
+// NewUpdateSubscriberNewsletterContext parses the incoming request URL and body, performs validations and creates the
+// context used by the newsletter controller update-subscriber action.
+func NewUpdateSubscriberNewsletterContext(ctx context.Context, r *http.Request, service *goa.Service) (*UpdateSubscriberNewsletterContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := DetachFromAccountGoogleContext{Context: ctx, ResponseData: resp, RequestData: req}
+	rctx := UpdateSubscriberNewsletterContext{Context: ctx, ResponseData: resp, RequestData: req}
 	return &rctx, err
 }
 
 // OK sends a HTTP response with status code 200.
-func (ctx *DetachFromAccountGoogleContext) OK(resp []byte) error {
+func (ctx *UpdateSubscriberNewsletterContext) OK(resp []byte) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "OK")
 	}
@@ -1090,16 +997,16 @@ func (ctx *DetachFromAccountGoogleContext) OK(resp []byte) error {
 	return err
 }
 
-// Forbidden sends a HTTP response with status code 403.
-func (ctx *DetachFromAccountGoogleContext) Forbidden(r error) error {
+// BadRequest sends a HTTP response with status code 400.
+func (ctx *UpdateSubscriberNewsletterContext) BadRequest(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 403, r)
+	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
 }
 
 // NotFound sends a HTTP response with status code 404.
-func (ctx *DetachFromAccountGoogleContext) NotFound(r error) error {
+func (ctx *UpdateSubscriberNewsletterContext) NotFound(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
@@ -1107,303 +1014,395 @@ func (ctx *DetachFromAccountGoogleContext) NotFound(r error) error {
 }
 
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *DetachFromAccountGoogleContext) InternalServerError(r error) error {
+func (ctx *UpdateSubscriberNewsletterContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// LoginGoogleContext provides the google login action context.
-type LoginGoogleContext struct {
+// ApproveRequestOauthContext provides the oauth approve-request action context.
+type ApproveRequestOauthContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
-	Token *uuid.UUID
+	RequestID uuid.UUID
 }
 
-// NewLoginGoogleContext parses the incoming request URL and body, performs validations and creates the
-// context used by the google controller login action.
-func NewLoginGoogleContext(ctx context.Context, r *http.Request, service *goa.Service) (*LoginGoogleContext, error) {
+// NewApproveRequestOauthContext parses the incoming request URL and body, performs validations and creates the
+// context used by the oauth controller approve-request action.
+func NewApproveRequestOauthContext(ctx context.Context, r *http.Request, service *goa.Service) (*ApproveRequestOauthContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := LoginGoogleContext{Context: ctx, ResponseData: resp, RequestData: req}
-	paramToken := req.Params["token"]
-	if len(paramToken) > 0 {
-		rawToken := paramToken[0]
-		if token, err2 := uuid.FromString(rawToken); err2 == nil {
-			tmp5 := &token
-			rctx.Token = tmp5
+	rctx := ApproveRequestOauthContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramRequestID := req.Params["requestID"]
+	if len(paramRequestID) == 0 {
+		err = goa.MergeErrors(err, goa.MissingParamError("requestID"))
+	} else {
+		rawRequestID := paramRequestID[0]
+		if requestID, err2 := uuid.FromString(rawRequestID); err2 == nil {
+			rctx.RequestID = requestID
 		} else {
-			err = goa.MergeErrors(err, goa.InvalidParamTypeError("token", rawToken, "uuid"))
+			err = goa.MergeErrors(err, goa.InvalidParamTypeError("requestID", rawRequestID, "uuid"))
 		}
 	}
 	return &rctx, err
 }
 
 // OK sends a HTTP response with status code 200.
-func (ctx *LoginGoogleContext) OK(resp []byte) error {
+func (ctx *ApproveRequestOauthContext) OK(r *OauthRedirect) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "text/plain")
+		ctx.ResponseData.Header().Set("Content-Type", "application/json")
 	}
-	ctx.ResponseData.WriteHeader(200)
-	_, err := ctx.ResponseData.Write(resp)
-	return err
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// NotFound sends a HTTP response with status code 404.
+func (ctx *ApproveRequestOauthContext) NotFound(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 404, r)
 }
 
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *LoginGoogleContext) InternalServerError(r error) error {
+func (ctx *ApproveRequestOauthContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// ReceiveGoogleContext provides the google receive action context.
-type ReceiveGoogleContext struct {
+// AuthorizeOauthContext provides the oauth authorize action context.
+type AuthorizeOauthContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
-	Code  string
-	State uuid.UUID
-}
-
-// NewReceiveGoogleContext parses the incoming request URL and body, performs validations and creates the
-// context used by the google controller receive action.
-func NewReceiveGoogleContext(ctx context.Context, r *http.Request, service *goa.Service) (*ReceiveGoogleContext, error) {
+	ClientID            string
+	CodeChallenge       *string
+	CodeChallengeMethod *string
+	Nonce               *string
+	RedirectURI         string
+	ResponseType        *string
+	Scope               *string
+	State               *string
+}
+
+// NewAuthorizeOauthContext parses the incoming request URL and body, performs validations and creates the
+// context used by the oauth controller authorize action.
+func NewAuthorizeOauthContext(ctx context.Context, r *http.Request, service *goa.Service) (*AuthorizeOauthContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := ReceiveGoogleContext{Context: ctx, ResponseData: resp, RequestData: req}
-	paramCode := req.Params["code"]
-	if len(paramCode) == 0 {
-		err = goa.MergeErrors(err, goa.MissingParamError("code"))
+	rctx := AuthorizeOauthContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramClientID := req.Params["client_id"]
+	if len(paramClientID) == 0 {
+		err = goa.MergeErrors(err, goa.MissingParamError("client_id"))
 	} else {
-		rawCode := paramCode[0]
-		rctx.Code = rawCode
+		rawClientID := paramClientID[0]
+		rctx.ClientID = rawClientID
+	}
+	paramCodeChallenge := req.Params["code_challenge"]
+	if len(paramCodeChallenge) > 0 {
+		rawCodeChallenge := paramCodeChallenge[0]
+		rctx.CodeChallenge = &rawCodeChallenge
+	}
+	paramCodeChallengeMethod := req.Params["code_challenge_method"]
+	if len(paramCodeChallengeMethod) > 0 {
+		rawCodeChallengeMethod := paramCodeChallengeMethod[0]
+		rctx.CodeChallengeMethod = &rawCodeChallengeMethod
+	}
+	paramNonce := req.Params["nonce"]
+	if len(paramNonce) > 0 {
+		rawNonce := paramNonce[0]
+		rctx.Nonce = &rawNonce
+	}
+	paramRedirectURI := req.Params["redirect_uri"]
+	if len(paramRedirectURI) == 0 {
+		err = goa.MergeErrors(err, goa.MissingParamError("redirect_uri"))
+	} else {
+		rawRedirectURI := paramRedirectURI[0]
+		rctx.RedirectURI = rawRedirectURI
+	}
+	paramResponseType := req.Params["response_type"]
+	if len(paramResponseType) > 0 {
+		rawResponseType := paramResponseType[0]
+		rctx.ResponseType = &rawResponseType
+	}
+	paramScope := req.Params["scope"]
+	if len(paramScope) > 0 {
+		rawScope := paramScope[0]
+		rctx.Scope = &rawScope
 	}
 	paramState := req.Params["state"]
-	if len(paramState) == 0 {
-		err = goa.MergeErrors(err, goa.MissingParamError("state"))
-	} else {
+	if len(paramState) > 0 {
 		rawState := paramState[0]
-		if state, err2 := uuid.FromString(rawState); err2 == nil {
-			rctx.State = state
-		} else {
-			err = goa.MergeErrors(err, goa.InvalidParamTypeError("state", rawState, "uuid"))
-		}
+		rctx.State = &rawState
 	}
 	return &rctx, err
 }
 
-// OK sends a HTTP response with status code 200.
-func (ctx *ReceiveGoogleContext) OK(r interface{}) error {
-	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "text/plain")
-	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+// Found sends a HTTP response with status code 302.
+func (ctx *AuthorizeOauthContext) Found() error {
+	ctx.ResponseData.WriteHeader(302)
+	return nil
 }
 
 // BadRequest sends a HTTP response with status code 400.
-func (ctx *ReceiveGoogleContext) BadRequest(r error) error {
+func (ctx *AuthorizeOauthContext) BadRequest(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
 }
 
-// Unauthorized sends a HTTP response with status code 401.
-func (ctx *ReceiveGoogleContext) Unauthorized(r error) error {
-	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
-	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 401, r)
-}
-
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *ReceiveGoogleContext) InternalServerError(r error) error {
+func (ctx *AuthorizeOauthContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// RegisterGoogleContext provides the google register action context.
-type RegisterGoogleContext struct {
+// GetRequestOauthContext provides the oauth get-request action context.
+type GetRequestOauthContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
-	Payload *GoogleRegisterParams
+	RequestID uuid.UUID
 }
 
-// NewRegisterGoogleContext parses the incoming request URL and body, performs validations and creates the
-// context used by the google controller register action.
-func NewRegisterGoogleContext(ctx context.Context, r *http.Request, service *goa.Service) (*RegisterGoogleContext, error) {
+// NewGetRequestOauthContext parses the incoming request URL and body, performs validations and creates the
+// context used by the oauth controller get-request action.
+func NewGetRequestOauthContext(ctx context.Context, r *http.Request, service *goa.Service) (*GetRequestOauthContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := RegisterGoogleContext{Context: ctx, ResponseData: resp, RequestData: req}
+	rctx := GetRequestOauthContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramRequestID := req.Params["requestID"]
+	if len(paramRequestID) == 0 {
+		err = goa.MergeErrors(err, goa.MissingParamError("requestID"))
+	} else {
+		rawRequestID := paramRequestID[0]
+		if requestID, err2 := uuid.FromString(rawRequestID); err2 == nil {
+			rctx.RequestID = requestID
+		} else {
+			err = goa.MergeErrors(err, goa.InvalidParamTypeError("requestID", rawRequestID, "uuid"))
+		}
+	}
 	return &rctx, err
 }
 
-// OKAdmin sends a HTTP response with status code 200.
-func (ctx *RegisterGoogleContext) OKAdmin(r *UserAdmin) error {
+// OK sends a HTTP response with status code 200.
+func (ctx *GetRequestOauthContext) OK(r *OauthRequest) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/json")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
 }
 
-// OK sends a HTTP response with status code 200.
-func (ctx *RegisterGoogleContext) OK(r *User) error {
+// NotFound sends a HTTP response with status code 404.
+func (ctx *GetRequestOauthContext) NotFound(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/json")
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+	return ctx.ResponseData.Service.Send(ctx.Context, 404, r)
 }
 
-// OKOwner sends a HTTP response with status code 200.
-func (ctx *RegisterGoogleContext) OKOwner(r *UserOwner) error {
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *GetRequestOauthContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/json")
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// BadRequest sends a HTTP response with status code 400.
-func (ctx *RegisterGoogleContext) BadRequest(r error) error {
+// TokenOauthContext provides the oauth token action context.
+type TokenOauthContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	Payload *OauthTokenParams
+}
+
+// NewTokenOauthContext parses the incoming request URL and body, performs validations and creates the
+// context used by the oauth controller token action.
+func NewTokenOauthContext(ctx context.Context, r *http.Request, service *goa.Service) (*TokenOauthContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := TokenOauthContext{Context: ctx, ResponseData: resp, RequestData: req}
+	return &rctx, err
+}
+
+// OK sends a HTTP response with status code 200.
+func (ctx *TokenOauthContext) OK(r *OauthToken) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+		ctx.ResponseData.Header().Set("Content-Type", "application/json")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
 }
 
-// Forbidden sends a HTTP response with status code 403.
-func (ctx *RegisterGoogleContext) Forbidden(r error) error {
+// BadRequest sends a HTTP response with status code 400.
+func (ctx *TokenOauthContext) BadRequest(r *OauthError) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+		ctx.ResponseData.Header().Set("Content-Type", "application/json")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 403, r)
+	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
 }
 
-// NotFound sends a HTTP response with status code 404.
-func (ctx *RegisterGoogleContext) NotFound(r error) error {
+// Unauthorized sends a HTTP response with status code 401.
+func (ctx *TokenOauthContext) Unauthorized(r *OauthError) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+		ctx.ResponseData.Header().Set("Content-Type", "application/json")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 404, r)
+	return ctx.ResponseData.Service.Send(ctx.Context, 401, r)
 }
 
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *RegisterGoogleContext) InternalServerError(r error) error {
+func (ctx *TokenOauthContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// RegisterURLGoogleContext provides the google register-url action context.
-type RegisterURLGoogleContext struct {
+// UserinfoOauthContext provides the oauth userinfo action context.
+type UserinfoOauthContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
 }
 
-// NewRegisterURLGoogleContext parses the incoming request URL and body, performs validations and creates the
-// context used by the google controller register-url action.
-func NewRegisterURLGoogleContext(ctx context.Context, r *http.Request, service *goa.Service) (*RegisterURLGoogleContext, error) {
+// NewUserinfoOauthContext parses the incoming request URL and body, performs validations and creates the
+// context used by the oauth controller userinfo action.
+func NewUserinfoOauthContext(ctx context.Context, r *http.Request, service *goa.Service) (*UserinfoOauthContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := RegisterURLGoogleContext{Context: ctx, ResponseData: resp, RequestData: req}
+	rctx := UserinfoOauthContext{Context: ctx, ResponseData: resp, RequestData: req}
 	return &rctx, err
 }
 
 // OK sends a HTTP response with status code 200.
-func (ctx *RegisterURLGoogleContext) OK(resp []byte) error {
+func (ctx *UserinfoOauthContext) OK(r *UserInfo) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "text/plain")
+		ctx.ResponseData.Header().Set("Content-Type", "application/json")
 	}
-	ctx.ResponseData.WriteHeader(200)
-	_, err := ctx.ResponseData.Write(resp)
-	return err
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// Unauthorized sends a HTTP response with status code 401.
+func (ctx *UserinfoOauthContext) Unauthorized(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 401, r)
 }
 
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *RegisterURLGoogleContext) InternalServerError(r error) error {
+func (ctx *UserinfoOauthContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// AttachToAccountLinkedinContext provides the linkedin attach-to-account action context.
-type AttachToAccountLinkedinContext struct {
+// ChangePasswordPasswordAuthContext provides the password-auth change-password action context.
+type ChangePasswordPasswordAuthContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
+	Payload *ChangePasswordParams
 }
 
-// NewAttachToAccountLinkedinContext parses the incoming request URL and body, performs validations and creates the
-// context used by the linkedin controller attach-to-account action.
-func NewAttachToAccountLinkedinContext(ctx context.Context, r *http.Request, service *goa.Service) (*AttachToAccountLinkedinContext, error) {
+// NewChangePasswordPasswordAuthContext parses the incoming request URL and body, performs validations and creates the
+// context used by the password-auth controller change-password action.
+func NewChangePasswordPasswordAuthContext(ctx context.Context, r *http.Request, service *goa.Service) (*ChangePasswordPasswordAuthContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := AttachToAccountLinkedinContext{Context: ctx, ResponseData: resp, RequestData: req}
+	rctx := ChangePasswordPasswordAuthContext{Context: ctx, ResponseData: resp, RequestData: req}
 	return &rctx, err
 }
 
 // OK sends a HTTP response with status code 200.
-func (ctx *AttachToAccountLinkedinContext) OK(resp []byte) error {
+func (ctx *ChangePasswordPasswordAuthContext) OK(resp []byte) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "text/plain")
+		ctx.ResponseData.Header().Set("Content-Type", "OK")
 	}
 	ctx.ResponseData.WriteHeader(200)
 	_, err := ctx.ResponseData.Write(resp)
 	return err
 }
 
+// BadRequest sends a HTTP response with status code 400.
+func (ctx *ChangePasswordPasswordAuthContext) BadRequest(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
+}
+
+// Forbidden sends a HTTP response with status code 403.
+func (ctx *ChangePasswordPasswordAuthContext) Forbidden(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 403, r)
+}
+
+// TooManyRequests sends a HTTP response with status code 429.
+func (ctx *ChangePasswordPasswordAuthContext) TooManyRequests(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 429, r)
+}
+
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *AttachToAccountLinkedinContext) InternalServerError(r error) error {
+func (ctx *ChangePasswordPasswordAuthContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// DetachFromAccountLinkedinContext provides the linkedin detach-from-account action context.
-type DetachFromAccountLinkedinContext struct {
+// ConfirmResetPasswordAuthContext provides the password-auth confirm-reset action context.
+type ConfirmResetPasswordAuthContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
+	Payload *ResetPasswordParams
 }
 
-// NewDetachFromAccountLinkedinContext parses the incoming request URL and body, performs validations and creates the
-// context used by the linkedin controller detach-from-account action.
-func NewDetachFromAccountLinkedinContext(ctx context.Context, r *http.Request, service *goa.Service) (*DetachFromAccountLinkedinContext, error) {
+// NewConfirmResetPasswordAuthContext parses the incoming request URL and body, performs validations and creates the
+// context used by the password-auth controller confirm-reset action.
+func NewConfirmResetPasswordAuthContext(ctx context.Context, r *http.Request, service *goa.Service) (*ConfirmResetPasswordAuthContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := DetachFromAccountLinkedinContext{Context: ctx, ResponseData: resp, RequestData: req}
+	rctx := ConfirmResetPasswordAuthContext{Context: ctx, ResponseData: resp, RequestData: req}
 	return &rctx, err
 }
 
 // OK sends a HTTP response with status code 200.
-func (ctx *DetachFromAccountLinkedinContext) OK(resp []byte) error {
+func (ctx *ConfirmResetPasswordAuthContext) OK(resp []byte) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "OK")
 	}
@@ -1412,53 +1411,62 @@ func (ctx *DetachFromAccountLinkedinContext) OK(resp []byte) error {
 	return err
 }
 
+// BadRequest sends a HTTP response with status code 400.
+func (ctx *ConfirmResetPasswordAuthContext) BadRequest(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
+}
+
 // Forbidden sends a HTTP response with status code 403.
-func (ctx *DetachFromAccountLinkedinContext) Forbidden(r error) error {
+func (ctx *ConfirmResetPasswordAuthContext) Forbidden(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 403, r)
 }
 
-// NotFound sends a HTTP response with status code 404.
-func (ctx *DetachFromAccountLinkedinContext) NotFound(r error) error {
+// TooManyRequests sends a HTTP response with status code 429.
+func (ctx *ConfirmResetPasswordAuthContext) TooManyRequests(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 404, r)
+	return ctx.ResponseData.Service.Send(ctx.Context, 429, r)
 }
 
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *DetachFromAccountLinkedinContext) InternalServerError(r error) error {
+func (ctx *ConfirmResetPasswordAuthContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// LoginLinkedinContext provides the linkedin login action context.
-type LoginLinkedinContext struct {
+// LoginPasswordAuthContext provides the password-auth login action context.
+type LoginPasswordAuthContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
-	Token *uuid.UUID
+	Token   *uuid.UUID
+	Payload *LoginParams
 }
 
-// NewLoginLinkedinContext parses the incoming request URL and body, performs validations and creates the
-// context used by the linkedin controller login action.
-func NewLoginLinkedinContext(ctx context.Context, r *http.Request, service *goa.Service) (*LoginLinkedinContext, error) {
+// NewLoginPasswordAuthContext parses the incoming request URL and body, performs validations and creates the
+// context used by the password-auth controller login action.
+func NewLoginPasswordAuthContext(ctx context.Context, r *http.Request, service *goa.Service) (*LoginPasswordAuthContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := LoginLinkedinContext{Context: ctx, ResponseData: resp, RequestData: req}
+	rctx := LoginPasswordAuthContext{Context: ctx, ResponseData: resp, RequestData: req}
 	paramToken := req.Params["token"]
 	if len(paramToken) > 0 {
 		rawToken := paramToken[0]
 		if token, err2 := uuid.FromString(rawToken); err2 == nil {
-			tmp7 := &token
-			rctx.Token = tmp7
+			tmp11 := &token
+			rctx.Token = tmp11
 		} else {
 			err = goa.MergeErrors(err, goa.InvalidParamTypeError("token", rawToken, "uuid"))
 		}
@@ -1466,117 +1474,135 @@ func NewLoginLinkedinContext(ctx context.Context, r *http.Request, service *goa.
 	return &rctx, err
 }
 
+// OKAdmin sends a HTTP response with status code 200.
+func (ctx *LoginPasswordAuthContext) OKAdmin(r *UserAdmin) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/json")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
 // OK sends a HTTP response with status code 200.
-func (ctx *LoginLinkedinContext) OK(resp []byte) error {
+func (ctx *LoginPasswordAuthContext) OK(r *User) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "text/plain")
+		ctx.ResponseData.Header().Set("Content-Type", "application/json")
 	}
-	ctx.ResponseData.WriteHeader(200)
-	_, err := ctx.ResponseData.Write(resp)
-	return err
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// OKOwner sends a HTTP response with status code 200.
+func (ctx *LoginPasswordAuthContext) OKOwner(r *UserOwner) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/json")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// Accepted sends a HTTP response with status code 202.
+func (ctx *LoginPasswordAuthContext) Accepted(r *TwoFactorChallenge) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/json")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 202, r)
+}
+
+// BadRequest sends a HTTP response with status code 400.
+func (ctx *LoginPasswordAuthContext) BadRequest(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
+}
+
+// Unauthorized sends a HTTP response with status code 401.
+func (ctx *LoginPasswordAuthContext) Unauthorized(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 401, r)
+}
+
+// Forbidden sends a HTTP response with status code 403.
+func (ctx *LoginPasswordAuthContext) Forbidden(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 403, r)
+}
+
+// TooManyRequests sends a HTTP response with status code 429.
+func (ctx *LoginPasswordAuthContext) TooManyRequests(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 429, r)
 }
 
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *LoginLinkedinContext) InternalServerError(r error) error {
+func (ctx *LoginPasswordAuthContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// ReceiveLinkedinContext provides the linkedin receive action context.
-type ReceiveLinkedinContext struct {
+// RegenerateRecoveryCodesPasswordAuthContext provides the password-auth regenerate-recovery-codes action context.
+type RegenerateRecoveryCodesPasswordAuthContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
-	Code  string
-	State uuid.UUID
 }
 
-// NewReceiveLinkedinContext parses the incoming request URL and body, performs validations and creates the
-// context used by the linkedin controller receive action.
-func NewReceiveLinkedinContext(ctx context.Context, r *http.Request, service *goa.Service) (*ReceiveLinkedinContext, error) {
+// NewRegenerateRecoveryCodesPasswordAuthContext parses the incoming request URL and body, performs validations and creates the
+// context used by the password-auth controller regenerate-recovery-codes action.
+func NewRegenerateRecoveryCodesPasswordAuthContext(ctx context.Context, r *http.Request, service *goa.Service) (*RegenerateRecoveryCodesPasswordAuthContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := ReceiveLinkedinContext{Context: ctx, ResponseData: resp, RequestData: req}
-	paramCode := req.Params["code"]
-	if len(paramCode) == 0 {
-		err = goa.MergeErrors(err, goa.MissingParamError("code"))
-	} else {
-		rawCode := paramCode[0]
-		rctx.Code = rawCode
-	}
-	paramState := req.Params["state"]
-	if len(paramState) == 0 {
-		err = goa.MergeErrors(err, goa.MissingParamError("state"))
-	} else {
-		rawState := paramState[0]
-		if state, err2 := uuid.FromString(rawState); err2 == nil {
-			rctx.State = state
-		} else {
-			err = goa.MergeErrors(err, goa.InvalidParamTypeError("state", rawState, "uuid"))
-		}
-	}
+	rctx := RegenerateRecoveryCodesPasswordAuthContext{Context: ctx, ResponseData: resp, RequestData: req}
 	return &rctx, err
 }
 
 // OK sends a HTTP response with status code 200.
-func (ctx *ReceiveLinkedinContext) OK(r interface{}) error {
+func (ctx *RegenerateRecoveryCodesPasswordAuthContext) OK(r *RecoveryCodes) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "text/plain")
+		ctx.ResponseData.Header().Set("Content-Type", "application/json")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
 }
 
-// BadRequest sends a HTTP response with status code 400.
-func (ctx *ReceiveLinkedinContext) BadRequest(r error) error {
-	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
-	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
-}
-
-// Unauthorized sends a HTTP response with status code 401.
-func (ctx *ReceiveLinkedinContext) Unauthorized(r error) error {
-	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
-	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 401, r)
-}
-
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *ReceiveLinkedinContext) InternalServerError(r error) error {
+func (ctx *RegenerateRecoveryCodesPasswordAuthContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// RegisterLinkedinContext provides the linkedin register action context.
-type RegisterLinkedinContext struct {
+// RegisterPasswordAuthContext provides the password-auth register action context.
+type RegisterPasswordAuthContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
-	Payload *LinkedinRegisterParams
+	Payload *RegisterParams
 }
 
-// NewRegisterLinkedinContext parses the incoming request URL and body, performs validations and creates the
-// context used by the linkedin controller register action.
-func NewRegisterLinkedinContext(ctx context.Context, r *http.Request, service *goa.Service) (*RegisterLinkedinContext, error) {
+// NewRegisterPasswordAuthContext parses the incoming request URL and body, performs validations and creates the
+// context used by the password-auth controller register action.
+func NewRegisterPasswordAuthContext(ctx context.Context, r *http.Request, service *goa.Service) (*RegisterPasswordAuthContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := RegisterLinkedinContext{Context: ctx, ResponseData: resp, RequestData: req}
+	rctx := RegisterPasswordAuthContext{Context: ctx, ResponseData: resp, RequestData: req}
 	return &rctx, err
 }
 
 // OKAdmin sends a HTTP response with status code 200.
-func (ctx *RegisterLinkedinContext) OKAdmin(r *UserAdmin) error {
+func (ctx *RegisterPasswordAuthContext) OKAdmin(r *UserAdmin) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/json")
 	}
@@ -1584,7 +1610,7 @@ func (ctx *RegisterLinkedinContext) OKAdmin(r *UserAdmin) error {
 }
 
 // OK sends a HTTP response with status code 200.
-func (ctx *RegisterLinkedinContext) OK(r *User) error {
+func (ctx *RegisterPasswordAuthContext) OK(r *User) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/json")
 	}
@@ -1592,7 +1618,7 @@ func (ctx *RegisterLinkedinContext) OK(r *User) error {
 }
 
 // OKOwner sends a HTTP response with status code 200.
-func (ctx *RegisterLinkedinContext) OKOwner(r *UserOwner) error {
+func (ctx *RegisterPasswordAuthContext) OKOwner(r *UserOwner) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/json")
 	}
@@ -1600,7 +1626,7 @@ func (ctx *RegisterLinkedinContext) OKOwner(r *UserOwner) error {
 }
 
 // BadRequest sends a HTTP response with status code 400.
-func (ctx *RegisterLinkedinContext) BadRequest(r error) error {
+func (ctx *RegisterPasswordAuthContext) BadRequest(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
@@ -1608,124 +1634,160 @@ func (ctx *RegisterLinkedinContext) BadRequest(r error) error {
 }
 
 // Forbidden sends a HTTP response with status code 403.
-func (ctx *RegisterLinkedinContext) Forbidden(r error) error {
+func (ctx *RegisterPasswordAuthContext) Forbidden(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 403, r)
 }
 
-// NotFound sends a HTTP response with status code 404.
-func (ctx *RegisterLinkedinContext) NotFound(r error) error {
-	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
-	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 404, r)
-}
-
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *RegisterLinkedinContext) InternalServerError(r error) error {
+func (ctx *RegisterPasswordAuthContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// RegisterURLLinkedinContext provides the linkedin register-url action context.
-type RegisterURLLinkedinContext struct {
+// RemovePasswordAuthContext provides the password-auth remove action context.
+type RemovePasswordAuthContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
 }
 
-// NewRegisterURLLinkedinContext parses the incoming request URL and body, performs validations and creates the
-// context used by the linkedin controller register-url action.
-func NewRegisterURLLinkedinContext(ctx context.Context, r *http.Request, service *goa.Service) (*RegisterURLLinkedinContext, error) {
+// NewRemovePasswordAuthContext parses the incoming request URL and body, performs validations and creates the
+// context used by the password-auth controller remove action.
+func NewRemovePasswordAuthContext(ctx context.Context, r *http.Request, service *goa.Service) (*RemovePasswordAuthContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := RegisterURLLinkedinContext{Context: ctx, ResponseData: resp, RequestData: req}
+	rctx := RemovePasswordAuthContext{Context: ctx, ResponseData: resp, RequestData: req}
 	return &rctx, err
 }
 
 // OK sends a HTTP response with status code 200.
-func (ctx *RegisterURLLinkedinContext) OK(resp []byte) error {
+func (ctx *RemovePasswordAuthContext) OK(resp []byte) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "text/plain")
+		ctx.ResponseData.Header().Set("Content-Type", "OK")
 	}
 	ctx.ResponseData.WriteHeader(200)
 	_, err := ctx.ResponseData.Write(resp)
 	return err
 }
 
+// Forbidden sends a HTTP response with status code 403.
+func (ctx *RemovePasswordAuthContext) Forbidden(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 403, r)
+}
+
+// NotFound sends a HTTP response with status code 404.
+func (ctx *RemovePasswordAuthContext) NotFound(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 404, r)
+}
+
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *RegisterURLLinkedinContext) InternalServerError(r error) error {
+func (ctx *RemovePasswordAuthContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// AttachToAccountMicrosoftContext provides the microsoft attach-to-account action context.
-type AttachToAccountMicrosoftContext struct {
+// ResetPasswordAuthContext provides the password-auth reset action context.
+type ResetPasswordAuthContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
+	Email       string
+	RedirectURL string
 }
 
-// NewAttachToAccountMicrosoftContext parses the incoming request URL and body, performs validations and creates the
-// context used by the microsoft controller attach-to-account action.
-func NewAttachToAccountMicrosoftContext(ctx context.Context, r *http.Request, service *goa.Service) (*AttachToAccountMicrosoftContext, error) {
+// NewResetPasswordAuthContext parses the incoming request URL and body, performs validations and creates the
+// context used by the password-auth controller reset action.
+func NewResetPasswordAuthContext(ctx context.Context, r *http.Request, service *goa.Service) (*ResetPasswordAuthContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := AttachToAccountMicrosoftContext{Context: ctx, ResponseData: resp, RequestData: req}
+	rctx := ResetPasswordAuthContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramEmail := req.Params["email"]
+	if len(paramEmail) == 0 {
+		err = goa.MergeErrors(err, goa.MissingParamError("email"))
+	} else {
+		rawEmail := paramEmail[0]
+		rctx.Email = rawEmail
+		if err2 := goa.ValidateFormat(goa.FormatEmail, rctx.Email); err2 != nil {
+			err = goa.MergeErrors(err, goa.InvalidFormatError(`email`, rctx.Email, goa.FormatEmail, err2))
+		}
+	}
+	paramRedirectURL := req.Params["redirect-url"]
+	if len(paramRedirectURL) == 0 {
+		err = goa.MergeErrors(err, goa.MissingParamError("redirect-url"))
+	} else {
+		rawRedirectURL := paramRedirectURL[0]
+		rctx.RedirectURL = rawRedirectURL
+	}
 	return &rctx, err
 }
 
 // OK sends a HTTP response with status code 200.
-func (ctx *AttachToAccountMicrosoftContext) OK(resp []byte) error {
+func (ctx *ResetPasswordAuthContext) OK(resp []byte) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "text/plain")
+		ctx.ResponseData.Header().Set("Content-Type", "OK")
 	}
 	ctx.ResponseData.WriteHeader(200)
 	_, err := ctx.ResponseData.Write(resp)
 	return err
 }
 
+// BadRequest sends a HTTP response with status code 400.
+func (ctx *ResetPasswordAuthContext) BadRequest(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
+}
+
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *AttachToAccountMicrosoftContext) InternalServerError(r error) error {
+func (ctx *ResetPasswordAuthContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// DetachFromAccountMicrosoftContext provides the microsoft detach-from-account action context.
-type DetachFromAccountMicrosoftContext struct {
+// UnlockPasswordAuthContext provides the password-auth unlock action context.
+type UnlockPasswordAuthContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
+	Payload *UnlockParams
 }
 
-// NewDetachFromAccountMicrosoftContext parses the incoming request URL and body, performs validations and creates the
-// context used by the microsoft controller detach-from-account action.
-func NewDetachFromAccountMicrosoftContext(ctx context.Context, r *http.Request, service *goa.Service) (*DetachFromAccountMicrosoftContext, error) {
+// NewUnlockPasswordAuthContext parses the incoming request URL and body, performs validations and creates the
+// context used by the password-auth controller unlock action.
+func NewUnlockPasswordAuthContext(ctx context.Context, r *http.Request, service *goa.Service) (*UnlockPasswordAuthContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := DetachFromAccountMicrosoftContext{Context: ctx, ResponseData: resp, RequestData: req}
+	rctx := UnlockPasswordAuthContext{Context: ctx, ResponseData: resp, RequestData: req}
 	return &rctx, err
 }
 
 // OK sends a HTTP response with status code 200.
-func (ctx *DetachFromAccountMicrosoftContext) OK(resp []byte) error {
+func (ctx *UnlockPasswordAuthContext) OK(resp []byte) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "OK")
 	}
@@ -1735,283 +1797,233 @@ func (ctx *DetachFromAccountMicrosoftContext) OK(resp []byte) error {
 }
 
 // Forbidden sends a HTTP response with status code 403.
-func (ctx *DetachFromAccountMicrosoftContext) Forbidden(r error) error {
+func (ctx *UnlockPasswordAuthContext) Forbidden(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 403, r)
 }
 
-// NotFound sends a HTTP response with status code 404.
-func (ctx *DetachFromAccountMicrosoftContext) NotFound(r error) error {
-	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
-	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 404, r)
-}
-
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *DetachFromAccountMicrosoftContext) InternalServerError(r error) error {
+func (ctx *UnlockPasswordAuthContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// LoginMicrosoftContext provides the microsoft login action context.
-type LoginMicrosoftContext struct {
+// CleanLoginTokenSessionContext provides the session clean-login-token action context.
+type CleanLoginTokenSessionContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
-	Token *uuid.UUID
 }
 
-// NewLoginMicrosoftContext parses the incoming request URL and body, performs validations and creates the
-// context used by the microsoft controller login action.
-func NewLoginMicrosoftContext(ctx context.Context, r *http.Request, service *goa.Service) (*LoginMicrosoftContext, error) {
+// NewCleanLoginTokenSessionContext parses the incoming request URL and body, performs validations and creates the
+// context used by the session controller clean-login-token action.
+func NewCleanLoginTokenSessionContext(ctx context.Context, r *http.Request, service *goa.Service) (*CleanLoginTokenSessionContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := LoginMicrosoftContext{Context: ctx, ResponseData: resp, RequestData: req}
-	paramToken := req.Params["token"]
-	if len(paramToken) > 0 {
-		rawToken := paramToken[0]
-		if token, err2 := uuid.FromString(rawToken); err2 == nil {
-			tmp9 := &token
-			rctx.Token = tmp9
-		} else {
-			err = goa.MergeErrors(err, goa.InvalidParamTypeError("token", rawToken, "uuid"))
-		}
-	}
+	rctx := CleanLoginTokenSessionContext{Context: ctx, ResponseData: resp, RequestData: req}
 	return &rctx, err
 }
 
 // OK sends a HTTP response with status code 200.
-func (ctx *LoginMicrosoftContext) OK(resp []byte) error {
+func (ctx *CleanLoginTokenSessionContext) OK(resp []byte) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "text/plain")
+		ctx.ResponseData.Header().Set("Content-Type", "OK")
 	}
 	ctx.ResponseData.WriteHeader(200)
 	_, err := ctx.ResponseData.Write(resp)
 	return err
 }
 
-// InternalServerError sends a HTTP response with status code 500.
-func (ctx *LoginMicrosoftContext) InternalServerError(r error) error {
+// Forbidden sends a HTTP response with status code 403.
+func (ctx *CleanLoginTokenSessionContext) Forbidden(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+	return ctx.ResponseData.Service.Send(ctx.Context, 403, r)
 }
 
-// ReceiveMicrosoftContext provides the microsoft receive action context.
-type ReceiveMicrosoftContext struct {
+// CleanMergeTokenSessionContext provides the session clean-merge-token action context.
+type CleanMergeTokenSessionContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
-	Code  string
-	State uuid.UUID
 }
 
-// NewReceiveMicrosoftContext parses the incoming request URL and body, performs validations and creates the
-// context used by the microsoft controller receive action.
-func NewReceiveMicrosoftContext(ctx context.Context, r *http.Request, service *goa.Service) (*ReceiveMicrosoftContext, error) {
+// NewCleanMergeTokenSessionContext parses the incoming request URL and body, performs validations and creates the
+// context used by the session controller clean-merge-token action.
+func NewCleanMergeTokenSessionContext(ctx context.Context, r *http.Request, service *goa.Service) (*CleanMergeTokenSessionContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := ReceiveMicrosoftContext{Context: ctx, ResponseData: resp, RequestData: req}
-	paramCode := req.Params["code"]
-	if len(paramCode) == 0 {
-		err = goa.MergeErrors(err, goa.MissingParamError("code"))
-	} else {
-		rawCode := paramCode[0]
-		rctx.Code = rawCode
-	}
-	paramState := req.Params["state"]
-	if len(paramState) == 0 {
-		err = goa.MergeErrors(err, goa.MissingParamError("state"))
-	} else {
-		rawState := paramState[0]
-		if state, err2 := uuid.FromString(rawState); err2 == nil {
-			rctx.State = state
-		} else {
-			err = goa.MergeErrors(err, goa.InvalidParamTypeError("state", rawState, "uuid"))
-		}
-	}
+	rctx := CleanMergeTokenSessionContext{Context: ctx, ResponseData: resp, RequestData: req}
 	return &rctx, err
 }
 
 // OK sends a HTTP response with status code 200.
-func (ctx *ReceiveMicrosoftContext) OK(r interface{}) error {
+func (ctx *CleanMergeTokenSessionContext) OK(resp []byte) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "text/plain")
+		ctx.ResponseData.Header().Set("Content-Type", "OK")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+	ctx.ResponseData.WriteHeader(200)
+	_, err := ctx.ResponseData.Write(resp)
+	return err
 }
 
-// BadRequest sends a HTTP response with status code 400.
-func (ctx *ReceiveMicrosoftContext) BadRequest(r error) error {
+// Forbidden sends a HTTP response with status code 403.
+func (ctx *CleanMergeTokenSessionContext) Forbidden(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
+	return ctx.ResponseData.Service.Send(ctx.Context, 403, r)
 }
 
-// Unauthorized sends a HTTP response with status code 401.
-func (ctx *ReceiveMicrosoftContext) Unauthorized(r error) error {
-	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
-	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 401, r)
+// CleanSessionsSessionContext provides the session clean-sessions action context.
+type CleanSessionsSessionContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
 }
 
-// InternalServerError sends a HTTP response with status code 500.
-func (ctx *ReceiveMicrosoftContext) InternalServerError(r error) error {
-	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
-	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
-}
-
-// RegisterMicrosoftContext provides the microsoft register action context.
-type RegisterMicrosoftContext struct {
-	context.Context
-	*goa.ResponseData
-	*goa.RequestData
-	Payload *MicrosoftRegisterParams
-}
-
-// NewRegisterMicrosoftContext parses the incoming request URL and body, performs validations and creates the
-// context used by the microsoft controller register action.
-func NewRegisterMicrosoftContext(ctx context.Context, r *http.Request, service *goa.Service) (*RegisterMicrosoftContext, error) {
+// NewCleanSessionsSessionContext parses the incoming request URL and body, performs validations and creates the
+// context used by the session controller clean-sessions action.
+func NewCleanSessionsSessionContext(ctx context.Context, r *http.Request, service *goa.Service) (*CleanSessionsSessionContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := RegisterMicrosoftContext{Context: ctx, ResponseData: resp, RequestData: req}
+	rctx := CleanSessionsSessionContext{Context: ctx, ResponseData: resp, RequestData: req}
 	return &rctx, err
 }
 
-// OKAdmin sends a HTTP response with status code 200.
-func (ctx *RegisterMicrosoftContext) OKAdmin(r *UserAdmin) error {
-	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/json")
-	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
-}
-
 // OK sends a HTTP response with status code 200.
-func (ctx *RegisterMicrosoftContext) OK(r *User) error {
+func (ctx *CleanSessionsSessionContext) OK(resp []byte) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/json")
+		ctx.ResponseData.Header().Set("Content-Type", "OK")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+	ctx.ResponseData.WriteHeader(200)
+	_, err := ctx.ResponseData.Write(resp)
+	return err
 }
 
-// OKOwner sends a HTTP response with status code 200.
-func (ctx *RegisterMicrosoftContext) OKOwner(r *UserOwner) error {
+// Forbidden sends a HTTP response with status code 403.
+func (ctx *CleanSessionsSessionContext) Forbidden(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/json")
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+	return ctx.ResponseData.Service.Send(ctx.Context, 403, r)
 }
 
-// BadRequest sends a HTTP response with status code 400.
-func (ctx *RegisterMicrosoftContext) BadRequest(r error) error {
-	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
-	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
+// GetSessionsSessionContext provides the session get-sessions action context.
+type GetSessionsSessionContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
 }
 
-// Forbidden sends a HTTP response with status code 403.
-func (ctx *RegisterMicrosoftContext) Forbidden(r error) error {
-	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
-	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 403, r)
+// NewGetSessionsSessionContext parses the incoming request URL and body, performs validations and creates the
+// context used by the session controller get-sessions action.
+func NewGetSessionsSessionContext(ctx context.Context, r *http.Request, service *goa.Service) (*GetSessionsSessionContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := GetSessionsSessionContext{Context: ctx, ResponseData: resp, RequestData: req}
+	return &rctx, err
 }
 
-// NotFound sends a HTTP response with status code 404.
-func (ctx *RegisterMicrosoftContext) NotFound(r error) error {
+// OK sends a HTTP response with status code 200.
+func (ctx *GetSessionsSessionContext) OK(r *AllSessions) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+		ctx.ResponseData.Header().Set("Content-Type", "all-sessions")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 404, r)
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
 }
 
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *RegisterMicrosoftContext) InternalServerError(r error) error {
+func (ctx *GetSessionsSessionContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// RegisterURLMicrosoftContext provides the microsoft register-url action context.
-type RegisterURLMicrosoftContext struct {
+// LogoutSessionContext provides the session logout action context.
+type LogoutSessionContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
 }
 
-// NewRegisterURLMicrosoftContext parses the incoming request URL and body, performs validations and creates the
-// context used by the microsoft controller register-url action.
-func NewRegisterURLMicrosoftContext(ctx context.Context, r *http.Request, service *goa.Service) (*RegisterURLMicrosoftContext, error) {
+// NewLogoutSessionContext parses the incoming request URL and body, performs validations and creates the
+// context used by the session controller logout action.
+func NewLogoutSessionContext(ctx context.Context, r *http.Request, service *goa.Service) (*LogoutSessionContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := RegisterURLMicrosoftContext{Context: ctx, ResponseData: resp, RequestData: req}
+	rctx := LogoutSessionContext{Context: ctx, ResponseData: resp, RequestData: req}
 	return &rctx, err
 }
 
 // OK sends a HTTP response with status code 200.
-func (ctx *RegisterURLMicrosoftContext) OK(resp []byte) error {
+func (ctx *LogoutSessionContext) OK(resp []byte) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "text/plain")
+		ctx.ResponseData.Header().Set("Content-Type", "OK")
 	}
 	ctx.ResponseData.WriteHeader(200)
 	_, err := ctx.ResponseData.Write(resp)
 	return err
 }
 
+// BadRequest sends a HTTP response with status code 400.
+func (ctx *LogoutSessionContext) BadRequest(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
+}
+
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *RegisterURLMicrosoftContext) InternalServerError(r error) error {
+func (ctx *LogoutSessionContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// AddSubscriberNewsletterContext provides the newsletter add-subscriber action context.
-type AddSubscriberNewsletterContext struct {
+// LogoutOtherSessionContext provides the session logout-other action context.
+type LogoutOtherSessionContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
-	Payload *NewsletterParam
 }
 
-// NewAddSubscriberNewsletterContext parses the incoming request URL and body, performs validations and creates the
-// context used by the newsletter controller add-subscriber action.
-func NewAddSubscriberNewsletterContext(ctx context.Context, r *http.Request, service *goa.Service) (*AddSubscriberNewsletterContext, error) {
+// NewLogoutOtherSessionContext parses the incoming request URL and body, performs validations and creates the
+// context used by the session controller logout-other action.
+func NewLogoutOtherSessionContext(ctx context.Context, r *http.Request, service *goa.Service) (*LogoutOtherSessionContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := AddSubscriberNewsletterContext{Context: ctx, ResponseData: resp, RequestData: req}
+	rctx := LogoutOtherSessionContext{Context: ctx, ResponseData: resp, RequestData: req}
 	return &rctx, err
 }
 
 // OK sends a HTTP response with status code 200.
-func (ctx *AddSubscriberNewsletterContext) OK(resp []byte) error {
+func (ctx *LogoutOtherSessionContext) OK(resp []byte) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "OK")
 	}
@@ -2021,69 +2033,66 @@ func (ctx *AddSubscriberNewsletterContext) OK(resp []byte) error {
 }
 
 // BadRequest sends a HTTP response with status code 400.
-func (ctx *AddSubscriberNewsletterContext) BadRequest(r error) error {
+func (ctx *LogoutOtherSessionContext) BadRequest(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
 }
 
-// NotFound sends a HTTP response with status code 404.
-func (ctx *AddSubscriberNewsletterContext) NotFound(r error) error {
-	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
-	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 404, r)
-}
-
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *AddSubscriberNewsletterContext) InternalServerError(r error) error {
+func (ctx *LogoutOtherSessionContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// GetSubscriberByEmailNewsletterContext provides the newsletter get-subscriber-by-email action context.
-type GetSubscriberByEmailNewsletterContext struct {
+// LogoutSpecificSessionContext provides the session logout-specific action context.
+type LogoutSpecificSessionContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
-	Email *string
+	Session   string
+	SessionID string
 }
 
-// NewGetSubscriberByEmailNewsletterContext parses the incoming request URL and body, performs validations and creates the
-// context used by the newsletter controller get-subscriber-by-email action.
-func NewGetSubscriberByEmailNewsletterContext(ctx context.Context, r *http.Request, service *goa.Service) (*GetSubscriberByEmailNewsletterContext, error) {
+// NewLogoutSpecificSessionContext parses the incoming request URL and body, performs validations and creates the
+// context used by the session controller logout-specific action.
+func NewLogoutSpecificSessionContext(ctx context.Context, r *http.Request, service *goa.Service) (*LogoutSpecificSessionContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := GetSubscriberByEmailNewsletterContext{Context: ctx, ResponseData: resp, RequestData: req}
-	paramEmail := req.Params["email"]
-	if len(paramEmail) > 0 {
-		rawEmail := paramEmail[0]
-		rctx.Email = &rawEmail
-		if rctx.Email != nil {
-			if err2 := goa.ValidateFormat(goa.FormatEmail, *rctx.Email); err2 != nil {
-				err = goa.MergeErrors(err, goa.InvalidFormatError(`email`, *rctx.Email, goa.FormatEmail, err2))
-			}
-		}
+	rctx := LogoutSpecificSessionContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramSession := req.Params["session"]
+	if len(paramSession) > 0 {
+		rawSession := paramSession[0]
+		rctx.Session = rawSession
+	}
+	paramSessionID := req.Params["session-id"]
+	if len(paramSessionID) == 0 {
+		err = goa.MergeErrors(err, goa.MissingParamError("session-id"))
+	} else {
+		rawSessionID := paramSessionID[0]
+		rctx.SessionID = rawSessionID
 	}
 	return &rctx, err
 }
 
 // OK sends a HTTP response with status code 200.
-func (ctx *GetSubscriberByEmailNewsletterContext) OK(r *NewsletterSubscriber) error {
+func (ctx *LogoutSpecificSessionContext) OK(resp []byte) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/json")
+		ctx.ResponseData.Header().Set("Content-Type", "OK")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+	ctx.ResponseData.WriteHeader(200)
+	_, err := ctx.ResponseData.Write(resp)
+	return err
 }
 
 // BadRequest sends a HTTP response with status code 400.
-func (ctx *GetSubscriberByEmailNewsletterContext) BadRequest(r error) error {
+func (ctx *LogoutSpecificSessionContext) BadRequest(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
@@ -2091,7 +2100,7 @@ func (ctx *GetSubscriberByEmailNewsletterContext) BadRequest(r error) error {
 }
 
 // NotFound sends a HTTP response with status code 404.
-func (ctx *GetSubscriberByEmailNewsletterContext) NotFound(r error) error {
+func (ctx *LogoutSpecificSessionContext) NotFound(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
@@ -2099,209 +2108,295 @@ func (ctx *GetSubscriberByEmailNewsletterContext) NotFound(r error) error {
 }
 
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *GetSubscriberByEmailNewsletterContext) InternalServerError(r error) error {
+func (ctx *LogoutSpecificSessionContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// GetSubscribersNewsletterContext provides the newsletter get-subscribers action context.
-type GetSubscribersNewsletterContext struct {
+// RedeemLoginCodeSessionContext provides the session redeem-login-code action context.
+type RedeemLoginCodeSessionContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
+	Payload *LoginCodeParams
 }
 
-// NewGetSubscribersNewsletterContext parses the incoming request URL and body, performs validations and creates the
-// context used by the newsletter controller get-subscribers action.
-func NewGetSubscribersNewsletterContext(ctx context.Context, r *http.Request, service *goa.Service) (*GetSubscribersNewsletterContext, error) {
+// NewRedeemLoginCodeSessionContext parses the incoming request URL and body, performs validations and creates the
+// context used by the session controller redeem-login-code action.
+func NewRedeemLoginCodeSessionContext(ctx context.Context, r *http.Request, service *goa.Service) (*RedeemLoginCodeSessionContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := GetSubscribersNewsletterContext{Context: ctx, ResponseData: resp, RequestData: req}
+	rctx := RedeemLoginCodeSessionContext{Context: ctx, ResponseData: resp, RequestData: req}
 	return &rctx, err
 }
 
+// OKAdmin sends a HTTP response with status code 200.
+func (ctx *RedeemLoginCodeSessionContext) OKAdmin(r *UserAdmin) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/json")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
 // OK sends a HTTP response with status code 200.
-func (ctx *GetSubscribersNewsletterContext) OK(r NewsletterSubscriberCollection) error {
+func (ctx *RedeemLoginCodeSessionContext) OK(r *User) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "newsletter-subscriber; type=collection")
+		ctx.ResponseData.Header().Set("Content-Type", "application/json")
 	}
-	if r == nil {
-		r = NewsletterSubscriberCollection{}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// OKOwner sends a HTTP response with status code 200.
+func (ctx *RedeemLoginCodeSessionContext) OKOwner(r *UserOwner) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/json")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
 }
 
-// BadRequest sends a HTTP response with status code 400.
-func (ctx *GetSubscribersNewsletterContext) BadRequest(r error) error {
+// Accepted sends a HTTP response with status code 202.
+func (ctx *RedeemLoginCodeSessionContext) Accepted(r *TwoFactorChallenge) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/json")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 202, r)
+}
+
+// Unauthorized sends a HTTP response with status code 401.
+func (ctx *RedeemLoginCodeSessionContext) Unauthorized(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
+	return ctx.ResponseData.Service.Send(ctx.Context, 401, r)
 }
 
-// NotFound sends a HTTP response with status code 404.
-func (ctx *GetSubscribersNewsletterContext) NotFound(r error) error {
+// Forbidden sends a HTTP response with status code 403.
+func (ctx *RedeemLoginCodeSessionContext) Forbidden(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 404, r)
+	return ctx.ResponseData.Service.Send(ctx.Context, 403, r)
 }
 
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *GetSubscribersNewsletterContext) InternalServerError(r error) error {
+func (ctx *RedeemLoginCodeSessionContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// RemoveSubscriberNewsletterContext provides the newsletter remove-subscriber action context.
-type RemoveSubscriberNewsletterContext struct {
+// RedeemPhoneLoginCodeSessionContext provides the session redeem-phone-login-code action context.
+type RedeemPhoneLoginCodeSessionContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
-	Email *string
+	Payload *PhoneLoginParams
 }
 
-// NewRemoveSubscriberNewsletterContext parses the incoming request URL and body, performs validations and creates the
-// context used by the newsletter controller remove-subscriber action.
-func NewRemoveSubscriberNewsletterContext(ctx context.Context, r *http.Request, service *goa.Service) (*RemoveSubscriberNewsletterContext, error) {
+// NewRedeemPhoneLoginCodeSessionContext parses the incoming request URL and body, performs validations and creates the
+// context used by the session controller redeem-phone-login-code action.
+func NewRedeemPhoneLoginCodeSessionContext(ctx context.Context, r *http.Request, service *goa.Service) (*RedeemPhoneLoginCodeSessionContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := RemoveSubscriberNewsletterContext{Context: ctx, ResponseData: resp, RequestData: req}
-	paramEmail := req.Params["email"]
-	if len(paramEmail) > 0 {
-		rawEmail := paramEmail[0]
-		rctx.Email = &rawEmail
-		if rctx.Email != nil {
-			if err2 := goa.ValidateFormat(goa.FormatEmail, *rctx.Email); err2 != nil {
-				err = goa.MergeErrors(err, goa.InvalidFormatError(`email`, *rctx.Email, goa.FormatEmail, err2))
-			}
-		}
-	}
+	rctx := RedeemPhoneLoginCodeSessionContext{Context: ctx, ResponseData: resp, RequestData: req}
 	return &rctx, err
 }
 
+// OKAdmin sends a HTTP response with status code 200.
+func (ctx *RedeemPhoneLoginCodeSessionContext) OKAdmin(r *UserAdmin) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/json")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
 // OK sends a HTTP response with status code 200.
-func (ctx *RemoveSubscriberNewsletterContext) OK(resp []byte) error {
+func (ctx *RedeemPhoneLoginCodeSessionContext) OK(r *User) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "OK")
+		ctx.ResponseData.Header().Set("Content-Type", "application/json")
 	}
-	ctx.ResponseData.WriteHeader(200)
-	_, err := ctx.ResponseData.Write(resp)
-	return err
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
 }
 
-// BadRequest sends a HTTP response with status code 400.
-func (ctx *RemoveSubscriberNewsletterContext) BadRequest(r error) error {
+// OKOwner sends a HTTP response with status code 200.
+func (ctx *RedeemPhoneLoginCodeSessionContext) OKOwner(r *UserOwner) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/json")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// Accepted sends a HTTP response with status code 202.
+func (ctx *RedeemPhoneLoginCodeSessionContext) Accepted(r *TwoFactorChallenge) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/json")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 202, r)
+}
+
+// Unauthorized sends a HTTP response with status code 401.
+func (ctx *RedeemPhoneLoginCodeSessionContext) Unauthorized(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
+	return ctx.ResponseData.Service.Send(ctx.Context, 401, r)
 }
 
-// NotFound sends a HTTP response with status code 404.
-func (ctx *RemoveSubscriberNewsletterContext) NotFound(r error) error {
+// Forbidden sends a HTTP response with status code 403.
+func (ctx *RedeemPhoneLoginCodeSessionContext) Forbidden(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 404, r)
+	return ctx.ResponseData.Service.Send(ctx.Context, 403, r)
 }
 
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *RemoveSubscriberNewsletterContext) InternalServerError(r error) error {
+func (ctx *RedeemPhoneLoginCodeSessionContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// UpdateSubscriberNewsletterContext provides the newsletter update-subscriber action context.
-type UpdateSubscriberNewsletterContext struct {
-	context.Context
+// RedeemTokenSessionContext provides the session redeemToken action context.
+type RedeemTokenSessionContext struct {
+	context.Context
 	*goa.ResponseData
 	*goa.RequestData
-	Payload *NewsletterParam
+	Payload *RedeemTokenSessionPayload
 }
 
-// NewUpdateSubscriberNewsletterContext parses the incoming request URL and body, performs validations and creates the
-// context used by the newsletter controller update-subscriber action.
-func NewUpdateSubscriberNewsletterContext(ctx context.Context, r *http.Request, service *goa.Service) (*UpdateSubscriberNewsletterContext, error) {
+// NewRedeemTokenSessionContext parses the incoming request URL and body, performs validations and creates the
+// context used by the session controller redeemToken action.
+func NewRedeemTokenSessionContext(ctx context.Context, r *http.Request, service *goa.Service) (*RedeemTokenSessionContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := UpdateSubscriberNewsletterContext{Context: ctx, ResponseData: resp, RequestData: req}
+	rctx := RedeemTokenSessionContext{Context: ctx, ResponseData: resp, RequestData: req}
 	return &rctx, err
 }
 
-// OK sends a HTTP response with status code 200.
-func (ctx *UpdateSubscriberNewsletterContext) OK(resp []byte) error {
-	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "OK")
+// redeemTokenSessionPayload is the session redeemToken action payload.
+type redeemTokenSessionPayload struct {
+	// The signature of the token, sent along with it in the login link
+	Signature *string `form:"signature,omitempty" json:"signature,omitempty" yaml:"signature,omitempty" xml:"signature,omitempty"`
+	// The token to redeem
+	Token *uuid.UUID `form:"token,omitempty" json:"token,omitempty" yaml:"token,omitempty" xml:"token,omitempty"`
+}
+
+// Validate runs the validation rules defined in the design.
+func (payload *redeemTokenSessionPayload) Validate() (err error) {
+	if payload.Token == nil {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`raw`, "token"))
 	}
-	ctx.ResponseData.WriteHeader(200)
-	_, err := ctx.ResponseData.Write(resp)
-	return err
+	if payload.Signature == nil {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`raw`, "signature"))
+	}
+	return
 }
 
-// BadRequest sends a HTTP response with status code 400.
-func (ctx *UpdateSubscriberNewsletterContext) BadRequest(r error) error {
+// Publicize creates RedeemTokenSessionPayload from redeemTokenSessionPayload
+func (payload *redeemTokenSessionPayload) Publicize() *RedeemTokenSessionPayload {
+	var pub RedeemTokenSessionPayload
+	if payload.Signature != nil {
+		pub.Signature = *payload.Signature
+	}
+	if payload.Token != nil {
+		pub.Token = *payload.Token
+	}
+	return &pub
+}
+
+// RedeemTokenSessionPayload is the session redeemToken action payload.
+type RedeemTokenSessionPayload struct {
+	// The signature of the token, sent along with it in the login link
+	Signature string `form:"signature" json:"signature" yaml:"signature" xml:"signature"`
+	// The token to redeem
+	Token uuid.UUID `form:"token" json:"token" yaml:"token" xml:"token"`
+}
+
+// Validate runs the validation rules defined in the design.
+func (payload *RedeemTokenSessionPayload) Validate() (err error) {
+
+	if payload.Signature == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`raw`, "signature"))
+	}
+	return
+}
+
+// Created sends a HTTP response with status code 201.
+func (ctx *RedeemTokenSessionContext) Created() error {
+	ctx.ResponseData.WriteHeader(201)
+	return nil
+}
+
+// Accepted sends a HTTP response with status code 202.
+func (ctx *RedeemTokenSessionContext) Accepted(r *TwoFactorChallenge) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+		ctx.ResponseData.Header().Set("Content-Type", "application/json")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
+	return ctx.ResponseData.Service.Send(ctx.Context, 202, r)
 }
 
-// NotFound sends a HTTP response with status code 404.
-func (ctx *UpdateSubscriberNewsletterContext) NotFound(r error) error {
+// Forbidden sends a HTTP response with status code 403.
+func (ctx *RedeemTokenSessionContext) Forbidden(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 404, r)
+	return ctx.ResponseData.Service.Send(ctx.Context, 403, r)
 }
 
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *UpdateSubscriberNewsletterContext) InternalServerError(r error) error {
+func (ctx *RedeemTokenSessionContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// ChangePasswordPasswordAuthContext provides the password-auth change-password action context.
-type ChangePasswordPasswordAuthContext struct {
+// RefreshSessionContext provides the session refresh action context.
+type RefreshSessionContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
-	Payload *ChangePasswordParams
+	XSession string
 }
 
-// NewChangePasswordPasswordAuthContext parses the incoming request URL and body, performs validations and creates the
-// context used by the password-auth controller change-password action.
-func NewChangePasswordPasswordAuthContext(ctx context.Context, r *http.Request, service *goa.Service) (*ChangePasswordPasswordAuthContext, error) {
+// NewRefreshSessionContext parses the incoming request URL and body, performs validations and creates the
+// context used by the session controller refresh action.
+func NewRefreshSessionContext(ctx context.Context, r *http.Request, service *goa.Service) (*RefreshSessionContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := ChangePasswordPasswordAuthContext{Context: ctx, ResponseData: resp, RequestData: req}
+	rctx := RefreshSessionContext{Context: ctx, ResponseData: resp, RequestData: req}
+	headerXSession := req.Header["X-Session"]
+	if len(headerXSession) == 0 {
+		err = goa.MergeErrors(err, goa.MissingHeaderError("X-Session"))
+	} else {
+		rawXSession := headerXSession[0]
+		req.Params["X-Session"] = []string{rawXSession}
+		rctx.XSession = rawXSession
+	}
 	return &rctx, err
 }
 
 // OK sends a HTTP response with status code 200.
-func (ctx *ChangePasswordPasswordAuthContext) OK(resp []byte) error {
+func (ctx *RefreshSessionContext) OK(resp []byte) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "OK")
+		ctx.ResponseData.Header().Set("Content-Type", "text/plain")
 	}
 	ctx.ResponseData.WriteHeader(200)
 	_, err := ctx.ResponseData.Write(resp)
@@ -2309,43 +2404,61 @@ func (ctx *ChangePasswordPasswordAuthContext) OK(resp []byte) error {
 }
 
 // BadRequest sends a HTTP response with status code 400.
-func (ctx *ChangePasswordPasswordAuthContext) BadRequest(r error) error {
+func (ctx *RefreshSessionContext) BadRequest(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
 }
 
+// Unauthorized sends a HTTP response with status code 401.
+func (ctx *RefreshSessionContext) Unauthorized(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 401, r)
+}
+
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *ChangePasswordPasswordAuthContext) InternalServerError(r error) error {
+func (ctx *RefreshSessionContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// ConfirmResetPasswordAuthContext provides the password-auth confirm-reset action context.
-type ConfirmResetPasswordAuthContext struct {
+// SendLoginCodeSessionContext provides the session send-login-code action context.
+type SendLoginCodeSessionContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
-	Payload *ResetPasswordParams
+	Email string
 }
 
-// NewConfirmResetPasswordAuthContext parses the incoming request URL and body, performs validations and creates the
-// context used by the password-auth controller confirm-reset action.
-func NewConfirmResetPasswordAuthContext(ctx context.Context, r *http.Request, service *goa.Service) (*ConfirmResetPasswordAuthContext, error) {
+// NewSendLoginCodeSessionContext parses the incoming request URL and body, performs validations and creates the
+// context used by the session controller send-login-code action.
+func NewSendLoginCodeSessionContext(ctx context.Context, r *http.Request, service *goa.Service) (*SendLoginCodeSessionContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := ConfirmResetPasswordAuthContext{Context: ctx, ResponseData: resp, RequestData: req}
+	rctx := SendLoginCodeSessionContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramEmail := req.Params["email"]
+	if len(paramEmail) == 0 {
+		err = goa.MergeErrors(err, goa.MissingParamError("email"))
+	} else {
+		rawEmail := paramEmail[0]
+		rctx.Email = rawEmail
+		if err2 := goa.ValidateFormat(goa.FormatEmail, rctx.Email); err2 != nil {
+			err = goa.MergeErrors(err, goa.InvalidFormatError(`email`, rctx.Email, goa.FormatEmail, err2))
+		}
+	}
 	return &rctx, err
 }
 
 // OK sends a HTTP response with status code 200.
-func (ctx *ConfirmResetPasswordAuthContext) OK(resp []byte) error {
+func (ctx *SendLoginCodeSessionContext) OK(resp []byte) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "OK")
 	}
@@ -2354,208 +2467,206 @@ func (ctx *ConfirmResetPasswordAuthContext) OK(resp []byte) error {
 	return err
 }
 
-// Forbidden sends a HTTP response with status code 403.
-func (ctx *ConfirmResetPasswordAuthContext) Forbidden(r error) error {
+// TooManyRequests sends a HTTP response with status code 429.
+func (ctx *SendLoginCodeSessionContext) TooManyRequests(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 403, r)
+	return ctx.ResponseData.Service.Send(ctx.Context, 429, r)
 }
 
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *ConfirmResetPasswordAuthContext) InternalServerError(r error) error {
+func (ctx *SendLoginCodeSessionContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// LoginPasswordAuthContext provides the password-auth login action context.
-type LoginPasswordAuthContext struct {
+// SendLoginLinkSessionContext provides the session send-login-link action context.
+type SendLoginLinkSessionContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
-	Token   *uuid.UUID
-	Payload *LoginParams
+	Email string
 }
 
-// NewLoginPasswordAuthContext parses the incoming request URL and body, performs validations and creates the
-// context used by the password-auth controller login action.
-func NewLoginPasswordAuthContext(ctx context.Context, r *http.Request, service *goa.Service) (*LoginPasswordAuthContext, error) {
+// NewSendLoginLinkSessionContext parses the incoming request URL and body, performs validations and creates the
+// context used by the session controller send-login-link action.
+func NewSendLoginLinkSessionContext(ctx context.Context, r *http.Request, service *goa.Service) (*SendLoginLinkSessionContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := LoginPasswordAuthContext{Context: ctx, ResponseData: resp, RequestData: req}
-	paramToken := req.Params["token"]
-	if len(paramToken) > 0 {
-		rawToken := paramToken[0]
-		if token, err2 := uuid.FromString(rawToken); err2 == nil {
-			tmp11 := &token
-			rctx.Token = tmp11
-		} else {
-			err = goa.MergeErrors(err, goa.InvalidParamTypeError("token", rawToken, "uuid"))
+	rctx := SendLoginLinkSessionContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramEmail := req.Params["email"]
+	if len(paramEmail) == 0 {
+		err = goa.MergeErrors(err, goa.MissingParamError("email"))
+	} else {
+		rawEmail := paramEmail[0]
+		rctx.Email = rawEmail
+		if err2 := goa.ValidateFormat(goa.FormatEmail, rctx.Email); err2 != nil {
+			err = goa.MergeErrors(err, goa.InvalidFormatError(`email`, rctx.Email, goa.FormatEmail, err2))
 		}
 	}
 	return &rctx, err
 }
 
-// OKAdmin sends a HTTP response with status code 200.
-func (ctx *LoginPasswordAuthContext) OKAdmin(r *UserAdmin) error {
-	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/json")
-	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
-}
-
 // OK sends a HTTP response with status code 200.
-func (ctx *LoginPasswordAuthContext) OK(r *User) error {
-	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/json")
-	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
-}
-
-// OKOwner sends a HTTP response with status code 200.
-func (ctx *LoginPasswordAuthContext) OKOwner(r *UserOwner) error {
+func (ctx *SendLoginLinkSessionContext) OK(resp []byte) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/json")
-	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
-}
-
-// BadRequest sends a HTTP response with status code 400.
-func (ctx *LoginPasswordAuthContext) BadRequest(r error) error {
-	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+		ctx.ResponseData.Header().Set("Content-Type", "OK")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
+	ctx.ResponseData.WriteHeader(200)
+	_, err := ctx.ResponseData.Write(resp)
+	return err
 }
 
-// Unauthorized sends a HTTP response with status code 401.
-func (ctx *LoginPasswordAuthContext) Unauthorized(r error) error {
+// TooManyRequests sends a HTTP response with status code 429.
+func (ctx *SendLoginLinkSessionContext) TooManyRequests(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 401, r)
+	return ctx.ResponseData.Service.Send(ctx.Context, 429, r)
 }
 
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *LoginPasswordAuthContext) InternalServerError(r error) error {
+func (ctx *SendLoginLinkSessionContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// RegisterPasswordAuthContext provides the password-auth register action context.
-type RegisterPasswordAuthContext struct {
+// SendPhoneLoginCodeSessionContext provides the session send-phone-login-code action context.
+type SendPhoneLoginCodeSessionContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
-	Payload *RegisterParams
+	Payload *SendPhoneLoginCodeSessionPayload
 }
 
-// NewRegisterPasswordAuthContext parses the incoming request URL and body, performs validations and creates the
-// context used by the password-auth controller register action.
-func NewRegisterPasswordAuthContext(ctx context.Context, r *http.Request, service *goa.Service) (*RegisterPasswordAuthContext, error) {
+// NewSendPhoneLoginCodeSessionContext parses the incoming request URL and body, performs validations and creates the
+// context used by the session controller send-phone-login-code action.
+func NewSendPhoneLoginCodeSessionContext(ctx context.Context, r *http.Request, service *goa.Service) (*SendPhoneLoginCodeSessionContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := RegisterPasswordAuthContext{Context: ctx, ResponseData: resp, RequestData: req}
+	rctx := SendPhoneLoginCodeSessionContext{Context: ctx, ResponseData: resp, RequestData: req}
 	return &rctx, err
 }
 
-// OKAdmin sends a HTTP response with status code 200.
-func (ctx *RegisterPasswordAuthContext) OKAdmin(r *UserAdmin) error {
-	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/json")
+// sendPhoneLoginCodeSessionPayload is the session send-phone-login-code action payload.
+type sendPhoneLoginCodeSessionPayload struct {
+	// Phone number in E.164 format
+	Phone *string `form:"phone,omitempty" json:"phone,omitempty" yaml:"phone,omitempty" xml:"phone,omitempty"`
+}
+
+// Validate runs the validation rules defined in the design.
+func (payload *sendPhoneLoginCodeSessionPayload) Validate() (err error) {
+	if payload.Phone == nil {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`raw`, "phone"))
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+	if payload.Phone != nil {
+		if ok := goa.ValidatePattern(`^\+[1-9][0-9]{6,14}$`, *payload.Phone); !ok {
+			err = goa.MergeErrors(err, goa.InvalidPatternError(`raw.phone`, *payload.Phone, `^\+[1-9][0-9]{6,14}$`))
+		}
+	}
+	return
 }
 
-// OK sends a HTTP response with status code 200.
-func (ctx *RegisterPasswordAuthContext) OK(r *User) error {
-	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/json")
+// Publicize creates SendPhoneLoginCodeSessionPayload from sendPhoneLoginCodeSessionPayload
+func (payload *sendPhoneLoginCodeSessionPayload) Publicize() *SendPhoneLoginCodeSessionPayload {
+	var pub SendPhoneLoginCodeSessionPayload
+	if payload.Phone != nil {
+		pub.Phone = *payload.Phone
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+	return &pub
 }
 
-// OKOwner sends a HTTP response with status code 200.
-func (ctx *RegisterPasswordAuthContext) OKOwner(r *UserOwner) error {
-	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/json")
+// SendPhoneLoginCodeSessionPayload is the session send-phone-login-code action payload.
+type SendPhoneLoginCodeSessionPayload struct {
+	// Phone number in E.164 format
+	Phone string `form:"phone" json:"phone" yaml:"phone" xml:"phone"`
+}
+
+// Validate runs the validation rules defined in the design.
+func (payload *SendPhoneLoginCodeSessionPayload) Validate() (err error) {
+	if payload.Phone == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`raw`, "phone"))
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+	if ok := goa.ValidatePattern(`^\+[1-9][0-9]{6,14}$`, payload.Phone); !ok {
+		err = goa.MergeErrors(err, goa.InvalidPatternError(`raw.phone`, payload.Phone, `^\+[1-9][0-9]{6,14}$`))
+	}
+	return
 }
 
-// BadRequest sends a HTTP response with status code 400.
-func (ctx *RegisterPasswordAuthContext) BadRequest(r error) error {
+// OK sends a HTTP response with status code 200.
+func (ctx *SendPhoneLoginCodeSessionContext) OK(resp []byte) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+		ctx.ResponseData.Header().Set("Content-Type", "OK")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
+	ctx.ResponseData.WriteHeader(200)
+	_, err := ctx.ResponseData.Write(resp)
+	return err
 }
 
-// Forbidden sends a HTTP response with status code 403.
-func (ctx *RegisterPasswordAuthContext) Forbidden(r error) error {
+// TooManyRequests sends a HTTP response with status code 429.
+func (ctx *SendPhoneLoginCodeSessionContext) TooManyRequests(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 403, r)
+	return ctx.ResponseData.Service.Send(ctx.Context, 429, r)
 }
 
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *RegisterPasswordAuthContext) InternalServerError(r error) error {
+func (ctx *SendPhoneLoginCodeSessionContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// RemovePasswordAuthContext provides the password-auth remove action context.
-type RemovePasswordAuthContext struct {
+// AttachToAccountSocialContext provides the social attach-to-account action context.
+type AttachToAccountSocialContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
+	Provider string
 }
 
-// NewRemovePasswordAuthContext parses the incoming request URL and body, performs validations and creates the
-// context used by the password-auth controller remove action.
-func NewRemovePasswordAuthContext(ctx context.Context, r *http.Request, service *goa.Service) (*RemovePasswordAuthContext, error) {
+// NewAttachToAccountSocialContext parses the incoming request URL and body, performs validations and creates the
+// context used by the social controller attach-to-account action.
+func NewAttachToAccountSocialContext(ctx context.Context, r *http.Request, service *goa.Service) (*AttachToAccountSocialContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := RemovePasswordAuthContext{Context: ctx, ResponseData: resp, RequestData: req}
+	rctx := AttachToAccountSocialContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramProvider := req.Params["provider"]
+	if len(paramProvider) == 0 {
+		err = goa.MergeErrors(err, goa.MissingParamError("provider"))
+	} else {
+		rawProvider := paramProvider[0]
+		rctx.Provider = rawProvider
+	}
 	return &rctx, err
 }
 
 // OK sends a HTTP response with status code 200.
-func (ctx *RemovePasswordAuthContext) OK(resp []byte) error {
+func (ctx *AttachToAccountSocialContext) OK(r *SocialConnectionMedia) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "OK")
+		ctx.ResponseData.Header().Set("Content-Type", "application/json")
 	}
-	ctx.ResponseData.WriteHeader(200)
-	_, err := ctx.ResponseData.Write(resp)
-	return err
-}
-
-// Forbidden sends a HTTP response with status code 403.
-func (ctx *RemovePasswordAuthContext) Forbidden(r error) error {
-	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
-	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 403, r)
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
 }
 
 // NotFound sends a HTTP response with status code 404.
-func (ctx *RemovePasswordAuthContext) NotFound(r error) error {
+func (ctx *AttachToAccountSocialContext) NotFound(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
@@ -2563,53 +2674,42 @@ func (ctx *RemovePasswordAuthContext) NotFound(r error) error {
 }
 
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *RemovePasswordAuthContext) InternalServerError(r error) error {
+func (ctx *AttachToAccountSocialContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// ResetPasswordAuthContext provides the password-auth reset action context.
-type ResetPasswordAuthContext struct {
+// DetachFromAccountSocialContext provides the social detach-from-account action context.
+type DetachFromAccountSocialContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
-	Email       string
-	RedirectURL string
+	Provider string
 }
 
-// NewResetPasswordAuthContext parses the incoming request URL and body, performs validations and creates the
-// context used by the password-auth controller reset action.
-func NewResetPasswordAuthContext(ctx context.Context, r *http.Request, service *goa.Service) (*ResetPasswordAuthContext, error) {
+// NewDetachFromAccountSocialContext parses the incoming request URL and body, performs validations and creates the
+// context used by the social controller detach-from-account action.
+func NewDetachFromAccountSocialContext(ctx context.Context, r *http.Request, service *goa.Service) (*DetachFromAccountSocialContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := ResetPasswordAuthContext{Context: ctx, ResponseData: resp, RequestData: req}
-	paramEmail := req.Params["email"]
-	if len(paramEmail) == 0 {
-		err = goa.MergeErrors(err, goa.MissingParamError("email"))
-	} else {
-		rawEmail := paramEmail[0]
-		rctx.Email = rawEmail
-		if err2 := goa.ValidateFormat(goa.FormatEmail, rctx.Email); err2 != nil {
-			err = goa.MergeErrors(err, goa.InvalidFormatError(`email`, rctx.Email, goa.FormatEmail, err2))
-		}
-	}
-	paramRedirectURL := req.Params["redirect-url"]
-	if len(paramRedirectURL) == 0 {
-		err = goa.MergeErrors(err, goa.MissingParamError("redirect-url"))
+	rctx := DetachFromAccountSocialContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramProvider := req.Params["provider"]
+	if len(paramProvider) == 0 {
+		err = goa.MergeErrors(err, goa.MissingParamError("provider"))
 	} else {
-		rawRedirectURL := paramRedirectURL[0]
-		rctx.RedirectURL = rawRedirectURL
+		rawProvider := paramProvider[0]
+		rctx.Provider = rawProvider
 	}
 	return &rctx, err
 }
 
 // OK sends a HTTP response with status code 200.
-func (ctx *ResetPasswordAuthContext) OK(resp []byte) error {
+func (ctx *DetachFromAccountSocialContext) OK(resp []byte) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "OK")
 	}
@@ -2618,444 +2718,468 @@ func (ctx *ResetPasswordAuthContext) OK(resp []byte) error {
 	return err
 }
 
+// Forbidden sends a HTTP response with status code 403.
+func (ctx *DetachFromAccountSocialContext) Forbidden(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 403, r)
+}
+
+// NotFound sends a HTTP response with status code 404.
+func (ctx *DetachFromAccountSocialContext) NotFound(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 404, r)
+}
+
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *ResetPasswordAuthContext) InternalServerError(r error) error {
+func (ctx *DetachFromAccountSocialContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// CleanLoginTokenSessionContext provides the session clean-login-token action context.
-type CleanLoginTokenSessionContext struct {
+// LoginSocialContext provides the social login action context.
+type LoginSocialContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
+	Provider string
+	Token    *uuid.UUID
 }
 
-// NewCleanLoginTokenSessionContext parses the incoming request URL and body, performs validations and creates the
-// context used by the session controller clean-login-token action.
-func NewCleanLoginTokenSessionContext(ctx context.Context, r *http.Request, service *goa.Service) (*CleanLoginTokenSessionContext, error) {
+// NewLoginSocialContext parses the incoming request URL and body, performs validations and creates the
+// context used by the social controller login action.
+func NewLoginSocialContext(ctx context.Context, r *http.Request, service *goa.Service) (*LoginSocialContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := CleanLoginTokenSessionContext{Context: ctx, ResponseData: resp, RequestData: req}
+	rctx := LoginSocialContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramProvider := req.Params["provider"]
+	if len(paramProvider) == 0 {
+		err = goa.MergeErrors(err, goa.MissingParamError("provider"))
+	} else {
+		rawProvider := paramProvider[0]
+		rctx.Provider = rawProvider
+	}
+	paramToken := req.Params["token"]
+	if len(paramToken) > 0 {
+		rawToken := paramToken[0]
+		if token, err2 := uuid.FromString(rawToken); err2 == nil {
+			tmp15 := &token
+			rctx.Token = tmp15
+		} else {
+			err = goa.MergeErrors(err, goa.InvalidParamTypeError("token", rawToken, "uuid"))
+		}
+	}
 	return &rctx, err
 }
 
 // OK sends a HTTP response with status code 200.
-func (ctx *CleanLoginTokenSessionContext) OK(resp []byte) error {
+func (ctx *LoginSocialContext) OK(r *SocialConnectionMedia) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "OK")
+		ctx.ResponseData.Header().Set("Content-Type", "application/json")
 	}
-	ctx.ResponseData.WriteHeader(200)
-	_, err := ctx.ResponseData.Write(resp)
-	return err
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
 }
 
-// Forbidden sends a HTTP response with status code 403.
-func (ctx *CleanLoginTokenSessionContext) Forbidden(r error) error {
+// NotFound sends a HTTP response with status code 404.
+func (ctx *LoginSocialContext) NotFound(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 403, r)
+	return ctx.ResponseData.Service.Send(ctx.Context, 404, r)
 }
 
-// CleanMergeTokenSessionContext provides the session clean-merge-token action context.
-type CleanMergeTokenSessionContext struct {
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *LoginSocialContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// ReceiveSocialContext provides the social receive action context.
+type ReceiveSocialContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
+	Binding  string
+	Code     string
+	Provider string
+	State    uuid.UUID
 }
 
-// NewCleanMergeTokenSessionContext parses the incoming request URL and body, performs validations and creates the
-// context used by the session controller clean-merge-token action.
-func NewCleanMergeTokenSessionContext(ctx context.Context, r *http.Request, service *goa.Service) (*CleanMergeTokenSessionContext, error) {
+// NewReceiveSocialContext parses the incoming request URL and body, performs validations and creates the
+// context used by the social controller receive action.
+func NewReceiveSocialContext(ctx context.Context, r *http.Request, service *goa.Service) (*ReceiveSocialContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := CleanMergeTokenSessionContext{Context: ctx, ResponseData: resp, RequestData: req}
+	rctx := ReceiveSocialContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramBinding := req.Params["binding"]
+	if len(paramBinding) == 0 {
+		err = goa.MergeErrors(err, goa.MissingParamError("binding"))
+	} else {
+		rawBinding := paramBinding[0]
+		rctx.Binding = rawBinding
+	}
+	paramCode := req.Params["code"]
+	if len(paramCode) == 0 {
+		err = goa.MergeErrors(err, goa.MissingParamError("code"))
+	} else {
+		rawCode := paramCode[0]
+		rctx.Code = rawCode
+	}
+	paramProvider := req.Params["provider"]
+	if len(paramProvider) == 0 {
+		err = goa.MergeErrors(err, goa.MissingParamError("provider"))
+	} else {
+		rawProvider := paramProvider[0]
+		rctx.Provider = rawProvider
+	}
+	paramState := req.Params["state"]
+	if len(paramState) == 0 {
+		err = goa.MergeErrors(err, goa.MissingParamError("state"))
+	} else {
+		rawState := paramState[0]
+		if state, err2 := uuid.FromString(rawState); err2 == nil {
+			rctx.State = state
+		} else {
+			err = goa.MergeErrors(err, goa.InvalidParamTypeError("state", rawState, "uuid"))
+		}
+	}
 	return &rctx, err
 }
 
 // OK sends a HTTP response with status code 200.
-func (ctx *CleanMergeTokenSessionContext) OK(resp []byte) error {
+func (ctx *ReceiveSocialContext) OK(r interface{}) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "OK")
+		ctx.ResponseData.Header().Set("Content-Type", "text/plain")
 	}
-	ctx.ResponseData.WriteHeader(200)
-	_, err := ctx.ResponseData.Write(resp)
-	return err
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
 }
 
-// Forbidden sends a HTTP response with status code 403.
-func (ctx *CleanMergeTokenSessionContext) Forbidden(r error) error {
+// BadRequest sends a HTTP response with status code 400.
+func (ctx *ReceiveSocialContext) BadRequest(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 403, r)
-}
-
-// CleanSessionsSessionContext provides the session clean-sessions action context.
-type CleanSessionsSessionContext struct {
-	context.Context
-	*goa.ResponseData
-	*goa.RequestData
+	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
 }
 
-// NewCleanSessionsSessionContext parses the incoming request URL and body, performs validations and creates the
-// context used by the session controller clean-sessions action.
-func NewCleanSessionsSessionContext(ctx context.Context, r *http.Request, service *goa.Service) (*CleanSessionsSessionContext, error) {
-	var err error
-	resp := goa.ContextResponse(ctx)
-	resp.Service = service
-	req := goa.ContextRequest(ctx)
-	req.Request = r
-	rctx := CleanSessionsSessionContext{Context: ctx, ResponseData: resp, RequestData: req}
-	return &rctx, err
+// Unauthorized sends a HTTP response with status code 401.
+func (ctx *ReceiveSocialContext) Unauthorized(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 401, r)
 }
 
-// OK sends a HTTP response with status code 200.
-func (ctx *CleanSessionsSessionContext) OK(resp []byte) error {
+// NotFound sends a HTTP response with status code 404.
+func (ctx *ReceiveSocialContext) NotFound(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "OK")
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
-	ctx.ResponseData.WriteHeader(200)
-	_, err := ctx.ResponseData.Write(resp)
-	return err
+	return ctx.ResponseData.Service.Send(ctx.Context, 404, r)
 }
 
-// Forbidden sends a HTTP response with status code 403.
-func (ctx *CleanSessionsSessionContext) Forbidden(r error) error {
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *ReceiveSocialContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 403, r)
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// GetSessionsSessionContext provides the session get-sessions action context.
-type GetSessionsSessionContext struct {
+// ReceiveFormSocialContext provides the social receive-form action context.
+type ReceiveFormSocialContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
+	Provider string
+	Payload  *SocialReceiveFormParams
 }
 
-// NewGetSessionsSessionContext parses the incoming request URL and body, performs validations and creates the
-// context used by the session controller get-sessions action.
-func NewGetSessionsSessionContext(ctx context.Context, r *http.Request, service *goa.Service) (*GetSessionsSessionContext, error) {
+// NewReceiveFormSocialContext parses the incoming request URL and body, performs validations and creates the
+// context used by the social controller receive-form action.
+func NewReceiveFormSocialContext(ctx context.Context, r *http.Request, service *goa.Service) (*ReceiveFormSocialContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := GetSessionsSessionContext{Context: ctx, ResponseData: resp, RequestData: req}
+	rctx := ReceiveFormSocialContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramProvider := req.Params["provider"]
+	if len(paramProvider) == 0 {
+		err = goa.MergeErrors(err, goa.MissingParamError("provider"))
+	} else {
+		rawProvider := paramProvider[0]
+		rctx.Provider = rawProvider
+	}
 	return &rctx, err
 }
 
-// OK sends a HTTP response with status code 200.
-func (ctx *GetSessionsSessionContext) OK(r *AllSessions) error {
+// SeeOther sends a HTTP response with status code 303.
+func (ctx *ReceiveFormSocialContext) SeeOther() error {
+	ctx.ResponseData.WriteHeader(303)
+	return nil
+}
+
+// BadRequest sends a HTTP response with status code 400.
+func (ctx *ReceiveFormSocialContext) BadRequest(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "all-sessions")
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
+}
+
+// NotFound sends a HTTP response with status code 404.
+func (ctx *ReceiveFormSocialContext) NotFound(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 404, r)
 }
 
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *GetSessionsSessionContext) InternalServerError(r error) error {
+func (ctx *ReceiveFormSocialContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// LogoutSessionContext provides the session logout action context.
-type LogoutSessionContext struct {
+// RegisterSocialContext provides the social register action context.
+type RegisterSocialContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
+	Provider string
+	Payload  *SocialRegisterParams
 }
 
-// NewLogoutSessionContext parses the incoming request URL and body, performs validations and creates the
-// context used by the session controller logout action.
-func NewLogoutSessionContext(ctx context.Context, r *http.Request, service *goa.Service) (*LogoutSessionContext, error) {
+// NewRegisterSocialContext parses the incoming request URL and body, performs validations and creates the
+// context used by the social controller register action.
+func NewRegisterSocialContext(ctx context.Context, r *http.Request, service *goa.Service) (*RegisterSocialContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := LogoutSessionContext{Context: ctx, ResponseData: resp, RequestData: req}
+	rctx := RegisterSocialContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramProvider := req.Params["provider"]
+	if len(paramProvider) == 0 {
+		err = goa.MergeErrors(err, goa.MissingParamError("provider"))
+	} else {
+		rawProvider := paramProvider[0]
+		rctx.Provider = rawProvider
+	}
 	return &rctx, err
 }
 
+// OKAdmin sends a HTTP response with status code 200.
+func (ctx *RegisterSocialContext) OKAdmin(r *UserAdmin) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/json")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
 // OK sends a HTTP response with status code 200.
-func (ctx *LogoutSessionContext) OK(resp []byte) error {
+func (ctx *RegisterSocialContext) OK(r *User) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "OK")
+		ctx.ResponseData.Header().Set("Content-Type", "application/json")
 	}
-	ctx.ResponseData.WriteHeader(200)
-	_, err := ctx.ResponseData.Write(resp)
-	return err
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// OKOwner sends a HTTP response with status code 200.
+func (ctx *RegisterSocialContext) OKOwner(r *UserOwner) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/json")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
 }
 
 // BadRequest sends a HTTP response with status code 400.
-func (ctx *LogoutSessionContext) BadRequest(r error) error {
+func (ctx *RegisterSocialContext) BadRequest(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
 }
 
+// Forbidden sends a HTTP response with status code 403.
+func (ctx *RegisterSocialContext) Forbidden(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 403, r)
+}
+
+// NotFound sends a HTTP response with status code 404.
+func (ctx *RegisterSocialContext) NotFound(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 404, r)
+}
+
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *LogoutSessionContext) InternalServerError(r error) error {
+func (ctx *RegisterSocialContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// LogoutOtherSessionContext provides the session logout-other action context.
-type LogoutOtherSessionContext struct {
+// RegisterURLSocialContext provides the social register-url action context.
+type RegisterURLSocialContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
+	Provider string
 }
 
-// NewLogoutOtherSessionContext parses the incoming request URL and body, performs validations and creates the
-// context used by the session controller logout-other action.
-func NewLogoutOtherSessionContext(ctx context.Context, r *http.Request, service *goa.Service) (*LogoutOtherSessionContext, error) {
+// NewRegisterURLSocialContext parses the incoming request URL and body, performs validations and creates the
+// context used by the social controller register-url action.
+func NewRegisterURLSocialContext(ctx context.Context, r *http.Request, service *goa.Service) (*RegisterURLSocialContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := LogoutOtherSessionContext{Context: ctx, ResponseData: resp, RequestData: req}
+	rctx := RegisterURLSocialContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramProvider := req.Params["provider"]
+	if len(paramProvider) == 0 {
+		err = goa.MergeErrors(err, goa.MissingParamError("provider"))
+	} else {
+		rawProvider := paramProvider[0]
+		rctx.Provider = rawProvider
+	}
 	return &rctx, err
 }
 
 // OK sends a HTTP response with status code 200.
-func (ctx *LogoutOtherSessionContext) OK(resp []byte) error {
+func (ctx *RegisterURLSocialContext) OK(r *SocialConnectionMedia) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "OK")
+		ctx.ResponseData.Header().Set("Content-Type", "application/json")
 	}
-	ctx.ResponseData.WriteHeader(200)
-	_, err := ctx.ResponseData.Write(resp)
-	return err
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
 }
 
-// BadRequest sends a HTTP response with status code 400.
-func (ctx *LogoutOtherSessionContext) BadRequest(r error) error {
+// NotFound sends a HTTP response with status code 404.
+func (ctx *RegisterURLSocialContext) NotFound(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
+	return ctx.ResponseData.Service.Send(ctx.Context, 404, r)
 }
 
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *LogoutOtherSessionContext) InternalServerError(r error) error {
+func (ctx *RegisterURLSocialContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// LogoutSpecificSessionContext provides the session logout-specific action context.
-type LogoutSpecificSessionContext struct {
+// IntrospectTokenContext provides the token introspect action context.
+type IntrospectTokenContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
-	Session   string
-	SessionID string
+	Payload *TokenParams
 }
 
-// NewLogoutSpecificSessionContext parses the incoming request URL and body, performs validations and creates the
-// context used by the session controller logout-specific action.
-func NewLogoutSpecificSessionContext(ctx context.Context, r *http.Request, service *goa.Service) (*LogoutSpecificSessionContext, error) {
+// NewIntrospectTokenContext parses the incoming request URL and body, performs validations and creates the
+// context used by the token controller introspect action.
+func NewIntrospectTokenContext(ctx context.Context, r *http.Request, service *goa.Service) (*IntrospectTokenContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := LogoutSpecificSessionContext{Context: ctx, ResponseData: resp, RequestData: req}
-	paramSession := req.Params["session"]
-	if len(paramSession) > 0 {
-		rawSession := paramSession[0]
-		rctx.Session = rawSession
-	}
-	paramSessionID := req.Params["session-id"]
-	if len(paramSessionID) == 0 {
-		err = goa.MergeErrors(err, goa.MissingParamError("session-id"))
-	} else {
-		rawSessionID := paramSessionID[0]
-		rctx.SessionID = rawSessionID
-	}
+	rctx := IntrospectTokenContext{Context: ctx, ResponseData: resp, RequestData: req}
 	return &rctx, err
 }
 
 // OK sends a HTTP response with status code 200.
-func (ctx *LogoutSpecificSessionContext) OK(resp []byte) error {
+func (ctx *IntrospectTokenContext) OK(r *TokenIntrospection) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "OK")
+		ctx.ResponseData.Header().Set("Content-Type", "application/json")
 	}
-	ctx.ResponseData.WriteHeader(200)
-	_, err := ctx.ResponseData.Write(resp)
-	return err
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
 }
 
 // BadRequest sends a HTTP response with status code 400.
-func (ctx *LogoutSpecificSessionContext) BadRequest(r error) error {
+func (ctx *IntrospectTokenContext) BadRequest(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
 }
 
-// NotFound sends a HTTP response with status code 404.
-func (ctx *LogoutSpecificSessionContext) NotFound(r error) error {
-	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
-	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 404, r)
-}
-
-// InternalServerError sends a HTTP response with status code 500.
-func (ctx *LogoutSpecificSessionContext) InternalServerError(r error) error {
-	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
-	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
-}
-
-// RedeemTokenSessionContext provides the session redeemToken action context.
-type RedeemTokenSessionContext struct {
-	context.Context
-	*goa.ResponseData
-	*goa.RequestData
-	Payload *RedeemTokenSessionPayload
-}
-
-// NewRedeemTokenSessionContext parses the incoming request URL and body, performs validations and creates the
-// context used by the session controller redeemToken action.
-func NewRedeemTokenSessionContext(ctx context.Context, r *http.Request, service *goa.Service) (*RedeemTokenSessionContext, error) {
-	var err error
-	resp := goa.ContextResponse(ctx)
-	resp.Service = service
-	req := goa.ContextRequest(ctx)
-	req.Request = r
-	rctx := RedeemTokenSessionContext{Context: ctx, ResponseData: resp, RequestData: req}
-	return &rctx, err
-}
-
-// redeemTokenSessionPayload is the session redeemToken action payload.
-type redeemTokenSessionPayload struct {
-	// The token to redeem
-	Token *uuid.UUID `form:"token,omitempty" json:"token,omitempty" yaml:"token,omitempty" xml:"token,omitempty"`
-}
-
-// Validate runs the validation rules defined in the design.
-func (payload *redeemTokenSessionPayload) Validate() (err error) {
-	if payload.Token == nil {
-		err = goa.MergeErrors(err, goa.MissingAttributeError(`raw`, "token"))
-	}
-	return
-}
-
-// Publicize creates RedeemTokenSessionPayload from redeemTokenSessionPayload
-func (payload *redeemTokenSessionPayload) Publicize() *RedeemTokenSessionPayload {
-	var pub RedeemTokenSessionPayload
-	if payload.Token != nil {
-		pub.Token = *payload.Token
-	}
-	return &pub
-}
-
-// RedeemTokenSessionPayload is the session redeemToken action payload.
-type RedeemTokenSessionPayload struct {
-	// The token to redeem
-	Token uuid.UUID `form:"token" json:"token" yaml:"token" xml:"token"`
-}
-
-// Created sends a HTTP response with status code 201.
-func (ctx *RedeemTokenSessionContext) Created() error {
-	ctx.ResponseData.WriteHeader(201)
-	return nil
-}
-
-// Forbidden sends a HTTP response with status code 403.
-func (ctx *RedeemTokenSessionContext) Forbidden(r error) error {
-	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
-	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 403, r)
-}
-
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *RedeemTokenSessionContext) InternalServerError(r error) error {
+func (ctx *IntrospectTokenContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// RefreshSessionContext provides the session refresh action context.
-type RefreshSessionContext struct {
+// RevokeTokenContext provides the token revoke action context.
+type RevokeTokenContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
-	XSession string
+	Payload *TokenParams
 }
 
-// NewRefreshSessionContext parses the incoming request URL and body, performs validations and creates the
-// context used by the session controller refresh action.
-func NewRefreshSessionContext(ctx context.Context, r *http.Request, service *goa.Service) (*RefreshSessionContext, error) {
+// NewRevokeTokenContext parses the incoming request URL and body, performs validations and creates the
+// context used by the token controller revoke action.
+func NewRevokeTokenContext(ctx context.Context, r *http.Request, service *goa.Service) (*RevokeTokenContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := RefreshSessionContext{Context: ctx, ResponseData: resp, RequestData: req}
-	headerXSession := req.Header["X-Session"]
-	if len(headerXSession) == 0 {
-		err = goa.MergeErrors(err, goa.MissingHeaderError("X-Session"))
-	} else {
-		rawXSession := headerXSession[0]
-		req.Params["X-Session"] = []string{rawXSession}
-		rctx.XSession = rawXSession
-	}
+	rctx := RevokeTokenContext{Context: ctx, ResponseData: resp, RequestData: req}
 	return &rctx, err
 }
 
 // OK sends a HTTP response with status code 200.
-func (ctx *RefreshSessionContext) OK(resp []byte) error {
-	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "text/plain")
-	}
+func (ctx *RevokeTokenContext) OK() error {
 	ctx.ResponseData.WriteHeader(200)
-	_, err := ctx.ResponseData.Write(resp)
-	return err
+	return nil
 }
 
 // BadRequest sends a HTTP response with status code 400.
-func (ctx *RefreshSessionContext) BadRequest(r error) error {
+func (ctx *RevokeTokenContext) BadRequest(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
 }
 
-// Unauthorized sends a HTTP response with status code 401.
-func (ctx *RefreshSessionContext) Unauthorized(r error) error {
+// Forbidden sends a HTTP response with status code 403.
+func (ctx *RevokeTokenContext) Forbidden(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 401, r)
+	return ctx.ResponseData.Service.Send(ctx.Context, 403, r)
 }
 
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *RefreshSessionContext) InternalServerError(r error) error {
+func (ctx *RevokeTokenContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
@@ -3319,173 +3443,1104 @@ func (ctx *RegisterTwitterContext) OKOwner(r *UserOwner) error {
 	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
 }
 
-// BadRequest sends a HTTP response with status code 400.
-func (ctx *RegisterTwitterContext) BadRequest(r error) error {
+// BadRequest sends a HTTP response with status code 400.
+func (ctx *RegisterTwitterContext) BadRequest(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
+}
+
+// Forbidden sends a HTTP response with status code 403.
+func (ctx *RegisterTwitterContext) Forbidden(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 403, r)
+}
+
+// NotFound sends a HTTP response with status code 404.
+func (ctx *RegisterTwitterContext) NotFound(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 404, r)
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *RegisterTwitterContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// RegisterURLTwitterContext provides the twitter register-url action context.
+type RegisterURLTwitterContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+}
+
+// NewRegisterURLTwitterContext parses the incoming request URL and body, performs validations and creates the
+// context used by the twitter controller register-url action.
+func NewRegisterURLTwitterContext(ctx context.Context, r *http.Request, service *goa.Service) (*RegisterURLTwitterContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := RegisterURLTwitterContext{Context: ctx, ResponseData: resp, RequestData: req}
+	return &rctx, err
+}
+
+// OK sends a HTTP response with status code 200.
+func (ctx *RegisterURLTwitterContext) OK(resp []byte) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "text/plain")
+	}
+	ctx.ResponseData.WriteHeader(200)
+	_, err := ctx.ResponseData.Write(resp)
+	return err
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *RegisterURLTwitterContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// ConfirmTwoFactorContext provides the two-factor confirm action context.
+type ConfirmTwoFactorContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	Payload *TwoFactorPasscodeParams
+}
+
+// NewConfirmTwoFactorContext parses the incoming request URL and body, performs validations and creates the
+// context used by the two-factor controller confirm action.
+func NewConfirmTwoFactorContext(ctx context.Context, r *http.Request, service *goa.Service) (*ConfirmTwoFactorContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := ConfirmTwoFactorContext{Context: ctx, ResponseData: resp, RequestData: req}
+	return &rctx, err
+}
+
+// OK sends a HTTP response with status code 200.
+func (ctx *ConfirmTwoFactorContext) OK(resp []byte) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "OK")
+	}
+	ctx.ResponseData.WriteHeader(200)
+	_, err := ctx.ResponseData.Write(resp)
+	return err
+}
+
+// BadRequest sends a HTTP response with status code 400.
+func (ctx *ConfirmTwoFactorContext) BadRequest(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
+}
+
+// Unauthorized sends a HTTP response with status code 401.
+func (ctx *ConfirmTwoFactorContext) Unauthorized(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 401, r)
+}
+
+// Forbidden sends a HTTP response with status code 403.
+func (ctx *ConfirmTwoFactorContext) Forbidden(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 403, r)
+}
+
+// TooManyRequests sends a HTTP response with status code 429.
+func (ctx *ConfirmTwoFactorContext) TooManyRequests(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 429, r)
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *ConfirmTwoFactorContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// DisableTwoFactorContext provides the two-factor disable action context.
+type DisableTwoFactorContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	Payload *TwoFactorPasscodeParams
+}
+
+// NewDisableTwoFactorContext parses the incoming request URL and body, performs validations and creates the
+// context used by the two-factor controller disable action.
+func NewDisableTwoFactorContext(ctx context.Context, r *http.Request, service *goa.Service) (*DisableTwoFactorContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := DisableTwoFactorContext{Context: ctx, ResponseData: resp, RequestData: req}
+	return &rctx, err
+}
+
+// OK sends a HTTP response with status code 200.
+func (ctx *DisableTwoFactorContext) OK(resp []byte) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "OK")
+	}
+	ctx.ResponseData.WriteHeader(200)
+	_, err := ctx.ResponseData.Write(resp)
+	return err
+}
+
+// BadRequest sends a HTTP response with status code 400.
+func (ctx *DisableTwoFactorContext) BadRequest(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
+}
+
+// Unauthorized sends a HTTP response with status code 401.
+func (ctx *DisableTwoFactorContext) Unauthorized(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 401, r)
+}
+
+// Forbidden sends a HTTP response with status code 403.
+func (ctx *DisableTwoFactorContext) Forbidden(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 403, r)
+}
+
+// TooManyRequests sends a HTTP response with status code 429.
+func (ctx *DisableTwoFactorContext) TooManyRequests(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 429, r)
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *DisableTwoFactorContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// EnrollTwoFactorContext provides the two-factor enroll action context.
+type EnrollTwoFactorContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+}
+
+// NewEnrollTwoFactorContext parses the incoming request URL and body, performs validations and creates the
+// context used by the two-factor controller enroll action.
+func NewEnrollTwoFactorContext(ctx context.Context, r *http.Request, service *goa.Service) (*EnrollTwoFactorContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := EnrollTwoFactorContext{Context: ctx, ResponseData: resp, RequestData: req}
+	return &rctx, err
+}
+
+// OK sends a HTTP response with status code 200.
+func (ctx *EnrollTwoFactorContext) OK(r *TwoFactorEnroll) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/json")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// BadRequest sends a HTTP response with status code 400.
+func (ctx *EnrollTwoFactorContext) BadRequest(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *EnrollTwoFactorContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// SendPhoneCodeTwoFactorContext provides the two-factor send-phone-code action context.
+type SendPhoneCodeTwoFactorContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	Challenge uuid.UUID
+}
+
+// NewSendPhoneCodeTwoFactorContext parses the incoming request URL and body, performs validations and creates the
+// context used by the two-factor controller send-phone-code action.
+func NewSendPhoneCodeTwoFactorContext(ctx context.Context, r *http.Request, service *goa.Service) (*SendPhoneCodeTwoFactorContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := SendPhoneCodeTwoFactorContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramChallenge := req.Params["challenge"]
+	if len(paramChallenge) == 0 {
+		err = goa.MergeErrors(err, goa.MissingParamError("challenge"))
+	} else {
+		rawChallenge := paramChallenge[0]
+		if challenge, err2 := uuid.FromString(rawChallenge); err2 == nil {
+			rctx.Challenge = challenge
+		} else {
+			err = goa.MergeErrors(err, goa.InvalidParamTypeError("challenge", rawChallenge, "uuid"))
+		}
+	}
+	return &rctx, err
+}
+
+// OK sends a HTTP response with status code 200.
+func (ctx *SendPhoneCodeTwoFactorContext) OK(resp []byte) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "OK")
+	}
+	ctx.ResponseData.WriteHeader(200)
+	_, err := ctx.ResponseData.Write(resp)
+	return err
+}
+
+// NotFound sends a HTTP response with status code 404.
+func (ctx *SendPhoneCodeTwoFactorContext) NotFound(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 404, r)
+}
+
+// TooManyRequests sends a HTTP response with status code 429.
+func (ctx *SendPhoneCodeTwoFactorContext) TooManyRequests(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 429, r)
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *SendPhoneCodeTwoFactorContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// VerifyTwoFactorContext provides the two-factor verify action context.
+type VerifyTwoFactorContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	Payload *TwoFactorVerifyParams
+}
+
+// NewVerifyTwoFactorContext parses the incoming request URL and body, performs validations and creates the
+// context used by the two-factor controller verify action.
+func NewVerifyTwoFactorContext(ctx context.Context, r *http.Request, service *goa.Service) (*VerifyTwoFactorContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := VerifyTwoFactorContext{Context: ctx, ResponseData: resp, RequestData: req}
+	return &rctx, err
+}
+
+// OKAdmin sends a HTTP response with status code 200.
+func (ctx *VerifyTwoFactorContext) OKAdmin(r *UserAdmin) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/json")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// OK sends a HTTP response with status code 200.
+func (ctx *VerifyTwoFactorContext) OK(r *User) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/json")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// OKOwner sends a HTTP response with status code 200.
+func (ctx *VerifyTwoFactorContext) OKOwner(r *UserOwner) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/json")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// Unauthorized sends a HTTP response with status code 401.
+func (ctx *VerifyTwoFactorContext) Unauthorized(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 401, r)
+}
+
+// Forbidden sends a HTTP response with status code 403.
+func (ctx *VerifyTwoFactorContext) Forbidden(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 403, r)
+}
+
+// TooManyRequests sends a HTTP response with status code 429.
+func (ctx *VerifyTwoFactorContext) TooManyRequests(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 429, r)
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *VerifyTwoFactorContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// AddPluginUserContext provides the user add-plugin action context.
+type AddPluginUserContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	Payload *UserPlugin
+}
+
+// NewAddPluginUserContext parses the incoming request URL and body, performs validations and creates the
+// context used by the user controller add-plugin action.
+func NewAddPluginUserContext(ctx context.Context, r *http.Request, service *goa.Service) (*AddPluginUserContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := AddPluginUserContext{Context: ctx, ResponseData: resp, RequestData: req}
+	return &rctx, err
+}
+
+// OKAdmin sends a HTTP response with status code 200.
+func (ctx *AddPluginUserContext) OKAdmin(r *UserAdmin) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/json")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// OK sends a HTTP response with status code 200.
+func (ctx *AddPluginUserContext) OK(r *User) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/json")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// OKOwner sends a HTTP response with status code 200.
+func (ctx *AddPluginUserContext) OKOwner(r *UserOwner) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/json")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// BadRequest sends a HTTP response with status code 400.
+func (ctx *AddPluginUserContext) BadRequest(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *AddPluginUserContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// DeactivateUserContext provides the user deactivate action context.
+type DeactivateUserContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	Admin *bool
+	ID    *string
+}
+
+// NewDeactivateUserContext parses the incoming request URL and body, performs validations and creates the
+// context used by the user controller deactivate action.
+func NewDeactivateUserContext(ctx context.Context, r *http.Request, service *goa.Service) (*DeactivateUserContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := DeactivateUserContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramAdmin := req.Params["admin"]
+	if len(paramAdmin) > 0 {
+		rawAdmin := paramAdmin[0]
+		if admin, err2 := strconv.ParseBool(rawAdmin); err2 == nil {
+			tmp13 := &admin
+			rctx.Admin = tmp13
+		} else {
+			err = goa.MergeErrors(err, goa.InvalidParamTypeError("admin", rawAdmin, "boolean"))
+		}
+	}
+	paramID := req.Params["id"]
+	if len(paramID) > 0 {
+		rawID := paramID[0]
+		rctx.ID = &rawID
+	}
+	return &rctx, err
+}
+
+// OK sends a HTTP response with status code 200.
+func (ctx *DeactivateUserContext) OK(resp []byte) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "OK")
+	}
+	ctx.ResponseData.WriteHeader(200)
+	_, err := ctx.ResponseData.Write(resp)
+	return err
+}
+
+// Forbidden sends a HTTP response with status code 403.
+func (ctx *DeactivateUserContext) Forbidden(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 403, r)
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *DeactivateUserContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// GetAllUsersUserContext provides the user get-all-users action context.
+type GetAllUsersUserContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+}
+
+// NewGetAllUsersUserContext parses the incoming request URL and body, performs validations and creates the
+// context used by the user controller get-all-users action.
+func NewGetAllUsersUserContext(ctx context.Context, r *http.Request, service *goa.Service) (*GetAllUsersUserContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := GetAllUsersUserContext{Context: ctx, ResponseData: resp, RequestData: req}
+	return &rctx, err
+}
+
+// OK sends a HTTP response with status code 200.
+func (ctx *GetAllUsersUserContext) OK(r interface{}) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "user")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// BadRequest sends a HTTP response with status code 400.
+func (ctx *GetAllUsersUserContext) BadRequest(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
+}
+
+// Forbidden sends a HTTP response with status code 403.
+func (ctx *GetAllUsersUserContext) Forbidden(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 403, r)
+}
+
+// NotFound sends a HTTP response with status code 404.
+func (ctx *GetAllUsersUserContext) NotFound(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 404, r)
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *GetAllUsersUserContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// GetByEmailUserContext provides the user get-by-email action context.
+type GetByEmailUserContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	Email string
+}
+
+// NewGetByEmailUserContext parses the incoming request URL and body, performs validations and creates the
+// context used by the user controller get-by-email action.
+func NewGetByEmailUserContext(ctx context.Context, r *http.Request, service *goa.Service) (*GetByEmailUserContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := GetByEmailUserContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramEmail := req.Params["email"]
+	if len(paramEmail) == 0 {
+		err = goa.MergeErrors(err, goa.MissingParamError("email"))
+	} else {
+		rawEmail := paramEmail[0]
+		rctx.Email = rawEmail
+		if err2 := goa.ValidateFormat(goa.FormatEmail, rctx.Email); err2 != nil {
+			err = goa.MergeErrors(err, goa.InvalidFormatError(`email`, rctx.Email, goa.FormatEmail, err2))
+		}
+	}
+	return &rctx, err
+}
+
+// OKAdmin sends a HTTP response with status code 200.
+func (ctx *GetByEmailUserContext) OKAdmin(r *UserAdmin) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/json")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// OK sends a HTTP response with status code 200.
+func (ctx *GetByEmailUserContext) OK(r *User) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/json")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// OKOwner sends a HTTP response with status code 200.
+func (ctx *GetByEmailUserContext) OKOwner(r *UserOwner) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/json")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// NotFound sends a HTTP response with status code 404.
+func (ctx *GetByEmailUserContext) NotFound(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 404, r)
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *GetByEmailUserContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// GetManyUserContext provides the user get-many action context.
+type GetManyUserContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	ID []string
+}
+
+// NewGetManyUserContext parses the incoming request URL and body, performs validations and creates the
+// context used by the user controller get-many action.
+func NewGetManyUserContext(ctx context.Context, r *http.Request, service *goa.Service) (*GetManyUserContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := GetManyUserContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramID := req.Params["id"]
+	if len(paramID) > 0 {
+		params := paramID
+		rctx.ID = params
+	}
+	return &rctx, err
+}
+
+// OKAdmin sends a HTTP response with status code 200.
+func (ctx *GetManyUserContext) OKAdmin(r UserAdminCollection) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/json")
+	}
+	if r == nil {
+		r = UserAdminCollection{}
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// OK sends a HTTP response with status code 200.
+func (ctx *GetManyUserContext) OK(r UserCollection) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/json")
+	}
+	if r == nil {
+		r = UserCollection{}
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// OKOwner sends a HTTP response with status code 200.
+func (ctx *GetManyUserContext) OKOwner(r UserOwnerCollection) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/json")
+	}
+	if r == nil {
+		r = UserOwnerCollection{}
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// BadRequest sends a HTTP response with status code 400.
+func (ctx *GetManyUserContext) BadRequest(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
+}
+
+// NotFound sends a HTTP response with status code 404.
+func (ctx *GetManyUserContext) NotFound(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 404, r)
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *GetManyUserContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// GetAuthsUserContext provides the user getAuths action context.
+type GetAuthsUserContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	UserID *string
+}
+
+// NewGetAuthsUserContext parses the incoming request URL and body, performs validations and creates the
+// context used by the user controller getAuths action.
+func NewGetAuthsUserContext(ctx context.Context, r *http.Request, service *goa.Service) (*GetAuthsUserContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := GetAuthsUserContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramUserID := req.Params["userID"]
+	if len(paramUserID) > 0 {
+		rawUserID := paramUserID[0]
+		rctx.UserID = &rawUserID
+	}
+	return &rctx, err
+}
+
+// OK sends a HTTP response with status code 200.
+func (ctx *GetAuthsUserContext) OK(r *AuthStatus) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/json")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// BadRequest sends a HTTP response with status code 400.
+func (ctx *GetAuthsUserContext) BadRequest(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
+}
+
+// Unauthorized sends a HTTP response with status code 401.
+func (ctx *GetAuthsUserContext) Unauthorized(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 401, r)
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *GetAuthsUserContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// ResendVerifyEmailUserContext provides the user resend-verify-email action context.
+type ResendVerifyEmailUserContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+}
+
+// NewResendVerifyEmailUserContext parses the incoming request URL and body, performs validations and creates the
+// context used by the user controller resend-verify-email action.
+func NewResendVerifyEmailUserContext(ctx context.Context, r *http.Request, service *goa.Service) (*ResendVerifyEmailUserContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := ResendVerifyEmailUserContext{Context: ctx, ResponseData: resp, RequestData: req}
+	return &rctx, err
+}
+
+// OK sends a HTTP response with status code 200.
+func (ctx *ResendVerifyEmailUserContext) OK(resp []byte) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "OK")
+	}
+	ctx.ResponseData.WriteHeader(200)
+	_, err := ctx.ResponseData.Write(resp)
+	return err
+}
+
+// NotFound sends a HTTP response with status code 404.
+func (ctx *ResendVerifyEmailUserContext) NotFound(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 404, r)
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *ResendVerifyEmailUserContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// RetrieveUserContext provides the user retrieve action context.
+type RetrieveUserContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	UserID *string
+}
+
+// NewRetrieveUserContext parses the incoming request URL and body, performs validations and creates the
+// context used by the user controller retrieve action.
+func NewRetrieveUserContext(ctx context.Context, r *http.Request, service *goa.Service) (*RetrieveUserContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := RetrieveUserContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramUserID := req.Params["user-id"]
+	if len(paramUserID) > 0 {
+		rawUserID := paramUserID[0]
+		rctx.UserID = &rawUserID
+	}
+	return &rctx, err
+}
+
+// OKAdmin sends a HTTP response with status code 200.
+func (ctx *RetrieveUserContext) OKAdmin(r *UserAdmin) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/json")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// OK sends a HTTP response with status code 200.
+func (ctx *RetrieveUserContext) OK(r *User) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/json")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// OKOwner sends a HTTP response with status code 200.
+func (ctx *RetrieveUserContext) OKOwner(r *UserOwner) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/json")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+}
+
+// BadRequest sends a HTTP response with status code 400.
+func (ctx *RetrieveUserContext) BadRequest(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
+}
+
+// Unauthorized sends a HTTP response with status code 401.
+func (ctx *RetrieveUserContext) Unauthorized(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 401, r)
+}
+
+// NotFound sends a HTTP response with status code 404.
+func (ctx *RetrieveUserContext) NotFound(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 404, r)
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *RetrieveUserContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// SendPhoneCodeUserContext provides the user send-phone-code action context.
+type SendPhoneCodeUserContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+}
+
+// NewSendPhoneCodeUserContext parses the incoming request URL and body, performs validations and creates the
+// context used by the user controller send-phone-code action.
+func NewSendPhoneCodeUserContext(ctx context.Context, r *http.Request, service *goa.Service) (*SendPhoneCodeUserContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := SendPhoneCodeUserContext{Context: ctx, ResponseData: resp, RequestData: req}
+	return &rctx, err
+}
+
+// OK sends a HTTP response with status code 200.
+func (ctx *SendPhoneCodeUserContext) OK(resp []byte) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+		ctx.ResponseData.Header().Set("Content-Type", "OK")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
+	ctx.ResponseData.WriteHeader(200)
+	_, err := ctx.ResponseData.Write(resp)
+	return err
 }
 
-// Forbidden sends a HTTP response with status code 403.
-func (ctx *RegisterTwitterContext) Forbidden(r error) error {
+// NotFound sends a HTTP response with status code 404.
+func (ctx *SendPhoneCodeUserContext) NotFound(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 403, r)
+	return ctx.ResponseData.Service.Send(ctx.Context, 404, r)
 }
 
-// NotFound sends a HTTP response with status code 404.
-func (ctx *RegisterTwitterContext) NotFound(r error) error {
+// TooManyRequests sends a HTTP response with status code 429.
+func (ctx *SendPhoneCodeUserContext) TooManyRequests(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 404, r)
+	return ctx.ResponseData.Service.Send(ctx.Context, 429, r)
 }
 
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *RegisterTwitterContext) InternalServerError(r error) error {
+func (ctx *SendPhoneCodeUserContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// RegisterURLTwitterContext provides the twitter register-url action context.
-type RegisterURLTwitterContext struct {
+// SendVerifyCodeUserContext provides the user send-verify-code action context.
+type SendVerifyCodeUserContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
 }
 
-// NewRegisterURLTwitterContext parses the incoming request URL and body, performs validations and creates the
-// context used by the twitter controller register-url action.
-func NewRegisterURLTwitterContext(ctx context.Context, r *http.Request, service *goa.Service) (*RegisterURLTwitterContext, error) {
+// NewSendVerifyCodeUserContext parses the incoming request URL and body, performs validations and creates the
+// context used by the user controller send-verify-code action.
+func NewSendVerifyCodeUserContext(ctx context.Context, r *http.Request, service *goa.Service) (*SendVerifyCodeUserContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := RegisterURLTwitterContext{Context: ctx, ResponseData: resp, RequestData: req}
+	rctx := SendVerifyCodeUserContext{Context: ctx, ResponseData: resp, RequestData: req}
 	return &rctx, err
 }
 
 // OK sends a HTTP response with status code 200.
-func (ctx *RegisterURLTwitterContext) OK(resp []byte) error {
+func (ctx *SendVerifyCodeUserContext) OK(resp []byte) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "text/plain")
+		ctx.ResponseData.Header().Set("Content-Type", "OK")
 	}
 	ctx.ResponseData.WriteHeader(200)
 	_, err := ctx.ResponseData.Write(resp)
 	return err
 }
 
+// NotFound sends a HTTP response with status code 404.
+func (ctx *SendVerifyCodeUserContext) NotFound(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 404, r)
+}
+
+// TooManyRequests sends a HTTP response with status code 429.
+func (ctx *SendVerifyCodeUserContext) TooManyRequests(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 429, r)
+}
+
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *RegisterURLTwitterContext) InternalServerError(r error) error {
+func (ctx *SendVerifyCodeUserContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// AddPluginUserContext provides the user add-plugin action context.
-type AddPluginUserContext struct {
+// UnlockUserContext provides the user unlock action context.
+type UnlockUserContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
-	Payload *UserPlugin
+	UID string
 }
 
-// NewAddPluginUserContext parses the incoming request URL and body, performs validations and creates the
-// context used by the user controller add-plugin action.
-func NewAddPluginUserContext(ctx context.Context, r *http.Request, service *goa.Service) (*AddPluginUserContext, error) {
+// NewUnlockUserContext parses the incoming request URL and body, performs validations and creates the
+// context used by the user controller unlock action.
+func NewUnlockUserContext(ctx context.Context, r *http.Request, service *goa.Service) (*UnlockUserContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := AddPluginUserContext{Context: ctx, ResponseData: resp, RequestData: req}
-	return &rctx, err
-}
-
-// OKAdmin sends a HTTP response with status code 200.
-func (ctx *AddPluginUserContext) OKAdmin(r *UserAdmin) error {
-	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/json")
+	rctx := UnlockUserContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramUID := req.Params["uid"]
+	if len(paramUID) == 0 {
+		err = goa.MergeErrors(err, goa.MissingParamError("uid"))
+	} else {
+		rawUID := paramUID[0]
+		rctx.UID = rawUID
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+	return &rctx, err
 }
 
 // OK sends a HTTP response with status code 200.
-func (ctx *AddPluginUserContext) OK(r *User) error {
+func (ctx *UnlockUserContext) OK(resp []byte) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/json")
+		ctx.ResponseData.Header().Set("Content-Type", "OK")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+	ctx.ResponseData.WriteHeader(200)
+	_, err := ctx.ResponseData.Write(resp)
+	return err
 }
 
-// OKOwner sends a HTTP response with status code 200.
-func (ctx *AddPluginUserContext) OKOwner(r *UserOwner) error {
+// Forbidden sends a HTTP response with status code 403.
+func (ctx *UnlockUserContext) Forbidden(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/json")
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+	return ctx.ResponseData.Service.Send(ctx.Context, 403, r)
 }
 
-// BadRequest sends a HTTP response with status code 400.
-func (ctx *AddPluginUserContext) BadRequest(r error) error {
+// NotFound sends a HTTP response with status code 404.
+func (ctx *UnlockUserContext) NotFound(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
+	return ctx.ResponseData.Service.Send(ctx.Context, 404, r)
 }
 
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *AddPluginUserContext) InternalServerError(r error) error {
+func (ctx *UnlockUserContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// DeactivateUserContext provides the user deactivate action context.
-type DeactivateUserContext struct {
+// UpdateUserContext provides the user update action context.
+type UpdateUserContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
-	Admin *bool
-	ID    *string
+	Payload *UserParams
 }
 
-// NewDeactivateUserContext parses the incoming request URL and body, performs validations and creates the
-// context used by the user controller deactivate action.
-func NewDeactivateUserContext(ctx context.Context, r *http.Request, service *goa.Service) (*DeactivateUserContext, error) {
+// NewUpdateUserContext parses the incoming request URL and body, performs validations and creates the
+// context used by the user controller update action.
+func NewUpdateUserContext(ctx context.Context, r *http.Request, service *goa.Service) (*UpdateUserContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := DeactivateUserContext{Context: ctx, ResponseData: resp, RequestData: req}
-	paramAdmin := req.Params["admin"]
-	if len(paramAdmin) > 0 {
-		rawAdmin := paramAdmin[0]
-		if admin, err2 := strconv.ParseBool(rawAdmin); err2 == nil {
-			tmp13 := &admin
-			rctx.Admin = tmp13
-		} else {
-			err = goa.MergeErrors(err, goa.InvalidParamTypeError("admin", rawAdmin, "boolean"))
-		}
-	}
-	paramID := req.Params["id"]
-	if len(paramID) > 0 {
-		rawID := paramID[0]
-		rctx.ID = &rawID
-	}
+	rctx := UpdateUserContext{Context: ctx, ResponseData: resp, RequestData: req}
 	return &rctx, err
 }
 
 // OK sends a HTTP response with status code 200.
-func (ctx *DeactivateUserContext) OK(resp []byte) error {
+func (ctx *UpdateUserContext) OK(resp []byte) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "OK")
 	}
@@ -3494,8 +4549,16 @@ func (ctx *DeactivateUserContext) OK(resp []byte) error {
 	return err
 }
 
+// BadRequest sends a HTTP response with status code 400.
+func (ctx *UpdateUserContext) BadRequest(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
+}
+
 // Forbidden sends a HTTP response with status code 403.
-func (ctx *DeactivateUserContext) Forbidden(r error) error {
+func (ctx *UpdateUserContext) Forbidden(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
@@ -3503,50 +4566,51 @@ func (ctx *DeactivateUserContext) Forbidden(r error) error {
 }
 
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *DeactivateUserContext) InternalServerError(r error) error {
+func (ctx *UpdateUserContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// GetAllUsersUserContext provides the user get-all-users action context.
-type GetAllUsersUserContext struct {
+// UpdateAdminUserContext provides the user update-admin action context.
+type UpdateAdminUserContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
+	UID     *string
+	Payload *UserParamsAdmin
 }
 
-// NewGetAllUsersUserContext parses the incoming request URL and body, performs validations and creates the
-// context used by the user controller get-all-users action.
-func NewGetAllUsersUserContext(ctx context.Context, r *http.Request, service *goa.Service) (*GetAllUsersUserContext, error) {
+// NewUpdateAdminUserContext parses the incoming request URL and body, performs validations and creates the
+// context used by the user controller update-admin action.
+func NewUpdateAdminUserContext(ctx context.Context, r *http.Request, service *goa.Service) (*UpdateAdminUserContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := GetAllUsersUserContext{Context: ctx, ResponseData: resp, RequestData: req}
+	rctx := UpdateAdminUserContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramUID := req.Params["uid"]
+	if len(paramUID) > 0 {
+		rawUID := paramUID[0]
+		rctx.UID = &rawUID
+	}
 	return &rctx, err
 }
 
 // OK sends a HTTP response with status code 200.
-func (ctx *GetAllUsersUserContext) OK(r interface{}) error {
-	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "user")
-	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
-}
-
-// BadRequest sends a HTTP response with status code 400.
-func (ctx *GetAllUsersUserContext) BadRequest(r error) error {
+func (ctx *UpdateAdminUserContext) OK(resp []byte) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+		ctx.ResponseData.Header().Set("Content-Type", "OK")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
+	ctx.ResponseData.WriteHeader(200)
+	_, err := ctx.ResponseData.Write(resp)
+	return err
 }
 
 // Forbidden sends a HTTP response with status code 403.
-func (ctx *GetAllUsersUserContext) Forbidden(r error) error {
+func (ctx *UpdateAdminUserContext) Forbidden(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
@@ -3554,7 +4618,7 @@ func (ctx *GetAllUsersUserContext) Forbidden(r error) error {
 }
 
 // NotFound sends a HTTP response with status code 404.
-func (ctx *GetAllUsersUserContext) NotFound(r error) error {
+func (ctx *UpdateAdminUserContext) NotFound(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
@@ -3562,45 +4626,35 @@ func (ctx *GetAllUsersUserContext) NotFound(r error) error {
 }
 
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *GetAllUsersUserContext) InternalServerError(r error) error {
+func (ctx *UpdateAdminUserContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// GetByEmailUserContext provides the user get-by-email action context.
-type GetByEmailUserContext struct {
+// UpdatePluginPermissionsUserContext provides the user update-plugin-permissions action context.
+type UpdatePluginPermissionsUserContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
-	Email string
+	Payload *UserPlugin
 }
 
-// NewGetByEmailUserContext parses the incoming request URL and body, performs validations and creates the
-// context used by the user controller get-by-email action.
-func NewGetByEmailUserContext(ctx context.Context, r *http.Request, service *goa.Service) (*GetByEmailUserContext, error) {
+// NewUpdatePluginPermissionsUserContext parses the incoming request URL and body, performs validations and creates the
+// context used by the user controller update-plugin-permissions action.
+func NewUpdatePluginPermissionsUserContext(ctx context.Context, r *http.Request, service *goa.Service) (*UpdatePluginPermissionsUserContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := GetByEmailUserContext{Context: ctx, ResponseData: resp, RequestData: req}
-	paramEmail := req.Params["email"]
-	if len(paramEmail) == 0 {
-		err = goa.MergeErrors(err, goa.MissingParamError("email"))
-	} else {
-		rawEmail := paramEmail[0]
-		rctx.Email = rawEmail
-		if err2 := goa.ValidateFormat(goa.FormatEmail, rctx.Email); err2 != nil {
-			err = goa.MergeErrors(err, goa.InvalidFormatError(`email`, rctx.Email, goa.FormatEmail, err2))
-		}
-	}
+	rctx := UpdatePluginPermissionsUserContext{Context: ctx, ResponseData: resp, RequestData: req}
 	return &rctx, err
 }
 
 // OKAdmin sends a HTTP response with status code 200.
-func (ctx *GetByEmailUserContext) OKAdmin(r *UserAdmin) error {
+func (ctx *UpdatePluginPermissionsUserContext) OKAdmin(r *UserAdmin) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/json")
 	}
@@ -3608,7 +4662,7 @@ func (ctx *GetByEmailUserContext) OKAdmin(r *UserAdmin) error {
 }
 
 // OK sends a HTTP response with status code 200.
-func (ctx *GetByEmailUserContext) OK(r *User) error {
+func (ctx *UpdatePluginPermissionsUserContext) OK(r *User) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/json")
 	}
@@ -3616,97 +4670,126 @@ func (ctx *GetByEmailUserContext) OK(r *User) error {
 }
 
 // OKOwner sends a HTTP response with status code 200.
-func (ctx *GetByEmailUserContext) OKOwner(r *UserOwner) error {
+func (ctx *UpdatePluginPermissionsUserContext) OKOwner(r *UserOwner) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/json")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
 }
 
-// NotFound sends a HTTP response with status code 404.
-func (ctx *GetByEmailUserContext) NotFound(r error) error {
+// BadRequest sends a HTTP response with status code 400.
+func (ctx *UpdatePluginPermissionsUserContext) BadRequest(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 404, r)
+	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
 }
 
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *GetByEmailUserContext) InternalServerError(r error) error {
+func (ctx *UpdatePluginPermissionsUserContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// GetManyUserContext provides the user get-many action context.
-type GetManyUserContext struct {
+// ValidateEmailUserContext provides the user validate-email action context.
+type ValidateEmailUserContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
-	ID []string
+	ValidateID string
 }
 
-// NewGetManyUserContext parses the incoming request URL and body, performs validations and creates the
-// context used by the user controller get-many action.
-func NewGetManyUserContext(ctx context.Context, r *http.Request, service *goa.Service) (*GetManyUserContext, error) {
+// NewValidateEmailUserContext parses the incoming request URL and body, performs validations and creates the
+// context used by the user controller validate-email action.
+func NewValidateEmailUserContext(ctx context.Context, r *http.Request, service *goa.Service) (*ValidateEmailUserContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := GetManyUserContext{Context: ctx, ResponseData: resp, RequestData: req}
-	paramID := req.Params["id"]
-	if len(paramID) > 0 {
-		params := paramID
-		rctx.ID = params
+	rctx := ValidateEmailUserContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramValidateID := req.Params["validateID"]
+	if len(paramValidateID) > 0 {
+		rawValidateID := paramValidateID[0]
+		rctx.ValidateID = rawValidateID
 	}
 	return &rctx, err
 }
 
-// OKAdmin sends a HTTP response with status code 200.
-func (ctx *GetManyUserContext) OKAdmin(r UserAdminCollection) error {
+// SeeOther sends a HTTP response with status code 303.
+func (ctx *ValidateEmailUserContext) SeeOther() error {
+	ctx.ResponseData.WriteHeader(303)
+	return nil
+}
+
+// NotFound sends a HTTP response with status code 404.
+func (ctx *ValidateEmailUserContext) NotFound(resp []byte) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/json")
+		ctx.ResponseData.Header().Set("Content-Type", "text/html")
 	}
-	if r == nil {
-		r = UserAdminCollection{}
+	ctx.ResponseData.WriteHeader(404)
+	_, err := ctx.ResponseData.Write(resp)
+	return err
+}
+
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *ValidateEmailUserContext) InternalServerError(r error) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// VerifyEmailCodeUserContext provides the user verify-email-code action context.
+type VerifyEmailCodeUserContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	Payload *EmailCodeParams
+}
+
+// NewVerifyEmailCodeUserContext parses the incoming request URL and body, performs validations and creates the
+// context used by the user controller verify-email-code action.
+func NewVerifyEmailCodeUserContext(ctx context.Context, r *http.Request, service *goa.Service) (*VerifyEmailCodeUserContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := VerifyEmailCodeUserContext{Context: ctx, ResponseData: resp, RequestData: req}
+	return &rctx, err
 }
 
 // OK sends a HTTP response with status code 200.
-func (ctx *GetManyUserContext) OK(r UserCollection) error {
+func (ctx *VerifyEmailCodeUserContext) OK(resp []byte) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/json")
-	}
-	if r == nil {
-		r = UserCollection{}
+		ctx.ResponseData.Header().Set("Content-Type", "OK")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+	ctx.ResponseData.WriteHeader(200)
+	_, err := ctx.ResponseData.Write(resp)
+	return err
 }
 
-// OKOwner sends a HTTP response with status code 200.
-func (ctx *GetManyUserContext) OKOwner(r UserOwnerCollection) error {
+// BadRequest sends a HTTP response with status code 400.
+func (ctx *VerifyEmailCodeUserContext) BadRequest(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/json")
-	}
-	if r == nil {
-		r = UserOwnerCollection{}
+		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
 }
 
-// BadRequest sends a HTTP response with status code 400.
-func (ctx *GetManyUserContext) BadRequest(r error) error {
+// Forbidden sends a HTTP response with status code 403.
+func (ctx *VerifyEmailCodeUserContext) Forbidden(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
+	return ctx.ResponseData.Service.Send(ctx.Context, 403, r)
 }
 
 // NotFound sends a HTTP response with status code 404.
-func (ctx *GetManyUserContext) NotFound(r error) error {
+func (ctx *VerifyEmailCodeUserContext) NotFound(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
@@ -3714,142 +4797,127 @@ func (ctx *GetManyUserContext) NotFound(r error) error {
 }
 
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *GetManyUserContext) InternalServerError(r error) error {
+func (ctx *VerifyEmailCodeUserContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// GetAuthsUserContext provides the user getAuths action context.
-type GetAuthsUserContext struct {
+// VerifyPhoneUserContext provides the user verify-phone action context.
+type VerifyPhoneUserContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
-	UserID *string
+	Payload *PhoneCodeParams
 }
 
-// NewGetAuthsUserContext parses the incoming request URL and body, performs validations and creates the
-// context used by the user controller getAuths action.
-func NewGetAuthsUserContext(ctx context.Context, r *http.Request, service *goa.Service) (*GetAuthsUserContext, error) {
+// NewVerifyPhoneUserContext parses the incoming request URL and body, performs validations and creates the
+// context used by the user controller verify-phone action.
+func NewVerifyPhoneUserContext(ctx context.Context, r *http.Request, service *goa.Service) (*VerifyPhoneUserContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := GetAuthsUserContext{Context: ctx, ResponseData: resp, RequestData: req}
-	paramUserID := req.Params["userID"]
-	if len(paramUserID) > 0 {
-		rawUserID := paramUserID[0]
-		rctx.UserID = &rawUserID
-	}
+	rctx := VerifyPhoneUserContext{Context: ctx, ResponseData: resp, RequestData: req}
 	return &rctx, err
 }
 
 // OK sends a HTTP response with status code 200.
-func (ctx *GetAuthsUserContext) OK(r *AuthStatus) error {
+func (ctx *VerifyPhoneUserContext) OK(resp []byte) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/json")
+		ctx.ResponseData.Header().Set("Content-Type", "OK")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
+	ctx.ResponseData.WriteHeader(200)
+	_, err := ctx.ResponseData.Write(resp)
+	return err
 }
 
 // BadRequest sends a HTTP response with status code 400.
-func (ctx *GetAuthsUserContext) BadRequest(r error) error {
+func (ctx *VerifyPhoneUserContext) BadRequest(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
 }
 
-// Unauthorized sends a HTTP response with status code 401.
-func (ctx *GetAuthsUserContext) Unauthorized(r error) error {
+// Forbidden sends a HTTP response with status code 403.
+func (ctx *VerifyPhoneUserContext) Forbidden(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 401, r)
+	return ctx.ResponseData.Service.Send(ctx.Context, 403, r)
 }
 
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *GetAuthsUserContext) InternalServerError(r error) error {
+func (ctx *VerifyPhoneUserContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// ResendVerifyEmailUserContext provides the user resend-verify-email action context.
-type ResendVerifyEmailUserContext struct {
+// ListWebauthnContext provides the webauthn list action context.
+type ListWebauthnContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
 }
 
-// NewResendVerifyEmailUserContext parses the incoming request URL and body, performs validations and creates the
-// context used by the user controller resend-verify-email action.
-func NewResendVerifyEmailUserContext(ctx context.Context, r *http.Request, service *goa.Service) (*ResendVerifyEmailUserContext, error) {
+// NewListWebauthnContext parses the incoming request URL and body, performs validations and creates the
+// context used by the webauthn controller list action.
+func NewListWebauthnContext(ctx context.Context, r *http.Request, service *goa.Service) (*ListWebauthnContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := ResendVerifyEmailUserContext{Context: ctx, ResponseData: resp, RequestData: req}
+	rctx := ListWebauthnContext{Context: ctx, ResponseData: resp, RequestData: req}
 	return &rctx, err
 }
 
 // OK sends a HTTP response with status code 200.
-func (ctx *ResendVerifyEmailUserContext) OK(resp []byte) error {
+func (ctx *ListWebauthnContext) OK(r WebauthnCredentialCollection) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "OK")
+		ctx.ResponseData.Header().Set("Content-Type", "application/json")
 	}
-	ctx.ResponseData.WriteHeader(200)
-	_, err := ctx.ResponseData.Write(resp)
-	return err
-}
-
-// NotFound sends a HTTP response with status code 404.
-func (ctx *ResendVerifyEmailUserContext) NotFound(r error) error {
-	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+	if r == nil {
+		r = WebauthnCredentialCollection{}
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 404, r)
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
 }
 
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *ResendVerifyEmailUserContext) InternalServerError(r error) error {
+func (ctx *ListWebauthnContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// RetrieveUserContext provides the user retrieve action context.
-type RetrieveUserContext struct {
+// LoginFinishWebauthnContext provides the webauthn login-finish action context.
+type LoginFinishWebauthnContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
-	UserID *string
+	Payload *WebauthnFinishParams
 }
 
-// NewRetrieveUserContext parses the incoming request URL and body, performs validations and creates the
-// context used by the user controller retrieve action.
-func NewRetrieveUserContext(ctx context.Context, r *http.Request, service *goa.Service) (*RetrieveUserContext, error) {
+// NewLoginFinishWebauthnContext parses the incoming request URL and body, performs validations and creates the
+// context used by the webauthn controller login-finish action.
+func NewLoginFinishWebauthnContext(ctx context.Context, r *http.Request, service *goa.Service) (*LoginFinishWebauthnContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := RetrieveUserContext{Context: ctx, ResponseData: resp, RequestData: req}
-	paramUserID := req.Params["user-id"]
-	if len(paramUserID) > 0 {
-		rawUserID := paramUserID[0]
-		rctx.UserID = &rawUserID
-	}
+	rctx := LoginFinishWebauthnContext{Context: ctx, ResponseData: resp, RequestData: req}
 	return &rctx, err
 }
 
 // OKAdmin sends a HTTP response with status code 200.
-func (ctx *RetrieveUserContext) OKAdmin(r *UserAdmin) error {
+func (ctx *LoginFinishWebauthnContext) OKAdmin(r *UserAdmin) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/json")
 	}
@@ -3857,7 +4925,7 @@ func (ctx *RetrieveUserContext) OKAdmin(r *UserAdmin) error {
 }
 
 // OK sends a HTTP response with status code 200.
-func (ctx *RetrieveUserContext) OK(r *User) error {
+func (ctx *LoginFinishWebauthnContext) OK(r *User) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/json")
 	}
@@ -3865,7 +4933,7 @@ func (ctx *RetrieveUserContext) OK(r *User) error {
 }
 
 // OKOwner sends a HTTP response with status code 200.
-func (ctx *RetrieveUserContext) OKOwner(r *UserOwner) error {
+func (ctx *LoginFinishWebauthnContext) OKOwner(r *UserOwner) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/json")
 	}
@@ -3873,7 +4941,7 @@ func (ctx *RetrieveUserContext) OKOwner(r *UserOwner) error {
 }
 
 // BadRequest sends a HTTP response with status code 400.
-func (ctx *RetrieveUserContext) BadRequest(r error) error {
+func (ctx *LoginFinishWebauthnContext) BadRequest(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
@@ -3881,111 +4949,165 @@ func (ctx *RetrieveUserContext) BadRequest(r error) error {
 }
 
 // Unauthorized sends a HTTP response with status code 401.
-func (ctx *RetrieveUserContext) Unauthorized(r error) error {
+func (ctx *LoginFinishWebauthnContext) Unauthorized(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 401, r)
 }
 
-// NotFound sends a HTTP response with status code 404.
-func (ctx *RetrieveUserContext) NotFound(r error) error {
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *LoginFinishWebauthnContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 404, r)
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// LoginStartWebauthnContext provides the webauthn login-start action context.
+type LoginStartWebauthnContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+	Payload *WebauthnLoginParams
+}
+
+// NewLoginStartWebauthnContext parses the incoming request URL and body, performs validations and creates the
+// context used by the webauthn controller login-start action.
+func NewLoginStartWebauthnContext(ctx context.Context, r *http.Request, service *goa.Service) (*LoginStartWebauthnContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := LoginStartWebauthnContext{Context: ctx, ResponseData: resp, RequestData: req}
+	return &rctx, err
+}
+
+// OK sends a HTTP response with status code 200.
+func (ctx *LoginStartWebauthnContext) OK(r *WebauthnOptions) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/json")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
 }
 
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *RetrieveUserContext) InternalServerError(r error) error {
+func (ctx *LoginStartWebauthnContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// UpdateUserContext provides the user update action context.
-type UpdateUserContext struct {
+// RegisterFinishWebauthnContext provides the webauthn register-finish action context.
+type RegisterFinishWebauthnContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
-	Payload *UserParams
+	Payload *WebauthnFinishParams
 }
 
-// NewUpdateUserContext parses the incoming request URL and body, performs validations and creates the
-// context used by the user controller update action.
-func NewUpdateUserContext(ctx context.Context, r *http.Request, service *goa.Service) (*UpdateUserContext, error) {
+// NewRegisterFinishWebauthnContext parses the incoming request URL and body, performs validations and creates the
+// context used by the webauthn controller register-finish action.
+func NewRegisterFinishWebauthnContext(ctx context.Context, r *http.Request, service *goa.Service) (*RegisterFinishWebauthnContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := UpdateUserContext{Context: ctx, ResponseData: resp, RequestData: req}
+	rctx := RegisterFinishWebauthnContext{Context: ctx, ResponseData: resp, RequestData: req}
 	return &rctx, err
 }
 
 // OK sends a HTTP response with status code 200.
-func (ctx *UpdateUserContext) OK(resp []byte) error {
+func (ctx *RegisterFinishWebauthnContext) OK(r *WebauthnCredential) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "OK")
+		ctx.ResponseData.Header().Set("Content-Type", "application/json")
 	}
-	ctx.ResponseData.WriteHeader(200)
-	_, err := ctx.ResponseData.Write(resp)
-	return err
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
 }
 
 // BadRequest sends a HTTP response with status code 400.
-func (ctx *UpdateUserContext) BadRequest(r error) error {
+func (ctx *RegisterFinishWebauthnContext) BadRequest(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
 }
 
-// Forbidden sends a HTTP response with status code 403.
-func (ctx *UpdateUserContext) Forbidden(r error) error {
+// InternalServerError sends a HTTP response with status code 500.
+func (ctx *RegisterFinishWebauthnContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 403, r)
+	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+}
+
+// RegisterStartWebauthnContext provides the webauthn register-start action context.
+type RegisterStartWebauthnContext struct {
+	context.Context
+	*goa.ResponseData
+	*goa.RequestData
+}
+
+// NewRegisterStartWebauthnContext parses the incoming request URL and body, performs validations and creates the
+// context used by the webauthn controller register-start action.
+func NewRegisterStartWebauthnContext(ctx context.Context, r *http.Request, service *goa.Service) (*RegisterStartWebauthnContext, error) {
+	var err error
+	resp := goa.ContextResponse(ctx)
+	resp.Service = service
+	req := goa.ContextRequest(ctx)
+	req.Request = r
+	rctx := RegisterStartWebauthnContext{Context: ctx, ResponseData: resp, RequestData: req}
+	return &rctx, err
+}
+
+// OK sends a HTTP response with status code 200.
+func (ctx *RegisterStartWebauthnContext) OK(r *WebauthnOptions) error {
+	if ctx.ResponseData.Header().Get("Content-Type") == "" {
+		ctx.ResponseData.Header().Set("Content-Type", "application/json")
+	}
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
 }
 
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *UpdateUserContext) InternalServerError(r error) error {
+func (ctx *RegisterStartWebauthnContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// UpdateAdminUserContext provides the user update-admin action context.
-type UpdateAdminUserContext struct {
+// RemoveWebauthnContext provides the webauthn remove action context.
+type RemoveWebauthnContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
-	UID     *string
-	Payload *UserParamsAdmin
+	ID string
 }
 
-// NewUpdateAdminUserContext parses the incoming request URL and body, performs validations and creates the
-// context used by the user controller update-admin action.
-func NewUpdateAdminUserContext(ctx context.Context, r *http.Request, service *goa.Service) (*UpdateAdminUserContext, error) {
+// NewRemoveWebauthnContext parses the incoming request URL and body, performs validations and creates the
+// context used by the webauthn controller remove action.
+func NewRemoveWebauthnContext(ctx context.Context, r *http.Request, service *goa.Service) (*RemoveWebauthnContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := UpdateAdminUserContext{Context: ctx, ResponseData: resp, RequestData: req}
-	paramUID := req.Params["uid"]
-	if len(paramUID) > 0 {
-		rawUID := paramUID[0]
-		rctx.UID = &rawUID
+	rctx := RemoveWebauthnContext{Context: ctx, ResponseData: resp, RequestData: req}
+	paramID := req.Params["id"]
+	if len(paramID) == 0 {
+		err = goa.MergeErrors(err, goa.MissingParamError("id"))
+	} else {
+		rawID := paramID[0]
+		rctx.ID = rawID
 	}
 	return &rctx, err
 }
 
 // OK sends a HTTP response with status code 200.
-func (ctx *UpdateAdminUserContext) OK(resp []byte) error {
+func (ctx *RemoveWebauthnContext) OK(resp []byte) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "OK")
 	}
@@ -3995,7 +5117,7 @@ func (ctx *UpdateAdminUserContext) OK(resp []byte) error {
 }
 
 // Forbidden sends a HTTP response with status code 403.
-func (ctx *UpdateAdminUserContext) Forbidden(r error) error {
+func (ctx *RemoveWebauthnContext) Forbidden(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
@@ -4003,7 +5125,7 @@ func (ctx *UpdateAdminUserContext) Forbidden(r error) error {
 }
 
 // NotFound sends a HTTP response with status code 404.
-func (ctx *UpdateAdminUserContext) NotFound(r error) error {
+func (ctx *RemoveWebauthnContext) NotFound(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
@@ -4011,118 +5133,71 @@ func (ctx *UpdateAdminUserContext) NotFound(r error) error {
 }
 
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *UpdateAdminUserContext) InternalServerError(r error) error {
+func (ctx *RemoveWebauthnContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// UpdatePluginPermissionsUserContext provides the user update-plugin-permissions action context.
-type UpdatePluginPermissionsUserContext struct {
+// KeySetWellKnownContext provides the well-known key-set action context.
+type KeySetWellKnownContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
-	Payload *UserPlugin
 }
 
-// NewUpdatePluginPermissionsUserContext parses the incoming request URL and body, performs validations and creates the
-// context used by the user controller update-plugin-permissions action.
-func NewUpdatePluginPermissionsUserContext(ctx context.Context, r *http.Request, service *goa.Service) (*UpdatePluginPermissionsUserContext, error) {
+// NewKeySetWellKnownContext parses the incoming request URL and body, performs validations and creates the
+// context used by the well-known controller key-set action.
+func NewKeySetWellKnownContext(ctx context.Context, r *http.Request, service *goa.Service) (*KeySetWellKnownContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := UpdatePluginPermissionsUserContext{Context: ctx, ResponseData: resp, RequestData: req}
+	rctx := KeySetWellKnownContext{Context: ctx, ResponseData: resp, RequestData: req}
 	return &rctx, err
 }
 
-// OKAdmin sends a HTTP response with status code 200.
-func (ctx *UpdatePluginPermissionsUserContext) OKAdmin(r *UserAdmin) error {
-	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/json")
-	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
-}
-
 // OK sends a HTTP response with status code 200.
-func (ctx *UpdatePluginPermissionsUserContext) OK(r *User) error {
-	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/json")
-	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
-}
-
-// OKOwner sends a HTTP response with status code 200.
-func (ctx *UpdatePluginPermissionsUserContext) OKOwner(r *UserOwner) error {
+func (ctx *KeySetWellKnownContext) OK(r *JSONWebKeySet) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/json")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
 }
 
-// BadRequest sends a HTTP response with status code 400.
-func (ctx *UpdatePluginPermissionsUserContext) BadRequest(r error) error {
-	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
-	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 400, r)
-}
-
 // InternalServerError sends a HTTP response with status code 500.
-func (ctx *UpdatePluginPermissionsUserContext) InternalServerError(r error) error {
+func (ctx *KeySetWellKnownContext) InternalServerError(r error) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
 		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
 	}
 	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
 }
 
-// ValidateEmailUserContext provides the user validate-email action context.
-type ValidateEmailUserContext struct {
+// OpenidConfigurationWellKnownContext provides the well-known openid-configuration action context.
+type OpenidConfigurationWellKnownContext struct {
 	context.Context
 	*goa.ResponseData
 	*goa.RequestData
-	ValidateID string
 }
 
-// NewValidateEmailUserContext parses the incoming request URL and body, performs validations and creates the
-// context used by the user controller validate-email action.
-func NewValidateEmailUserContext(ctx context.Context, r *http.Request, service *goa.Service) (*ValidateEmailUserContext, error) {
+// NewOpenidConfigurationWellKnownContext parses the incoming request URL and body, performs validations and creates the
+// context used by the well-known controller openid-configuration action.
+func NewOpenidConfigurationWellKnownContext(ctx context.Context, r *http.Request, service *goa.Service) (*OpenidConfigurationWellKnownContext, error) {
 	var err error
 	resp := goa.ContextResponse(ctx)
 	resp.Service = service
 	req := goa.ContextRequest(ctx)
 	req.Request = r
-	rctx := ValidateEmailUserContext{Context: ctx, ResponseData: resp, RequestData: req}
-	paramValidateID := req.Params["validateID"]
-	if len(paramValidateID) > 0 {
-		rawValidateID := paramValidateID[0]
-		rctx.ValidateID = rawValidateID
-	}
+	rctx := OpenidConfigurationWellKnownContext{Context: ctx, ResponseData: resp, RequestData: req}
 	return &rctx, err
 }
 
-// SeeOther sends a HTTP response with status code 303.
-func (ctx *ValidateEmailUserContext) SeeOther() error {
-	ctx.ResponseData.WriteHeader(303)
-	return nil
-}
-
-// NotFound sends a HTTP response with status code 404.
-func (ctx *ValidateEmailUserContext) NotFound(resp []byte) error {
-	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "text/html")
-	}
-	ctx.ResponseData.WriteHeader(404)
-	_, err := ctx.ResponseData.Write(resp)
-	return err
-}
-
-// InternalServerError sends a HTTP response with status code 500.
-func (ctx *ValidateEmailUserContext) InternalServerError(r error) error {
+// OK sends a HTTP response with status code 200.
+func (ctx *OpenidConfigurationWellKnownContext) OK(r *OpenidConfiguration) error {
 	if ctx.ResponseData.Header().Get("Content-Type") == "" {
-		ctx.ResponseData.Header().Set("Content-Type", "application/vnd.goa.error")
+		ctx.ResponseData.Header().Set("Content-Type", "application/json")
 	}
-	return ctx.ResponseData.Service.Send(ctx.Context, 500, r)
+	return ctx.ResponseData.Service.Send(ctx.Context, 200, r)
 }
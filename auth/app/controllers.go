@@ -14,6 +14,7 @@ import (
 	"context"
 	"github.com/goadesign/goa"
 	"github.com/goadesign/goa/cors"
+	"github.com/goadesign/goa/encoding/form"
 	"net/http"
 )
 
@@ -26,182 +27,13 @@ func initService(service *goa.Service) {
 	service.Decoder.Register(goa.NewJSONDecoder, "application/json")
 	service.Decoder.Register(goa.NewGobDecoder, "application/gob", "application/x-gob")
 	service.Decoder.Register(goa.NewXMLDecoder, "application/xml")
+	service.Decoder.Register(form.NewDecoder, "application/x-www-form-urlencoded")
 
 	// Setup default encoder and decoder
 	service.Encoder.Register(goa.NewJSONEncoder, "*/*")
 	service.Decoder.Register(goa.NewJSONDecoder, "*/*")
 }
 
-// AmazonController is the controller interface for the Amazon actions.
-type AmazonController interface {
-	goa.Muxer
-	AttachToAccount(*AttachToAccountAmazonContext) error
-	DetachFromAccount(*DetachFromAccountAmazonContext) error
-	Login(*LoginAmazonContext) error
-	Receive(*ReceiveAmazonContext) error
-	Register(*RegisterAmazonContext) error
-	RegisterURL(*RegisterURLAmazonContext) error
-}
-
-// MountAmazonController "mounts" a Amazon resource controller on the given service.
-func MountAmazonController(service *goa.Service, ctrl AmazonController) {
-	initService(service)
-	var h goa.Handler
-	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/amazon/attach", ctrl.MuxHandler("preflight", handleAmazonOrigin(cors.HandlePreflight()), nil))
-	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/amazon/detach", ctrl.MuxHandler("preflight", handleAmazonOrigin(cors.HandlePreflight()), nil))
-	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/amazon/login", ctrl.MuxHandler("preflight", handleAmazonOrigin(cors.HandlePreflight()), nil))
-	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/amazon/receive", ctrl.MuxHandler("preflight", handleAmazonOrigin(cors.HandlePreflight()), nil))
-	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/amazon/register", ctrl.MuxHandler("preflight", handleAmazonOrigin(cors.HandlePreflight()), nil))
-	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/amazon/register-start", ctrl.MuxHandler("preflight", handleAmazonOrigin(cors.HandlePreflight()), nil))
-
-	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
-		// Check if there was an error loading the request
-		if err := goa.ContextError(ctx); err != nil {
-			return err
-		}
-		// Build the context
-		rctx, err := NewAttachToAccountAmazonContext(ctx, req, service)
-		if err != nil {
-			return err
-		}
-		return ctrl.AttachToAccount(rctx)
-	}
-	h = handleSecurity("jwt", h)
-	h = handleAmazonOrigin(h)
-	service.Mux.Handle("POST", "/api/v1/user/auth/amazon/attach", ctrl.MuxHandler("attach-to-account", h, nil))
-	service.LogInfo("mount", "ctrl", "Amazon", "action", "AttachToAccount", "route", "POST /api/v1/user/auth/amazon/attach", "security", "jwt")
-
-	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
-		// Check if there was an error loading the request
-		if err := goa.ContextError(ctx); err != nil {
-			return err
-		}
-		// Build the context
-		rctx, err := NewDetachFromAccountAmazonContext(ctx, req, service)
-		if err != nil {
-			return err
-		}
-		return ctrl.DetachFromAccount(rctx)
-	}
-	h = handleSecurity("jwt", h)
-	h = handleAmazonOrigin(h)
-	service.Mux.Handle("POST", "/api/v1/user/auth/amazon/detach", ctrl.MuxHandler("detach-from-account", h, nil))
-	service.LogInfo("mount", "ctrl", "Amazon", "action", "DetachFromAccount", "route", "POST /api/v1/user/auth/amazon/detach", "security", "jwt")
-
-	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
-		// Check if there was an error loading the request
-		if err := goa.ContextError(ctx); err != nil {
-			return err
-		}
-		// Build the context
-		rctx, err := NewLoginAmazonContext(ctx, req, service)
-		if err != nil {
-			return err
-		}
-		return ctrl.Login(rctx)
-	}
-	h = handleSecurity("key", h)
-	h = handleAmazonOrigin(h)
-	service.Mux.Handle("GET", "/api/v1/user/auth/amazon/login", ctrl.MuxHandler("login", h, nil))
-	service.LogInfo("mount", "ctrl", "Amazon", "action", "Login", "route", "GET /api/v1/user/auth/amazon/login", "security", "key")
-
-	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
-		// Check if there was an error loading the request
-		if err := goa.ContextError(ctx); err != nil {
-			return err
-		}
-		// Build the context
-		rctx, err := NewReceiveAmazonContext(ctx, req, service)
-		if err != nil {
-			return err
-		}
-		return ctrl.Receive(rctx)
-	}
-	h = handleSecurity("key", h)
-	h = handleAmazonOrigin(h)
-	service.Mux.Handle("GET", "/api/v1/user/auth/amazon/receive", ctrl.MuxHandler("receive", h, nil))
-	service.LogInfo("mount", "ctrl", "Amazon", "action", "Receive", "route", "GET /api/v1/user/auth/amazon/receive", "security", "key")
-
-	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
-		// Check if there was an error loading the request
-		if err := goa.ContextError(ctx); err != nil {
-			return err
-		}
-		// Build the context
-		rctx, err := NewRegisterAmazonContext(ctx, req, service)
-		if err != nil {
-			return err
-		}
-		// Build the payload
-		if rawPayload := goa.ContextRequest(ctx).Payload; rawPayload != nil {
-			rctx.Payload = rawPayload.(*AmazonRegisterParams)
-		} else {
-			return goa.MissingPayloadError()
-		}
-		return ctrl.Register(rctx)
-	}
-	h = handleSecurity("key", h)
-	h = handleAmazonOrigin(h)
-	service.Mux.Handle("POST", "/api/v1/user/auth/amazon/register", ctrl.MuxHandler("register", h, unmarshalRegisterAmazonPayload))
-	service.LogInfo("mount", "ctrl", "Amazon", "action", "Register", "route", "POST /api/v1/user/auth/amazon/register", "security", "key")
-
-	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
-		// Check if there was an error loading the request
-		if err := goa.ContextError(ctx); err != nil {
-			return err
-		}
-		// Build the context
-		rctx, err := NewRegisterURLAmazonContext(ctx, req, service)
-		if err != nil {
-			return err
-		}
-		return ctrl.RegisterURL(rctx)
-	}
-	h = handleSecurity("key", h)
-	h = handleAmazonOrigin(h)
-	service.Mux.Handle("GET", "/api/v1/user/auth/amazon/register-start", ctrl.MuxHandler("register-url", h, nil))
-	service.LogInfo("mount", "ctrl", "Amazon", "action", "RegisterURL", "route", "GET /api/v1/user/auth/amazon/register-start", "security", "key")
-}
-
-// handleAmazonOrigin applies the CORS response headers corresponding to the origin.
-func handleAmazonOrigin(h goa.Handler) goa.Handler {
-
-	return func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
-		origin := req.Header.Get("Origin")
-		if origin == "" {
-			// Not a CORS request
-			return h(ctx, rw, req)
-		}
-		if cors.MatchOrigin(origin, "*") {
-			ctx = goa.WithLogContext(ctx, "origin", origin)
-			rw.Header().Set("Access-Control-Allow-Origin", origin)
-			rw.Header().Set("Access-Control-Allow-Credentials", "false")
-			if acrm := req.Header.Get("Access-Control-Request-Method"); acrm != "" {
-				// We are handling a preflight request
-				rw.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
-			}
-			return h(ctx, rw, req)
-		}
-
-		return h(ctx, rw, req)
-	}
-}
-
-// unmarshalRegisterAmazonPayload unmarshals the request body into the context request data Payload field.
-func unmarshalRegisterAmazonPayload(ctx context.Context, service *goa.Service, req *http.Request) error {
-	payload := &amazonRegisterParams{}
-	if err := service.DecodeRequest(req, payload); err != nil {
-		return err
-	}
-	if err := payload.Validate(); err != nil {
-		// Initialize payload with private data structure so it can be logged
-		goa.ContextRequest(ctx).Payload = payload
-		return err
-	}
-	goa.ContextRequest(ctx).Payload = payload.Publicize()
-	return nil
-}
-
 // BookmarkController is the controller interface for the Bookmark actions.
 type BookmarkController interface {
 	goa.Muxer
@@ -239,7 +71,7 @@ func MountBookmarkController(service *goa.Service, ctrl BookmarkController) {
 		}
 		return ctrl.AddPost(rctx)
 	}
-	h = handleSecurity("jwt", h)
+	h = handleSecurity("jwt", h, "profile")
 	h = handleBookmarkOrigin(h)
 	service.Mux.Handle("PUT", "/api/v1/user/bookmark/post", ctrl.MuxHandler("addPost", h, unmarshalAddPostBookmarkPayload))
 	service.LogInfo("mount", "ctrl", "Bookmark", "action", "AddPost", "route", "PUT /api/v1/user/bookmark/post", "security", "jwt")
@@ -262,7 +94,7 @@ func MountBookmarkController(service *goa.Service, ctrl BookmarkController) {
 		}
 		return ctrl.AddVideo(rctx)
 	}
-	h = handleSecurity("jwt", h)
+	h = handleSecurity("jwt", h, "profile")
 	h = handleBookmarkOrigin(h)
 	service.Mux.Handle("PUT", "/api/v1/user/bookmark/video", ctrl.MuxHandler("addVideo", h, unmarshalAddVideoBookmarkPayload))
 	service.LogInfo("mount", "ctrl", "Bookmark", "action", "AddVideo", "route", "PUT /api/v1/user/bookmark/video", "security", "jwt")
@@ -279,7 +111,7 @@ func MountBookmarkController(service *goa.Service, ctrl BookmarkController) {
 		}
 		return ctrl.GetBookmarks(rctx)
 	}
-	h = handleSecurity("jwt", h)
+	h = handleSecurity("jwt", h, "profile")
 	h = handleBookmarkOrigin(h)
 	service.Mux.Handle("GET", "/api/v1/user/bookmark", ctrl.MuxHandler("getBookmarks", h, nil))
 	service.LogInfo("mount", "ctrl", "Bookmark", "action", "GetBookmarks", "route", "GET /api/v1/user/bookmark", "security", "jwt")
@@ -296,7 +128,7 @@ func MountBookmarkController(service *goa.Service, ctrl BookmarkController) {
 		}
 		return ctrl.GetPostBookmarks(rctx)
 	}
-	h = handleSecurity("jwt", h)
+	h = handleSecurity("jwt", h, "profile")
 	h = handleBookmarkOrigin(h)
 	service.Mux.Handle("GET", "/api/v1/user/bookmark/post", ctrl.MuxHandler("getPostBookmarks", h, nil))
 	service.LogInfo("mount", "ctrl", "Bookmark", "action", "GetPostBookmarks", "route", "GET /api/v1/user/bookmark/post", "security", "jwt")
@@ -313,7 +145,7 @@ func MountBookmarkController(service *goa.Service, ctrl BookmarkController) {
 		}
 		return ctrl.GetVideoBookmarks(rctx)
 	}
-	h = handleSecurity("jwt", h)
+	h = handleSecurity("jwt", h, "profile")
 	h = handleBookmarkOrigin(h)
 	service.Mux.Handle("GET", "/api/v1/user/bookmark/video", ctrl.MuxHandler("getVideoBookmarks", h, nil))
 	service.LogInfo("mount", "ctrl", "Bookmark", "action", "GetVideoBookmarks", "route", "GET /api/v1/user/bookmark/video", "security", "jwt")
@@ -330,7 +162,7 @@ func MountBookmarkController(service *goa.Service, ctrl BookmarkController) {
 		}
 		return ctrl.RemoveFromBookmark(rctx)
 	}
-	h = handleSecurity("jwt", h)
+	h = handleSecurity("jwt", h, "profile")
 	h = handleBookmarkOrigin(h)
 	service.Mux.Handle("DELETE", "/api/v1/user/bookmark", ctrl.MuxHandler("removeFromBookmark", h, nil))
 	service.LogInfo("mount", "ctrl", "Bookmark", "action", "RemoveFromBookmark", "route", "DELETE /api/v1/user/bookmark", "security", "jwt")
@@ -380,27 +212,24 @@ func unmarshalAddVideoBookmarkPayload(ctx context.Context, service *goa.Service,
 	return nil
 }
 
-// FacebookController is the controller interface for the Facebook actions.
-type FacebookController interface {
+// ClientController is the controller interface for the Client actions.
+type ClientController interface {
 	goa.Muxer
-	AttachToAccount(*AttachToAccountFacebookContext) error
-	DetachFromAccount(*DetachFromAccountFacebookContext) error
-	Login(*LoginFacebookContext) error
-	Receive(*ReceiveFacebookContext) error
-	Register(*RegisterFacebookContext) error
-	RegisterURL(*RegisterURLFacebookContext) error
+	Create(*CreateClientContext) error
+	Delete(*DeleteClientContext) error
+	Get(*GetClientContext) error
+	List(*ListClientContext) error
+	RotateSecret(*RotateSecretClientContext) error
+	Update(*UpdateClientContext) error
 }
 
-// MountFacebookController "mounts" a Facebook resource controller on the given service.
-func MountFacebookController(service *goa.Service, ctrl FacebookController) {
+// MountClientController "mounts" a Client resource controller on the given service.
+func MountClientController(service *goa.Service, ctrl ClientController) {
 	initService(service)
 	var h goa.Handler
-	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/facebook/attach", ctrl.MuxHandler("preflight", handleFacebookOrigin(cors.HandlePreflight()), nil))
-	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/facebook/detach", ctrl.MuxHandler("preflight", handleFacebookOrigin(cors.HandlePreflight()), nil))
-	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/facebook/login", ctrl.MuxHandler("preflight", handleFacebookOrigin(cors.HandlePreflight()), nil))
-	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/facebook/receive", ctrl.MuxHandler("preflight", handleFacebookOrigin(cors.HandlePreflight()), nil))
-	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/facebook/register", ctrl.MuxHandler("preflight", handleFacebookOrigin(cors.HandlePreflight()), nil))
-	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/facebook/register-start", ctrl.MuxHandler("preflight", handleFacebookOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/api/v1/user/clients", ctrl.MuxHandler("preflight", handleClientOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/api/v1/user/clients/:clientID", ctrl.MuxHandler("preflight", handleClientOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/api/v1/user/clients/:clientID/secret", ctrl.MuxHandler("preflight", handleClientOrigin(cors.HandlePreflight()), nil))
 
 	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		// Check if there was an error loading the request
@@ -408,16 +237,22 @@ func MountFacebookController(service *goa.Service, ctrl FacebookController) {
 			return err
 		}
 		// Build the context
-		rctx, err := NewAttachToAccountFacebookContext(ctx, req, service)
+		rctx, err := NewCreateClientContext(ctx, req, service)
 		if err != nil {
 			return err
 		}
-		return ctrl.AttachToAccount(rctx)
+		// Build the payload
+		if rawPayload := goa.ContextRequest(ctx).Payload; rawPayload != nil {
+			rctx.Payload = rawPayload.(*OauthClientParams)
+		} else {
+			return goa.MissingPayloadError()
+		}
+		return ctrl.Create(rctx)
 	}
-	h = handleSecurity("jwt", h)
-	h = handleFacebookOrigin(h)
-	service.Mux.Handle("POST", "/api/v1/user/auth/facebook/attach", ctrl.MuxHandler("attach-to-account", h, nil))
-	service.LogInfo("mount", "ctrl", "Facebook", "action", "AttachToAccount", "route", "POST /api/v1/user/auth/facebook/attach", "security", "jwt")
+	h = handleSecurity("jwt", h, "admin")
+	h = handleClientOrigin(h)
+	service.Mux.Handle("POST", "/api/v1/user/clients", ctrl.MuxHandler("create", h, unmarshalCreateClientPayload))
+	service.LogInfo("mount", "ctrl", "Client", "action", "Create", "route", "POST /api/v1/user/clients", "security", "jwt")
 
 	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		// Check if there was an error loading the request
@@ -425,16 +260,16 @@ func MountFacebookController(service *goa.Service, ctrl FacebookController) {
 			return err
 		}
 		// Build the context
-		rctx, err := NewDetachFromAccountFacebookContext(ctx, req, service)
+		rctx, err := NewDeleteClientContext(ctx, req, service)
 		if err != nil {
 			return err
 		}
-		return ctrl.DetachFromAccount(rctx)
+		return ctrl.Delete(rctx)
 	}
-	h = handleSecurity("jwt", h)
-	h = handleFacebookOrigin(h)
-	service.Mux.Handle("POST", "/api/v1/user/auth/facebook/detach", ctrl.MuxHandler("detach-from-account", h, nil))
-	service.LogInfo("mount", "ctrl", "Facebook", "action", "DetachFromAccount", "route", "POST /api/v1/user/auth/facebook/detach", "security", "jwt")
+	h = handleSecurity("jwt", h, "admin")
+	h = handleClientOrigin(h)
+	service.Mux.Handle("DELETE", "/api/v1/user/clients/:clientID", ctrl.MuxHandler("delete", h, nil))
+	service.LogInfo("mount", "ctrl", "Client", "action", "Delete", "route", "DELETE /api/v1/user/clients/:clientID", "security", "jwt")
 
 	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		// Check if there was an error loading the request
@@ -442,16 +277,16 @@ func MountFacebookController(service *goa.Service, ctrl FacebookController) {
 			return err
 		}
 		// Build the context
-		rctx, err := NewLoginFacebookContext(ctx, req, service)
+		rctx, err := NewGetClientContext(ctx, req, service)
 		if err != nil {
 			return err
 		}
-		return ctrl.Login(rctx)
+		return ctrl.Get(rctx)
 	}
-	h = handleSecurity("key", h)
-	h = handleFacebookOrigin(h)
-	service.Mux.Handle("GET", "/api/v1/user/auth/facebook/login", ctrl.MuxHandler("login", h, nil))
-	service.LogInfo("mount", "ctrl", "Facebook", "action", "Login", "route", "GET /api/v1/user/auth/facebook/login", "security", "key")
+	h = handleSecurity("jwt", h, "admin")
+	h = handleClientOrigin(h)
+	service.Mux.Handle("GET", "/api/v1/user/clients/:clientID", ctrl.MuxHandler("get", h, nil))
+	service.LogInfo("mount", "ctrl", "Client", "action", "Get", "route", "GET /api/v1/user/clients/:clientID", "security", "jwt")
 
 	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		// Check if there was an error loading the request
@@ -459,16 +294,16 @@ func MountFacebookController(service *goa.Service, ctrl FacebookController) {
 			return err
 		}
 		// Build the context
-		rctx, err := NewReceiveFacebookContext(ctx, req, service)
+		rctx, err := NewListClientContext(ctx, req, service)
 		if err != nil {
 			return err
 		}
-		return ctrl.Receive(rctx)
+		return ctrl.List(rctx)
 	}
-	h = handleSecurity("key", h)
-	h = handleFacebookOrigin(h)
-	service.Mux.Handle("GET", "/api/v1/user/auth/facebook/receive", ctrl.MuxHandler("receive", h, nil))
-	service.LogInfo("mount", "ctrl", "Facebook", "action", "Receive", "route", "GET /api/v1/user/auth/facebook/receive", "security", "key")
+	h = handleSecurity("jwt", h, "admin")
+	h = handleClientOrigin(h)
+	service.Mux.Handle("GET", "/api/v1/user/clients", ctrl.MuxHandler("list", h, nil))
+	service.LogInfo("mount", "ctrl", "Client", "action", "List", "route", "GET /api/v1/user/clients", "security", "jwt")
 
 	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		// Check if there was an error loading the request
@@ -476,22 +311,16 @@ func MountFacebookController(service *goa.Service, ctrl FacebookController) {
 			return err
 		}
 		// Build the context
-		rctx, err := NewRegisterFacebookContext(ctx, req, service)
+		rctx, err := NewRotateSecretClientContext(ctx, req, service)
 		if err != nil {
 			return err
 		}
-		// Build the payload
-		if rawPayload := goa.ContextRequest(ctx).Payload; rawPayload != nil {
-			rctx.Payload = rawPayload.(*FacebookRegisterParams)
-		} else {
-			return goa.MissingPayloadError()
-		}
-		return ctrl.Register(rctx)
+		return ctrl.RotateSecret(rctx)
 	}
-	h = handleSecurity("key", h)
-	h = handleFacebookOrigin(h)
-	service.Mux.Handle("POST", "/api/v1/user/auth/facebook/register", ctrl.MuxHandler("register", h, unmarshalRegisterFacebookPayload))
-	service.LogInfo("mount", "ctrl", "Facebook", "action", "Register", "route", "POST /api/v1/user/auth/facebook/register", "security", "key")
+	h = handleSecurity("jwt", h, "admin")
+	h = handleClientOrigin(h)
+	service.Mux.Handle("POST", "/api/v1/user/clients/:clientID/secret", ctrl.MuxHandler("rotate-secret", h, nil))
+	service.LogInfo("mount", "ctrl", "Client", "action", "RotateSecret", "route", "POST /api/v1/user/clients/:clientID/secret", "security", "jwt")
 
 	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		// Check if there was an error loading the request
@@ -499,20 +328,26 @@ func MountFacebookController(service *goa.Service, ctrl FacebookController) {
 			return err
 		}
 		// Build the context
-		rctx, err := NewRegisterURLFacebookContext(ctx, req, service)
+		rctx, err := NewUpdateClientContext(ctx, req, service)
 		if err != nil {
 			return err
 		}
-		return ctrl.RegisterURL(rctx)
+		// Build the payload
+		if rawPayload := goa.ContextRequest(ctx).Payload; rawPayload != nil {
+			rctx.Payload = rawPayload.(*OauthClientParams)
+		} else {
+			return goa.MissingPayloadError()
+		}
+		return ctrl.Update(rctx)
 	}
-	h = handleSecurity("key", h)
-	h = handleFacebookOrigin(h)
-	service.Mux.Handle("GET", "/api/v1/user/auth/facebook/register-start", ctrl.MuxHandler("register-url", h, nil))
-	service.LogInfo("mount", "ctrl", "Facebook", "action", "RegisterURL", "route", "GET /api/v1/user/auth/facebook/register-start", "security", "key")
+	h = handleSecurity("jwt", h, "admin")
+	h = handleClientOrigin(h)
+	service.Mux.Handle("PUT", "/api/v1/user/clients/:clientID", ctrl.MuxHandler("update", h, unmarshalUpdateClientPayload))
+	service.LogInfo("mount", "ctrl", "Client", "action", "Update", "route", "PUT /api/v1/user/clients/:clientID", "security", "jwt")
 }
 
-// handleFacebookOrigin applies the CORS response headers corresponding to the origin.
-func handleFacebookOrigin(h goa.Handler) goa.Handler {
+// handleClientOrigin applies the CORS response headers corresponding to the origin.
+func handleClientOrigin(h goa.Handler) goa.Handler {
 
 	return func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		origin := req.Header.Get("Origin")
@@ -535,9 +370,24 @@ func handleFacebookOrigin(h goa.Handler) goa.Handler {
 	}
 }
 
-// unmarshalRegisterFacebookPayload unmarshals the request body into the context request data Payload field.
-func unmarshalRegisterFacebookPayload(ctx context.Context, service *goa.Service, req *http.Request) error {
-	payload := &facebookRegisterParams{}
+// unmarshalCreateClientPayload unmarshals the request body into the context request data Payload field.
+func unmarshalCreateClientPayload(ctx context.Context, service *goa.Service, req *http.Request) error {
+	payload := &oauthClientParams{}
+	if err := service.DecodeRequest(req, payload); err != nil {
+		return err
+	}
+	if err := payload.Validate(); err != nil {
+		// Initialize payload with private data structure so it can be logged
+		goa.ContextRequest(ctx).Payload = payload
+		return err
+	}
+	goa.ContextRequest(ctx).Payload = payload.Publicize()
+	return nil
+}
+
+// unmarshalUpdateClientPayload unmarshals the request body into the context request data Payload field.
+func unmarshalUpdateClientPayload(ctx context.Context, service *goa.Service, req *http.Request) error {
+	payload := &oauthClientParams{}
 	if err := service.DecodeRequest(req, payload); err != nil {
 		return err
 	}
@@ -550,27 +400,22 @@ func unmarshalRegisterFacebookPayload(ctx context.Context, service *goa.Service,
 	return nil
 }
 
-// GoogleController is the controller interface for the Google actions.
-type GoogleController interface {
+// NewsletterController is the controller interface for the Newsletter actions.
+type NewsletterController interface {
 	goa.Muxer
-	AttachToAccount(*AttachToAccountGoogleContext) error
-	DetachFromAccount(*DetachFromAccountGoogleContext) error
-	Login(*LoginGoogleContext) error
-	Receive(*ReceiveGoogleContext) error
-	Register(*RegisterGoogleContext) error
-	RegisterURL(*RegisterURLGoogleContext) error
+	AddSubscriber(*AddSubscriberNewsletterContext) error
+	GetSubscriberByEmail(*GetSubscriberByEmailNewsletterContext) error
+	GetSubscribers(*GetSubscribersNewsletterContext) error
+	RemoveSubscriber(*RemoveSubscriberNewsletterContext) error
+	UpdateSubscriber(*UpdateSubscriberNewsletterContext) error
 }
 
-// MountGoogleController "mounts" a Google resource controller on the given service.
-func MountGoogleController(service *goa.Service, ctrl GoogleController) {
+// MountNewsletterController "mounts" a Newsletter resource controller on the given service.
+func MountNewsletterController(service *goa.Service, ctrl NewsletterController) {
 	initService(service)
 	var h goa.Handler
-	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/google/attach", ctrl.MuxHandler("preflight", handleGoogleOrigin(cors.HandlePreflight()), nil))
-	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/google/detach", ctrl.MuxHandler("preflight", handleGoogleOrigin(cors.HandlePreflight()), nil))
-	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/google/login", ctrl.MuxHandler("preflight", handleGoogleOrigin(cors.HandlePreflight()), nil))
-	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/google/receive", ctrl.MuxHandler("preflight", handleGoogleOrigin(cors.HandlePreflight()), nil))
-	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/google/register", ctrl.MuxHandler("preflight", handleGoogleOrigin(cors.HandlePreflight()), nil))
-	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/google/register-start", ctrl.MuxHandler("preflight", handleGoogleOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/api/v1/user/newsletter", ctrl.MuxHandler("preflight", handleNewsletterOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/api/v1/user/newsletter/all", ctrl.MuxHandler("preflight", handleNewsletterOrigin(cors.HandlePreflight()), nil))
 
 	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		// Check if there was an error loading the request
@@ -578,16 +423,22 @@ func MountGoogleController(service *goa.Service, ctrl GoogleController) {
 			return err
 		}
 		// Build the context
-		rctx, err := NewAttachToAccountGoogleContext(ctx, req, service)
+		rctx, err := NewAddSubscriberNewsletterContext(ctx, req, service)
 		if err != nil {
 			return err
 		}
-		return ctrl.AttachToAccount(rctx)
+		// Build the payload
+		if rawPayload := goa.ContextRequest(ctx).Payload; rawPayload != nil {
+			rctx.Payload = rawPayload.(*NewsletterParam)
+		} else {
+			return goa.MissingPayloadError()
+		}
+		return ctrl.AddSubscriber(rctx)
 	}
-	h = handleSecurity("jwt", h)
-	h = handleGoogleOrigin(h)
-	service.Mux.Handle("POST", "/api/v1/user/auth/google/attach", ctrl.MuxHandler("attach-to-account", h, nil))
-	service.LogInfo("mount", "ctrl", "Google", "action", "AttachToAccount", "route", "POST /api/v1/user/auth/google/attach", "security", "jwt")
+	h = handleSecurity("key", h)
+	h = handleNewsletterOrigin(h)
+	service.Mux.Handle("POST", "/api/v1/user/newsletter", ctrl.MuxHandler("add-subscriber", h, unmarshalAddSubscriberNewsletterPayload))
+	service.LogInfo("mount", "ctrl", "Newsletter", "action", "AddSubscriber", "route", "POST /api/v1/user/newsletter", "security", "key")
 
 	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		// Check if there was an error loading the request
@@ -595,16 +446,16 @@ func MountGoogleController(service *goa.Service, ctrl GoogleController) {
 			return err
 		}
 		// Build the context
-		rctx, err := NewDetachFromAccountGoogleContext(ctx, req, service)
+		rctx, err := NewGetSubscriberByEmailNewsletterContext(ctx, req, service)
 		if err != nil {
 			return err
 		}
-		return ctrl.DetachFromAccount(rctx)
+		return ctrl.GetSubscriberByEmail(rctx)
 	}
-	h = handleSecurity("jwt", h)
-	h = handleGoogleOrigin(h)
-	service.Mux.Handle("POST", "/api/v1/user/auth/google/detach", ctrl.MuxHandler("detach-from-account", h, nil))
-	service.LogInfo("mount", "ctrl", "Google", "action", "DetachFromAccount", "route", "POST /api/v1/user/auth/google/detach", "security", "jwt")
+	h = handleSecurity("key", h)
+	h = handleNewsletterOrigin(h)
+	service.Mux.Handle("GET", "/api/v1/user/newsletter", ctrl.MuxHandler("get-subscriber-by-email", h, nil))
+	service.LogInfo("mount", "ctrl", "Newsletter", "action", "GetSubscriberByEmail", "route", "GET /api/v1/user/newsletter", "security", "key")
 
 	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		// Check if there was an error loading the request
@@ -612,16 +463,16 @@ func MountGoogleController(service *goa.Service, ctrl GoogleController) {
 			return err
 		}
 		// Build the context
-		rctx, err := NewLoginGoogleContext(ctx, req, service)
+		rctx, err := NewGetSubscribersNewsletterContext(ctx, req, service)
 		if err != nil {
 			return err
 		}
-		return ctrl.Login(rctx)
+		return ctrl.GetSubscribers(rctx)
 	}
-	h = handleSecurity("key", h)
-	h = handleGoogleOrigin(h)
-	service.Mux.Handle("GET", "/api/v1/user/auth/google/login", ctrl.MuxHandler("login", h, nil))
-	service.LogInfo("mount", "ctrl", "Google", "action", "Login", "route", "GET /api/v1/user/auth/google/login", "security", "key")
+	h = handleSecurity("jwt", h, "admin")
+	h = handleNewsletterOrigin(h)
+	service.Mux.Handle("GET", "/api/v1/user/newsletter/all", ctrl.MuxHandler("get-subscribers", h, nil))
+	service.LogInfo("mount", "ctrl", "Newsletter", "action", "GetSubscribers", "route", "GET /api/v1/user/newsletter/all", "security", "jwt")
 
 	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		// Check if there was an error loading the request
@@ -629,16 +480,16 @@ func MountGoogleController(service *goa.Service, ctrl GoogleController) {
 			return err
 		}
 		// Build the context
-		rctx, err := NewReceiveGoogleContext(ctx, req, service)
+		rctx, err := NewRemoveSubscriberNewsletterContext(ctx, req, service)
 		if err != nil {
 			return err
 		}
-		return ctrl.Receive(rctx)
+		return ctrl.RemoveSubscriber(rctx)
 	}
 	h = handleSecurity("key", h)
-	h = handleGoogleOrigin(h)
-	service.Mux.Handle("GET", "/api/v1/user/auth/google/receive", ctrl.MuxHandler("receive", h, nil))
-	service.LogInfo("mount", "ctrl", "Google", "action", "Receive", "route", "GET /api/v1/user/auth/google/receive", "security", "key")
+	h = handleNewsletterOrigin(h)
+	service.Mux.Handle("DELETE", "/api/v1/user/newsletter", ctrl.MuxHandler("remove-subscriber", h, nil))
+	service.LogInfo("mount", "ctrl", "Newsletter", "action", "RemoveSubscriber", "route", "DELETE /api/v1/user/newsletter", "security", "key")
 
 	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		// Check if there was an error loading the request
@@ -646,43 +497,26 @@ func MountGoogleController(service *goa.Service, ctrl GoogleController) {
 			return err
 		}
 		// Build the context
-		rctx, err := NewRegisterGoogleContext(ctx, req, service)
+		rctx, err := NewUpdateSubscriberNewsletterContext(ctx, req, service)
 		if err != nil {
 			return err
 		}
 		// Build the payload
 		if rawPayload := goa.ContextRequest(ctx).Payload; rawPayload != nil {
-			rctx.Payload = rawPayload.(*GoogleRegisterParams)
+			rctx.Payload = rawPayload.(*NewsletterParam)
 		} else {
 			return goa.MissingPayloadError()
 		}
-		return ctrl.Register(rctx)
-	}
-	h = handleSecurity("key", h)
-	h = handleGoogleOrigin(h)
-	service.Mux.Handle("POST", "/api/v1/user/auth/google/register", ctrl.MuxHandler("register", h, unmarshalRegisterGooglePayload))
-	service.LogInfo("mount", "ctrl", "Google", "action", "Register", "route", "POST /api/v1/user/auth/google/register", "security", "key")
-
-	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
-		// Check if there was an error loading the request
-		if err := goa.ContextError(ctx); err != nil {
-			return err
-		}
-		// Build the context
-		rctx, err := NewRegisterURLGoogleContext(ctx, req, service)
-		if err != nil {
-			return err
-		}
-		return ctrl.RegisterURL(rctx)
+		return ctrl.UpdateSubscriber(rctx)
 	}
 	h = handleSecurity("key", h)
-	h = handleGoogleOrigin(h)
-	service.Mux.Handle("GET", "/api/v1/user/auth/google/register-start", ctrl.MuxHandler("register-url", h, nil))
-	service.LogInfo("mount", "ctrl", "Google", "action", "RegisterURL", "route", "GET /api/v1/user/auth/google/register-start", "security", "key")
+	h = handleNewsletterOrigin(h)
+	service.Mux.Handle("PATCH", "/api/v1/user/newsletter", ctrl.MuxHandler("update-subscriber", h, unmarshalUpdateSubscriberNewsletterPayload))
+	service.LogInfo("mount", "ctrl", "Newsletter", "action", "UpdateSubscriber", "route", "PATCH /api/v1/user/newsletter", "security", "key")
 }
 
-// handleGoogleOrigin applies the CORS response headers corresponding to the origin.
-func handleGoogleOrigin(h goa.Handler) goa.Handler {
+// handleNewsletterOrigin applies the CORS response headers corresponding to the origin.
+func handleNewsletterOrigin(h goa.Handler) goa.Handler {
 
 	return func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		origin := req.Header.Get("Origin")
@@ -705,9 +539,24 @@ func handleGoogleOrigin(h goa.Handler) goa.Handler {
 	}
 }
 
-// unmarshalRegisterGooglePayload unmarshals the request body into the context request data Payload field.
-func unmarshalRegisterGooglePayload(ctx context.Context, service *goa.Service, req *http.Request) error {
-	payload := &googleRegisterParams{}
+// unmarshalAddSubscriberNewsletterPayload unmarshals the request body into the context request data Payload field.
+func unmarshalAddSubscriberNewsletterPayload(ctx context.Context, service *goa.Service, req *http.Request) error {
+	payload := &newsletterParam{}
+	if err := service.DecodeRequest(req, payload); err != nil {
+		return err
+	}
+	if err := payload.Validate(); err != nil {
+		// Initialize payload with private data structure so it can be logged
+		goa.ContextRequest(ctx).Payload = payload
+		return err
+	}
+	goa.ContextRequest(ctx).Payload = payload.Publicize()
+	return nil
+}
+
+// unmarshalUpdateSubscriberNewsletterPayload unmarshals the request body into the context request data Payload field.
+func unmarshalUpdateSubscriberNewsletterPayload(ctx context.Context, service *goa.Service, req *http.Request) error {
+	payload := &newsletterParam{}
 	if err := service.DecodeRequest(req, payload); err != nil {
 		return err
 	}
@@ -720,27 +569,24 @@ func unmarshalRegisterGooglePayload(ctx context.Context, service *goa.Service, r
 	return nil
 }
 
-// LinkedinController is the controller interface for the Linkedin actions.
-type LinkedinController interface {
+// OauthController is the controller interface for the Oauth actions.
+type OauthController interface {
 	goa.Muxer
-	AttachToAccount(*AttachToAccountLinkedinContext) error
-	DetachFromAccount(*DetachFromAccountLinkedinContext) error
-	Login(*LoginLinkedinContext) error
-	Receive(*ReceiveLinkedinContext) error
-	Register(*RegisterLinkedinContext) error
-	RegisterURL(*RegisterURLLinkedinContext) error
+	ApproveRequest(*ApproveRequestOauthContext) error
+	Authorize(*AuthorizeOauthContext) error
+	GetRequest(*GetRequestOauthContext) error
+	Token(*TokenOauthContext) error
+	Userinfo(*UserinfoOauthContext) error
 }
 
-// MountLinkedinController "mounts" a Linkedin resource controller on the given service.
-func MountLinkedinController(service *goa.Service, ctrl LinkedinController) {
+// MountOauthController "mounts" a Oauth resource controller on the given service.
+func MountOauthController(service *goa.Service, ctrl OauthController) {
 	initService(service)
 	var h goa.Handler
-	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/linkedin/attach", ctrl.MuxHandler("preflight", handleLinkedinOrigin(cors.HandlePreflight()), nil))
-	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/linkedin/detach", ctrl.MuxHandler("preflight", handleLinkedinOrigin(cors.HandlePreflight()), nil))
-	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/linkedin/login", ctrl.MuxHandler("preflight", handleLinkedinOrigin(cors.HandlePreflight()), nil))
-	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/linkedin/receive", ctrl.MuxHandler("preflight", handleLinkedinOrigin(cors.HandlePreflight()), nil))
-	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/linkedin/register", ctrl.MuxHandler("preflight", handleLinkedinOrigin(cors.HandlePreflight()), nil))
-	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/linkedin/register-start", ctrl.MuxHandler("preflight", handleLinkedinOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/api/v1/user/oauth/requests/:requestID", ctrl.MuxHandler("preflight", handleOauthOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/api/v1/user/oauth/authorize", ctrl.MuxHandler("preflight", handleOauthOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/api/v1/user/oauth/token", ctrl.MuxHandler("preflight", handleOauthOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/api/v1/user/oauth/userinfo", ctrl.MuxHandler("preflight", handleOauthOrigin(cors.HandlePreflight()), nil))
 
 	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		// Check if there was an error loading the request
@@ -748,33 +594,16 @@ func MountLinkedinController(service *goa.Service, ctrl LinkedinController) {
 			return err
 		}
 		// Build the context
-		rctx, err := NewAttachToAccountLinkedinContext(ctx, req, service)
+		rctx, err := NewApproveRequestOauthContext(ctx, req, service)
 		if err != nil {
 			return err
 		}
-		return ctrl.AttachToAccount(rctx)
-	}
-	h = handleSecurity("jwt", h)
-	h = handleLinkedinOrigin(h)
-	service.Mux.Handle("POST", "/api/v1/user/auth/linkedin/attach", ctrl.MuxHandler("attach-to-account", h, nil))
-	service.LogInfo("mount", "ctrl", "Linkedin", "action", "AttachToAccount", "route", "POST /api/v1/user/auth/linkedin/attach", "security", "jwt")
-
-	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
-		// Check if there was an error loading the request
-		if err := goa.ContextError(ctx); err != nil {
-			return err
-		}
-		// Build the context
-		rctx, err := NewDetachFromAccountLinkedinContext(ctx, req, service)
-		if err != nil {
-			return err
-		}
-		return ctrl.DetachFromAccount(rctx)
+		return ctrl.ApproveRequest(rctx)
 	}
-	h = handleSecurity("jwt", h)
-	h = handleLinkedinOrigin(h)
-	service.Mux.Handle("POST", "/api/v1/user/auth/linkedin/detach", ctrl.MuxHandler("detach-from-account", h, nil))
-	service.LogInfo("mount", "ctrl", "Linkedin", "action", "DetachFromAccount", "route", "POST /api/v1/user/auth/linkedin/detach", "security", "jwt")
+	h = handleSecurity("jwt", h, "approve")
+	h = handleOauthOrigin(h)
+	service.Mux.Handle("POST", "/api/v1/user/oauth/requests/:requestID", ctrl.MuxHandler("approve-request", h, nil))
+	service.LogInfo("mount", "ctrl", "Oauth", "action", "ApproveRequest", "route", "POST /api/v1/user/oauth/requests/:requestID", "security", "jwt")
 
 	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		// Check if there was an error loading the request
@@ -782,16 +611,15 @@ func MountLinkedinController(service *goa.Service, ctrl LinkedinController) {
 			return err
 		}
 		// Build the context
-		rctx, err := NewLoginLinkedinContext(ctx, req, service)
+		rctx, err := NewAuthorizeOauthContext(ctx, req, service)
 		if err != nil {
 			return err
 		}
-		return ctrl.Login(rctx)
+		return ctrl.Authorize(rctx)
 	}
-	h = handleSecurity("key", h)
-	h = handleLinkedinOrigin(h)
-	service.Mux.Handle("GET", "/api/v1/user/auth/linkedin/login", ctrl.MuxHandler("login", h, nil))
-	service.LogInfo("mount", "ctrl", "Linkedin", "action", "Login", "route", "GET /api/v1/user/auth/linkedin/login", "security", "key")
+	h = handleOauthOrigin(h)
+	service.Mux.Handle("GET", "/api/v1/user/oauth/authorize", ctrl.MuxHandler("authorize", h, nil))
+	service.LogInfo("mount", "ctrl", "Oauth", "action", "Authorize", "route", "GET /api/v1/user/oauth/authorize")
 
 	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		// Check if there was an error loading the request
@@ -799,16 +627,16 @@ func MountLinkedinController(service *goa.Service, ctrl LinkedinController) {
 			return err
 		}
 		// Build the context
-		rctx, err := NewReceiveLinkedinContext(ctx, req, service)
+		rctx, err := NewGetRequestOauthContext(ctx, req, service)
 		if err != nil {
 			return err
 		}
-		return ctrl.Receive(rctx)
+		return ctrl.GetRequest(rctx)
 	}
 	h = handleSecurity("key", h)
-	h = handleLinkedinOrigin(h)
-	service.Mux.Handle("GET", "/api/v1/user/auth/linkedin/receive", ctrl.MuxHandler("receive", h, nil))
-	service.LogInfo("mount", "ctrl", "Linkedin", "action", "Receive", "route", "GET /api/v1/user/auth/linkedin/receive", "security", "key")
+	h = handleOauthOrigin(h)
+	service.Mux.Handle("GET", "/api/v1/user/oauth/requests/:requestID", ctrl.MuxHandler("get-request", h, nil))
+	service.LogInfo("mount", "ctrl", "Oauth", "action", "GetRequest", "route", "GET /api/v1/user/oauth/requests/:requestID", "security", "key")
 
 	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		// Check if there was an error loading the request
@@ -816,22 +644,21 @@ func MountLinkedinController(service *goa.Service, ctrl LinkedinController) {
 			return err
 		}
 		// Build the context
-		rctx, err := NewRegisterLinkedinContext(ctx, req, service)
+		rctx, err := NewTokenOauthContext(ctx, req, service)
 		if err != nil {
 			return err
 		}
 		// Build the payload
 		if rawPayload := goa.ContextRequest(ctx).Payload; rawPayload != nil {
-			rctx.Payload = rawPayload.(*LinkedinRegisterParams)
+			rctx.Payload = rawPayload.(*OauthTokenParams)
 		} else {
 			return goa.MissingPayloadError()
 		}
-		return ctrl.Register(rctx)
+		return ctrl.Token(rctx)
 	}
-	h = handleSecurity("key", h)
-	h = handleLinkedinOrigin(h)
-	service.Mux.Handle("POST", "/api/v1/user/auth/linkedin/register", ctrl.MuxHandler("register", h, unmarshalRegisterLinkedinPayload))
-	service.LogInfo("mount", "ctrl", "Linkedin", "action", "Register", "route", "POST /api/v1/user/auth/linkedin/register", "security", "key")
+	h = handleOauthOrigin(h)
+	service.Mux.Handle("POST", "/api/v1/user/oauth/token", ctrl.MuxHandler("token", h, unmarshalTokenOauthPayload))
+	service.LogInfo("mount", "ctrl", "Oauth", "action", "Token", "route", "POST /api/v1/user/oauth/token")
 
 	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		// Check if there was an error loading the request
@@ -839,20 +666,19 @@ func MountLinkedinController(service *goa.Service, ctrl LinkedinController) {
 			return err
 		}
 		// Build the context
-		rctx, err := NewRegisterURLLinkedinContext(ctx, req, service)
+		rctx, err := NewUserinfoOauthContext(ctx, req, service)
 		if err != nil {
 			return err
 		}
-		return ctrl.RegisterURL(rctx)
+		return ctrl.Userinfo(rctx)
 	}
-	h = handleSecurity("key", h)
-	h = handleLinkedinOrigin(h)
-	service.Mux.Handle("GET", "/api/v1/user/auth/linkedin/register-start", ctrl.MuxHandler("register-url", h, nil))
-	service.LogInfo("mount", "ctrl", "Linkedin", "action", "RegisterURL", "route", "GET /api/v1/user/auth/linkedin/register-start", "security", "key")
+	h = handleOauthOrigin(h)
+	service.Mux.Handle("GET", "/api/v1/user/oauth/userinfo", ctrl.MuxHandler("userinfo", h, nil))
+	service.LogInfo("mount", "ctrl", "Oauth", "action", "Userinfo", "route", "GET /api/v1/user/oauth/userinfo")
 }
 
-// handleLinkedinOrigin applies the CORS response headers corresponding to the origin.
-func handleLinkedinOrigin(h goa.Handler) goa.Handler {
+// handleOauthOrigin applies the CORS response headers corresponding to the origin.
+func handleOauthOrigin(h goa.Handler) goa.Handler {
 
 	return func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		origin := req.Header.Get("Origin")
@@ -875,9 +701,9 @@ func handleLinkedinOrigin(h goa.Handler) goa.Handler {
 	}
 }
 
-// unmarshalRegisterLinkedinPayload unmarshals the request body into the context request data Payload field.
-func unmarshalRegisterLinkedinPayload(ctx context.Context, service *goa.Service, req *http.Request) error {
-	payload := &linkedinRegisterParams{}
+// unmarshalTokenOauthPayload unmarshals the request body into the context request data Payload field.
+func unmarshalTokenOauthPayload(ctx context.Context, service *goa.Service, req *http.Request) error {
+	payload := &oauthTokenParams{}
 	if err := service.DecodeRequest(req, payload); err != nil {
 		return err
 	}
@@ -890,27 +716,31 @@ func unmarshalRegisterLinkedinPayload(ctx context.Context, service *goa.Service,
 	return nil
 }
 
-// MicrosoftController is the controller interface for the Microsoft actions.
-type MicrosoftController interface {
+// PasswordAuthController is the controller interface for the PasswordAuth actions.
+type PasswordAuthController interface {
 	goa.Muxer
-	AttachToAccount(*AttachToAccountMicrosoftContext) error
-	DetachFromAccount(*DetachFromAccountMicrosoftContext) error
-	Login(*LoginMicrosoftContext) error
-	Receive(*ReceiveMicrosoftContext) error
-	Register(*RegisterMicrosoftContext) error
-	RegisterURL(*RegisterURLMicrosoftContext) error
+	ChangePassword(*ChangePasswordPasswordAuthContext) error
+	ConfirmReset(*ConfirmResetPasswordAuthContext) error
+	Login(*LoginPasswordAuthContext) error
+	RegenerateRecoveryCodes(*RegenerateRecoveryCodesPasswordAuthContext) error
+	Register(*RegisterPasswordAuthContext) error
+	Remove(*RemovePasswordAuthContext) error
+	Reset(*ResetPasswordAuthContext) error
+	Unlock(*UnlockPasswordAuthContext) error
 }
 
-// MountMicrosoftController "mounts" a Microsoft resource controller on the given service.
-func MountMicrosoftController(service *goa.Service, ctrl MicrosoftController) {
+// MountPasswordAuthController "mounts" a PasswordAuth resource controller on the given service.
+func MountPasswordAuthController(service *goa.Service, ctrl PasswordAuthController) {
 	initService(service)
 	var h goa.Handler
-	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/microsoft/attach", ctrl.MuxHandler("preflight", handleMicrosoftOrigin(cors.HandlePreflight()), nil))
-	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/microsoft/detach", ctrl.MuxHandler("preflight", handleMicrosoftOrigin(cors.HandlePreflight()), nil))
-	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/microsoft/login", ctrl.MuxHandler("preflight", handleMicrosoftOrigin(cors.HandlePreflight()), nil))
-	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/microsoft/receive", ctrl.MuxHandler("preflight", handleMicrosoftOrigin(cors.HandlePreflight()), nil))
-	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/microsoft/register", ctrl.MuxHandler("preflight", handleMicrosoftOrigin(cors.HandlePreflight()), nil))
-	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/microsoft/register-start", ctrl.MuxHandler("preflight", handleMicrosoftOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/change-password", ctrl.MuxHandler("preflight", handlePasswordAuthOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/finalize-reset", ctrl.MuxHandler("preflight", handlePasswordAuthOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/login", ctrl.MuxHandler("preflight", handlePasswordAuthOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/recovery-codes", ctrl.MuxHandler("preflight", handlePasswordAuthOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/register", ctrl.MuxHandler("preflight", handlePasswordAuthOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/remove-password", ctrl.MuxHandler("preflight", handlePasswordAuthOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/reset-password", ctrl.MuxHandler("preflight", handlePasswordAuthOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/unlock", ctrl.MuxHandler("preflight", handlePasswordAuthOrigin(cors.HandlePreflight()), nil))
 
 	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		// Check if there was an error loading the request
@@ -918,33 +748,22 @@ func MountMicrosoftController(service *goa.Service, ctrl MicrosoftController) {
 			return err
 		}
 		// Build the context
-		rctx, err := NewAttachToAccountMicrosoftContext(ctx, req, service)
+		rctx, err := NewChangePasswordPasswordAuthContext(ctx, req, service)
 		if err != nil {
 			return err
 		}
-		return ctrl.AttachToAccount(rctx)
-	}
-	h = handleSecurity("jwt", h)
-	h = handleMicrosoftOrigin(h)
-	service.Mux.Handle("POST", "/api/v1/user/auth/microsoft/attach", ctrl.MuxHandler("attach-to-account", h, nil))
-	service.LogInfo("mount", "ctrl", "Microsoft", "action", "AttachToAccount", "route", "POST /api/v1/user/auth/microsoft/attach", "security", "jwt")
-
-	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
-		// Check if there was an error loading the request
-		if err := goa.ContextError(ctx); err != nil {
-			return err
-		}
-		// Build the context
-		rctx, err := NewDetachFromAccountMicrosoftContext(ctx, req, service)
-		if err != nil {
-			return err
+		// Build the payload
+		if rawPayload := goa.ContextRequest(ctx).Payload; rawPayload != nil {
+			rctx.Payload = rawPayload.(*ChangePasswordParams)
+		} else {
+			return goa.MissingPayloadError()
 		}
-		return ctrl.DetachFromAccount(rctx)
+		return ctrl.ChangePassword(rctx)
 	}
-	h = handleSecurity("jwt", h)
-	h = handleMicrosoftOrigin(h)
-	service.Mux.Handle("POST", "/api/v1/user/auth/microsoft/detach", ctrl.MuxHandler("detach-from-account", h, nil))
-	service.LogInfo("mount", "ctrl", "Microsoft", "action", "DetachFromAccount", "route", "POST /api/v1/user/auth/microsoft/detach", "security", "jwt")
+	h = handleSecurity("jwt", h, "account")
+	h = handlePasswordAuthOrigin(h)
+	service.Mux.Handle("POST", "/api/v1/user/auth/change-password", ctrl.MuxHandler("change-password", h, unmarshalChangePasswordPasswordAuthPayload))
+	service.LogInfo("mount", "ctrl", "PasswordAuth", "action", "ChangePassword", "route", "POST /api/v1/user/auth/change-password", "security", "jwt")
 
 	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		// Check if there was an error loading the request
@@ -952,33 +771,22 @@ func MountMicrosoftController(service *goa.Service, ctrl MicrosoftController) {
 			return err
 		}
 		// Build the context
-		rctx, err := NewLoginMicrosoftContext(ctx, req, service)
+		rctx, err := NewConfirmResetPasswordAuthContext(ctx, req, service)
 		if err != nil {
 			return err
 		}
-		return ctrl.Login(rctx)
-	}
-	h = handleSecurity("key", h)
-	h = handleMicrosoftOrigin(h)
-	service.Mux.Handle("GET", "/api/v1/user/auth/microsoft/login", ctrl.MuxHandler("login", h, nil))
-	service.LogInfo("mount", "ctrl", "Microsoft", "action", "Login", "route", "GET /api/v1/user/auth/microsoft/login", "security", "key")
-
-	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
-		// Check if there was an error loading the request
-		if err := goa.ContextError(ctx); err != nil {
-			return err
-		}
-		// Build the context
-		rctx, err := NewReceiveMicrosoftContext(ctx, req, service)
-		if err != nil {
-			return err
+		// Build the payload
+		if rawPayload := goa.ContextRequest(ctx).Payload; rawPayload != nil {
+			rctx.Payload = rawPayload.(*ResetPasswordParams)
+		} else {
+			return goa.MissingPayloadError()
 		}
-		return ctrl.Receive(rctx)
+		return ctrl.ConfirmReset(rctx)
 	}
 	h = handleSecurity("key", h)
-	h = handleMicrosoftOrigin(h)
-	service.Mux.Handle("GET", "/api/v1/user/auth/microsoft/receive", ctrl.MuxHandler("receive", h, nil))
-	service.LogInfo("mount", "ctrl", "Microsoft", "action", "Receive", "route", "GET /api/v1/user/auth/microsoft/receive", "security", "key")
+	h = handlePasswordAuthOrigin(h)
+	service.Mux.Handle("POST", "/api/v1/user/auth/finalize-reset", ctrl.MuxHandler("confirm-reset", h, unmarshalConfirmResetPasswordAuthPayload))
+	service.LogInfo("mount", "ctrl", "PasswordAuth", "action", "ConfirmReset", "route", "POST /api/v1/user/auth/finalize-reset", "security", "key")
 
 	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		// Check if there was an error loading the request
@@ -986,22 +794,22 @@ func MountMicrosoftController(service *goa.Service, ctrl MicrosoftController) {
 			return err
 		}
 		// Build the context
-		rctx, err := NewRegisterMicrosoftContext(ctx, req, service)
+		rctx, err := NewLoginPasswordAuthContext(ctx, req, service)
 		if err != nil {
 			return err
 		}
 		// Build the payload
 		if rawPayload := goa.ContextRequest(ctx).Payload; rawPayload != nil {
-			rctx.Payload = rawPayload.(*MicrosoftRegisterParams)
+			rctx.Payload = rawPayload.(*LoginParams)
 		} else {
 			return goa.MissingPayloadError()
 		}
-		return ctrl.Register(rctx)
+		return ctrl.Login(rctx)
 	}
 	h = handleSecurity("key", h)
-	h = handleMicrosoftOrigin(h)
-	service.Mux.Handle("POST", "/api/v1/user/auth/microsoft/register", ctrl.MuxHandler("register", h, unmarshalRegisterMicrosoftPayload))
-	service.LogInfo("mount", "ctrl", "Microsoft", "action", "Register", "route", "POST /api/v1/user/auth/microsoft/register", "security", "key")
+	h = handlePasswordAuthOrigin(h)
+	service.Mux.Handle("POST", "/api/v1/user/auth/login", ctrl.MuxHandler("login", h, unmarshalLoginPasswordAuthPayload))
+	service.LogInfo("mount", "ctrl", "PasswordAuth", "action", "Login", "route", "POST /api/v1/user/auth/login", "security", "key")
 
 	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		// Check if there was an error loading the request
@@ -1009,73 +817,16 @@ func MountMicrosoftController(service *goa.Service, ctrl MicrosoftController) {
 			return err
 		}
 		// Build the context
-		rctx, err := NewRegisterURLMicrosoftContext(ctx, req, service)
+		rctx, err := NewRegenerateRecoveryCodesPasswordAuthContext(ctx, req, service)
 		if err != nil {
 			return err
 		}
-		return ctrl.RegisterURL(rctx)
-	}
-	h = handleSecurity("key", h)
-	h = handleMicrosoftOrigin(h)
-	service.Mux.Handle("GET", "/api/v1/user/auth/microsoft/register-start", ctrl.MuxHandler("register-url", h, nil))
-	service.LogInfo("mount", "ctrl", "Microsoft", "action", "RegisterURL", "route", "GET /api/v1/user/auth/microsoft/register-start", "security", "key")
-}
-
-// handleMicrosoftOrigin applies the CORS response headers corresponding to the origin.
-func handleMicrosoftOrigin(h goa.Handler) goa.Handler {
-
-	return func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
-		origin := req.Header.Get("Origin")
-		if origin == "" {
-			// Not a CORS request
-			return h(ctx, rw, req)
-		}
-		if cors.MatchOrigin(origin, "*") {
-			ctx = goa.WithLogContext(ctx, "origin", origin)
-			rw.Header().Set("Access-Control-Allow-Origin", origin)
-			rw.Header().Set("Access-Control-Allow-Credentials", "false")
-			if acrm := req.Header.Get("Access-Control-Request-Method"); acrm != "" {
-				// We are handling a preflight request
-				rw.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
-			}
-			return h(ctx, rw, req)
-		}
-
-		return h(ctx, rw, req)
-	}
-}
-
-// unmarshalRegisterMicrosoftPayload unmarshals the request body into the context request data Payload field.
-func unmarshalRegisterMicrosoftPayload(ctx context.Context, service *goa.Service, req *http.Request) error {
-	payload := &microsoftRegisterParams{}
-	if err := service.DecodeRequest(req, payload); err != nil {
-		return err
-	}
-	if err := payload.Validate(); err != nil {
-		// Initialize payload with private data structure so it can be logged
-		goa.ContextRequest(ctx).Payload = payload
-		return err
+		return ctrl.RegenerateRecoveryCodes(rctx)
 	}
-	goa.ContextRequest(ctx).Payload = payload.Publicize()
-	return nil
-}
-
-// NewsletterController is the controller interface for the Newsletter actions.
-type NewsletterController interface {
-	goa.Muxer
-	AddSubscriber(*AddSubscriberNewsletterContext) error
-	GetSubscriberByEmail(*GetSubscriberByEmailNewsletterContext) error
-	GetSubscribers(*GetSubscribersNewsletterContext) error
-	RemoveSubscriber(*RemoveSubscriberNewsletterContext) error
-	UpdateSubscriber(*UpdateSubscriberNewsletterContext) error
-}
-
-// MountNewsletterController "mounts" a Newsletter resource controller on the given service.
-func MountNewsletterController(service *goa.Service, ctrl NewsletterController) {
-	initService(service)
-	var h goa.Handler
-	service.Mux.Handle("OPTIONS", "/api/v1/user/newsletter", ctrl.MuxHandler("preflight", handleNewsletterOrigin(cors.HandlePreflight()), nil))
-	service.Mux.Handle("OPTIONS", "/api/v1/user/newsletter/all", ctrl.MuxHandler("preflight", handleNewsletterOrigin(cors.HandlePreflight()), nil))
+	h = handleSecurity("jwt", h, "account")
+	h = handlePasswordAuthOrigin(h)
+	service.Mux.Handle("POST", "/api/v1/user/auth/recovery-codes", ctrl.MuxHandler("regenerate-recovery-codes", h, nil))
+	service.LogInfo("mount", "ctrl", "PasswordAuth", "action", "RegenerateRecoveryCodes", "route", "POST /api/v1/user/auth/recovery-codes", "security", "jwt")
 
 	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		// Check if there was an error loading the request
@@ -1083,39 +834,22 @@ func MountNewsletterController(service *goa.Service, ctrl NewsletterController)
 			return err
 		}
 		// Build the context
-		rctx, err := NewAddSubscriberNewsletterContext(ctx, req, service)
+		rctx, err := NewRegisterPasswordAuthContext(ctx, req, service)
 		if err != nil {
 			return err
 		}
 		// Build the payload
 		if rawPayload := goa.ContextRequest(ctx).Payload; rawPayload != nil {
-			rctx.Payload = rawPayload.(*NewsletterParam)
+			rctx.Payload = rawPayload.(*RegisterParams)
 		} else {
 			return goa.MissingPayloadError()
 		}
-		return ctrl.AddSubscriber(rctx)
-	}
-	h = handleSecurity("key", h)
-	h = handleNewsletterOrigin(h)
-	service.Mux.Handle("POST", "/api/v1/user/newsletter", ctrl.MuxHandler("add-subscriber", h, unmarshalAddSubscriberNewsletterPayload))
-	service.LogInfo("mount", "ctrl", "Newsletter", "action", "AddSubscriber", "route", "POST /api/v1/user/newsletter", "security", "key")
-
-	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
-		// Check if there was an error loading the request
-		if err := goa.ContextError(ctx); err != nil {
-			return err
-		}
-		// Build the context
-		rctx, err := NewGetSubscriberByEmailNewsletterContext(ctx, req, service)
-		if err != nil {
-			return err
-		}
-		return ctrl.GetSubscriberByEmail(rctx)
+		return ctrl.Register(rctx)
 	}
 	h = handleSecurity("key", h)
-	h = handleNewsletterOrigin(h)
-	service.Mux.Handle("GET", "/api/v1/user/newsletter", ctrl.MuxHandler("get-subscriber-by-email", h, nil))
-	service.LogInfo("mount", "ctrl", "Newsletter", "action", "GetSubscriberByEmail", "route", "GET /api/v1/user/newsletter", "security", "key")
+	h = handlePasswordAuthOrigin(h)
+	service.Mux.Handle("POST", "/api/v1/user/auth/register", ctrl.MuxHandler("register", h, unmarshalRegisterPasswordAuthPayload))
+	service.LogInfo("mount", "ctrl", "PasswordAuth", "action", "Register", "route", "POST /api/v1/user/auth/register", "security", "key")
 
 	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		// Check if there was an error loading the request
@@ -1123,16 +857,16 @@ func MountNewsletterController(service *goa.Service, ctrl NewsletterController)
 			return err
 		}
 		// Build the context
-		rctx, err := NewGetSubscribersNewsletterContext(ctx, req, service)
+		rctx, err := NewRemovePasswordAuthContext(ctx, req, service)
 		if err != nil {
 			return err
 		}
-		return ctrl.GetSubscribers(rctx)
+		return ctrl.Remove(rctx)
 	}
-	h = handleSecurity("jwt", h)
-	h = handleNewsletterOrigin(h)
-	service.Mux.Handle("GET", "/api/v1/user/newsletter/all", ctrl.MuxHandler("get-subscribers", h, nil))
-	service.LogInfo("mount", "ctrl", "Newsletter", "action", "GetSubscribers", "route", "GET /api/v1/user/newsletter/all", "security", "jwt")
+	h = handleSecurity("jwt", h, "account")
+	h = handlePasswordAuthOrigin(h)
+	service.Mux.Handle("POST", "/api/v1/user/auth/remove-password", ctrl.MuxHandler("remove", h, nil))
+	service.LogInfo("mount", "ctrl", "PasswordAuth", "action", "Remove", "route", "POST /api/v1/user/auth/remove-password", "security", "jwt")
 
 	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		// Check if there was an error loading the request
@@ -1140,16 +874,16 @@ func MountNewsletterController(service *goa.Service, ctrl NewsletterController)
 			return err
 		}
 		// Build the context
-		rctx, err := NewRemoveSubscriberNewsletterContext(ctx, req, service)
+		rctx, err := NewResetPasswordAuthContext(ctx, req, service)
 		if err != nil {
 			return err
 		}
-		return ctrl.RemoveSubscriber(rctx)
+		return ctrl.Reset(rctx)
 	}
 	h = handleSecurity("key", h)
-	h = handleNewsletterOrigin(h)
-	service.Mux.Handle("DELETE", "/api/v1/user/newsletter", ctrl.MuxHandler("remove-subscriber", h, nil))
-	service.LogInfo("mount", "ctrl", "Newsletter", "action", "RemoveSubscriber", "route", "DELETE /api/v1/user/newsletter", "security", "key")
+	h = handlePasswordAuthOrigin(h)
+	service.Mux.Handle("POST", "/api/v1/user/auth/reset-password", ctrl.MuxHandler("reset", h, nil))
+	service.LogInfo("mount", "ctrl", "PasswordAuth", "action", "Reset", "route", "POST /api/v1/user/auth/reset-password", "security", "key")
 
 	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		// Check if there was an error loading the request
@@ -1157,26 +891,26 @@ func MountNewsletterController(service *goa.Service, ctrl NewsletterController)
 			return err
 		}
 		// Build the context
-		rctx, err := NewUpdateSubscriberNewsletterContext(ctx, req, service)
+		rctx, err := NewUnlockPasswordAuthContext(ctx, req, service)
 		if err != nil {
 			return err
 		}
 		// Build the payload
 		if rawPayload := goa.ContextRequest(ctx).Payload; rawPayload != nil {
-			rctx.Payload = rawPayload.(*NewsletterParam)
+			rctx.Payload = rawPayload.(*UnlockParams)
 		} else {
 			return goa.MissingPayloadError()
 		}
-		return ctrl.UpdateSubscriber(rctx)
+		return ctrl.Unlock(rctx)
 	}
 	h = handleSecurity("key", h)
-	h = handleNewsletterOrigin(h)
-	service.Mux.Handle("PATCH", "/api/v1/user/newsletter", ctrl.MuxHandler("update-subscriber", h, unmarshalUpdateSubscriberNewsletterPayload))
-	service.LogInfo("mount", "ctrl", "Newsletter", "action", "UpdateSubscriber", "route", "PATCH /api/v1/user/newsletter", "security", "key")
+	h = handlePasswordAuthOrigin(h)
+	service.Mux.Handle("POST", "/api/v1/user/auth/unlock", ctrl.MuxHandler("unlock", h, unmarshalUnlockPasswordAuthPayload))
+	service.LogInfo("mount", "ctrl", "PasswordAuth", "action", "Unlock", "route", "POST /api/v1/user/auth/unlock", "security", "key")
 }
 
-// handleNewsletterOrigin applies the CORS response headers corresponding to the origin.
-func handleNewsletterOrigin(h goa.Handler) goa.Handler {
+// handlePasswordAuthOrigin applies the CORS response headers corresponding to the origin.
+func handlePasswordAuthOrigin(h goa.Handler) goa.Handler {
 
 	return func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		origin := req.Header.Get("Origin")
@@ -1199,9 +933,9 @@ func handleNewsletterOrigin(h goa.Handler) goa.Handler {
 	}
 }
 
-// unmarshalAddSubscriberNewsletterPayload unmarshals the request body into the context request data Payload field.
-func unmarshalAddSubscriberNewsletterPayload(ctx context.Context, service *goa.Service, req *http.Request) error {
-	payload := &newsletterParam{}
+// unmarshalChangePasswordPasswordAuthPayload unmarshals the request body into the context request data Payload field.
+func unmarshalChangePasswordPasswordAuthPayload(ctx context.Context, service *goa.Service, req *http.Request) error {
+	payload := &changePasswordParams{}
 	if err := service.DecodeRequest(req, payload); err != nil {
 		return err
 	}
@@ -1214,9 +948,9 @@ func unmarshalAddSubscriberNewsletterPayload(ctx context.Context, service *goa.S
 	return nil
 }
 
-// unmarshalUpdateSubscriberNewsletterPayload unmarshals the request body into the context request data Payload field.
-func unmarshalUpdateSubscriberNewsletterPayload(ctx context.Context, service *goa.Service, req *http.Request) error {
-	payload := &newsletterParam{}
+// unmarshalConfirmResetPasswordAuthPayload unmarshals the request body into the context request data Payload field.
+func unmarshalConfirmResetPasswordAuthPayload(ctx context.Context, service *goa.Service, req *http.Request) error {
+	payload := &resetPasswordParams{}
 	if err := service.DecodeRequest(req, payload); err != nil {
 		return err
 	}
@@ -1229,212 +963,24 @@ func unmarshalUpdateSubscriberNewsletterPayload(ctx context.Context, service *go
 	return nil
 }
 
-// PasswordAuthController is the controller interface for the PasswordAuth actions.
-type PasswordAuthController interface {
-	goa.Muxer
-	ChangePassword(*ChangePasswordPasswordAuthContext) error
-	ConfirmReset(*ConfirmResetPasswordAuthContext) error
-	Login(*LoginPasswordAuthContext) error
-	Register(*RegisterPasswordAuthContext) error
-	Remove(*RemovePasswordAuthContext) error
-	Reset(*ResetPasswordAuthContext) error
+// unmarshalLoginPasswordAuthPayload unmarshals the request body into the context request data Payload field.
+func unmarshalLoginPasswordAuthPayload(ctx context.Context, service *goa.Service, req *http.Request) error {
+	payload := &loginParams{}
+	if err := service.DecodeRequest(req, payload); err != nil {
+		return err
+	}
+	if err := payload.Validate(); err != nil {
+		// Initialize payload with private data structure so it can be logged
+		goa.ContextRequest(ctx).Payload = payload
+		return err
+	}
+	goa.ContextRequest(ctx).Payload = payload.Publicize()
+	return nil
 }
 
-// MountPasswordAuthController "mounts" a PasswordAuth resource controller on the given service.
-func MountPasswordAuthController(service *goa.Service, ctrl PasswordAuthController) {
-	initService(service)
-	var h goa.Handler
-	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/change-password", ctrl.MuxHandler("preflight", handlePasswordAuthOrigin(cors.HandlePreflight()), nil))
-	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/finalize-reset", ctrl.MuxHandler("preflight", handlePasswordAuthOrigin(cors.HandlePreflight()), nil))
-	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/login", ctrl.MuxHandler("preflight", handlePasswordAuthOrigin(cors.HandlePreflight()), nil))
-	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/register", ctrl.MuxHandler("preflight", handlePasswordAuthOrigin(cors.HandlePreflight()), nil))
-	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/remove-password", ctrl.MuxHandler("preflight", handlePasswordAuthOrigin(cors.HandlePreflight()), nil))
-	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/reset-password", ctrl.MuxHandler("preflight", handlePasswordAuthOrigin(cors.HandlePreflight()), nil))
-
-	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
-		// Check if there was an error loading the request
-		if err := goa.ContextError(ctx); err != nil {
-			return err
-		}
-		// Build the context
-		rctx, err := NewChangePasswordPasswordAuthContext(ctx, req, service)
-		if err != nil {
-			return err
-		}
-		// Build the payload
-		if rawPayload := goa.ContextRequest(ctx).Payload; rawPayload != nil {
-			rctx.Payload = rawPayload.(*ChangePasswordParams)
-		} else {
-			return goa.MissingPayloadError()
-		}
-		return ctrl.ChangePassword(rctx)
-	}
-	h = handleSecurity("jwt", h)
-	h = handlePasswordAuthOrigin(h)
-	service.Mux.Handle("POST", "/api/v1/user/auth/change-password", ctrl.MuxHandler("change-password", h, unmarshalChangePasswordPasswordAuthPayload))
-	service.LogInfo("mount", "ctrl", "PasswordAuth", "action", "ChangePassword", "route", "POST /api/v1/user/auth/change-password", "security", "jwt")
-
-	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
-		// Check if there was an error loading the request
-		if err := goa.ContextError(ctx); err != nil {
-			return err
-		}
-		// Build the context
-		rctx, err := NewConfirmResetPasswordAuthContext(ctx, req, service)
-		if err != nil {
-			return err
-		}
-		// Build the payload
-		if rawPayload := goa.ContextRequest(ctx).Payload; rawPayload != nil {
-			rctx.Payload = rawPayload.(*ResetPasswordParams)
-		} else {
-			return goa.MissingPayloadError()
-		}
-		return ctrl.ConfirmReset(rctx)
-	}
-	h = handleSecurity("key", h)
-	h = handlePasswordAuthOrigin(h)
-	service.Mux.Handle("POST", "/api/v1/user/auth/finalize-reset", ctrl.MuxHandler("confirm-reset", h, unmarshalConfirmResetPasswordAuthPayload))
-	service.LogInfo("mount", "ctrl", "PasswordAuth", "action", "ConfirmReset", "route", "POST /api/v1/user/auth/finalize-reset", "security", "key")
-
-	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
-		// Check if there was an error loading the request
-		if err := goa.ContextError(ctx); err != nil {
-			return err
-		}
-		// Build the context
-		rctx, err := NewLoginPasswordAuthContext(ctx, req, service)
-		if err != nil {
-			return err
-		}
-		// Build the payload
-		if rawPayload := goa.ContextRequest(ctx).Payload; rawPayload != nil {
-			rctx.Payload = rawPayload.(*LoginParams)
-		} else {
-			return goa.MissingPayloadError()
-		}
-		return ctrl.Login(rctx)
-	}
-	h = handleSecurity("key", h)
-	h = handlePasswordAuthOrigin(h)
-	service.Mux.Handle("POST", "/api/v1/user/auth/login", ctrl.MuxHandler("login", h, unmarshalLoginPasswordAuthPayload))
-	service.LogInfo("mount", "ctrl", "PasswordAuth", "action", "Login", "route", "POST /api/v1/user/auth/login", "security", "key")
-
-	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
-		// Check if there was an error loading the request
-		if err := goa.ContextError(ctx); err != nil {
-			return err
-		}
-		// Build the context
-		rctx, err := NewRegisterPasswordAuthContext(ctx, req, service)
-		if err != nil {
-			return err
-		}
-		// Build the payload
-		if rawPayload := goa.ContextRequest(ctx).Payload; rawPayload != nil {
-			rctx.Payload = rawPayload.(*RegisterParams)
-		} else {
-			return goa.MissingPayloadError()
-		}
-		return ctrl.Register(rctx)
-	}
-	h = handleSecurity("key", h)
-	h = handlePasswordAuthOrigin(h)
-	service.Mux.Handle("POST", "/api/v1/user/auth/register", ctrl.MuxHandler("register", h, unmarshalRegisterPasswordAuthPayload))
-	service.LogInfo("mount", "ctrl", "PasswordAuth", "action", "Register", "route", "POST /api/v1/user/auth/register", "security", "key")
-
-	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
-		// Check if there was an error loading the request
-		if err := goa.ContextError(ctx); err != nil {
-			return err
-		}
-		// Build the context
-		rctx, err := NewRemovePasswordAuthContext(ctx, req, service)
-		if err != nil {
-			return err
-		}
-		return ctrl.Remove(rctx)
-	}
-	h = handleSecurity("jwt", h)
-	h = handlePasswordAuthOrigin(h)
-	service.Mux.Handle("POST", "/api/v1/user/auth/remove-password", ctrl.MuxHandler("remove", h, nil))
-	service.LogInfo("mount", "ctrl", "PasswordAuth", "action", "Remove", "route", "POST /api/v1/user/auth/remove-password", "security", "jwt")
-
-	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
-		// Check if there was an error loading the request
-		if err := goa.ContextError(ctx); err != nil {
-			return err
-		}
-		// Build the context
-		rctx, err := NewResetPasswordAuthContext(ctx, req, service)
-		if err != nil {
-			return err
-		}
-		return ctrl.Reset(rctx)
-	}
-	h = handleSecurity("key", h)
-	h = handlePasswordAuthOrigin(h)
-	service.Mux.Handle("POST", "/api/v1/user/auth/reset-password", ctrl.MuxHandler("reset", h, nil))
-	service.LogInfo("mount", "ctrl", "PasswordAuth", "action", "Reset", "route", "POST /api/v1/user/auth/reset-password", "security", "key")
-}
-
-// handlePasswordAuthOrigin applies the CORS response headers corresponding to the origin.
-func handlePasswordAuthOrigin(h goa.Handler) goa.Handler {
-
-	return func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
-		origin := req.Header.Get("Origin")
-		if origin == "" {
-			// Not a CORS request
-			return h(ctx, rw, req)
-		}
-		if cors.MatchOrigin(origin, "*") {
-			ctx = goa.WithLogContext(ctx, "origin", origin)
-			rw.Header().Set("Access-Control-Allow-Origin", origin)
-			rw.Header().Set("Access-Control-Allow-Credentials", "false")
-			if acrm := req.Header.Get("Access-Control-Request-Method"); acrm != "" {
-				// We are handling a preflight request
-				rw.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
-			}
-			return h(ctx, rw, req)
-		}
-
-		return h(ctx, rw, req)
-	}
-}
-
-// unmarshalChangePasswordPasswordAuthPayload unmarshals the request body into the context request data Payload field.
-func unmarshalChangePasswordPasswordAuthPayload(ctx context.Context, service *goa.Service, req *http.Request) error {
-	payload := &changePasswordParams{}
-	if err := service.DecodeRequest(req, payload); err != nil {
-		return err
-	}
-	if err := payload.Validate(); err != nil {
-		// Initialize payload with private data structure so it can be logged
-		goa.ContextRequest(ctx).Payload = payload
-		return err
-	}
-	goa.ContextRequest(ctx).Payload = payload.Publicize()
-	return nil
-}
-
-// unmarshalConfirmResetPasswordAuthPayload unmarshals the request body into the context request data Payload field.
-func unmarshalConfirmResetPasswordAuthPayload(ctx context.Context, service *goa.Service, req *http.Request) error {
-	payload := &resetPasswordParams{}
-	if err := service.DecodeRequest(req, payload); err != nil {
-		return err
-	}
-	if err := payload.Validate(); err != nil {
-		// Initialize payload with private data structure so it can be logged
-		goa.ContextRequest(ctx).Payload = payload
-		return err
-	}
-	goa.ContextRequest(ctx).Payload = payload.Publicize()
-	return nil
-}
-
-// unmarshalLoginPasswordAuthPayload unmarshals the request body into the context request data Payload field.
-func unmarshalLoginPasswordAuthPayload(ctx context.Context, service *goa.Service, req *http.Request) error {
-	payload := &loginParams{}
+// unmarshalRegisterPasswordAuthPayload unmarshals the request body into the context request data Payload field.
+func unmarshalRegisterPasswordAuthPayload(ctx context.Context, service *goa.Service, req *http.Request) error {
+	payload := &registerParams{}
 	if err := service.DecodeRequest(req, payload); err != nil {
 		return err
 	}
@@ -1447,9 +993,9 @@ func unmarshalLoginPasswordAuthPayload(ctx context.Context, service *goa.Service
 	return nil
 }
 
-// unmarshalRegisterPasswordAuthPayload unmarshals the request body into the context request data Payload field.
-func unmarshalRegisterPasswordAuthPayload(ctx context.Context, service *goa.Service, req *http.Request) error {
-	payload := &registerParams{}
+// unmarshalUnlockPasswordAuthPayload unmarshals the request body into the context request data Payload field.
+func unmarshalUnlockPasswordAuthPayload(ctx context.Context, service *goa.Service, req *http.Request) error {
+	payload := &unlockParams{}
 	if err := service.DecodeRequest(req, payload); err != nil {
 		return err
 	}
@@ -1472,8 +1018,13 @@ type SessionController interface {
 	Logout(*LogoutSessionContext) error
 	LogoutOther(*LogoutOtherSessionContext) error
 	LogoutSpecific(*LogoutSpecificSessionContext) error
+	RedeemLoginCode(*RedeemLoginCodeSessionContext) error
+	RedeemPhoneLoginCode(*RedeemPhoneLoginCodeSessionContext) error
 	RedeemToken(*RedeemTokenSessionContext) error
 	Refresh(*RefreshSessionContext) error
+	SendLoginCode(*SendLoginCodeSessionContext) error
+	SendLoginLink(*SendLoginLinkSessionContext) error
+	SendPhoneLoginCode(*SendPhoneLoginCodeSessionContext) error
 }
 
 // MountSessionController "mounts" a Session resource controller on the given service.
@@ -1487,8 +1038,13 @@ func MountSessionController(service *goa.Service, ctrl SessionController) {
 	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/logout", ctrl.MuxHandler("preflight", handleSessionOrigin(cors.HandlePreflight()), nil))
 	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/logout/all", ctrl.MuxHandler("preflight", handleSessionOrigin(cors.HandlePreflight()), nil))
 	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/logout/:session-id", ctrl.MuxHandler("preflight", handleSessionOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/login-code/redeem", ctrl.MuxHandler("preflight", handleSessionOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/phone-login-code/redeem", ctrl.MuxHandler("preflight", handleSessionOrigin(cors.HandlePreflight()), nil))
 	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/token", ctrl.MuxHandler("preflight", handleSessionOrigin(cors.HandlePreflight()), nil))
 	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/session", ctrl.MuxHandler("preflight", handleSessionOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/login-code", ctrl.MuxHandler("preflight", handleSessionOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/login-link", ctrl.MuxHandler("preflight", handleSessionOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/phone-login-code", ctrl.MuxHandler("preflight", handleSessionOrigin(cors.HandlePreflight()), nil))
 
 	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		// Check if there was an error loading the request
@@ -1502,7 +1058,7 @@ func MountSessionController(service *goa.Service, ctrl SessionController) {
 		}
 		return ctrl.CleanLoginToken(rctx)
 	}
-	h = handleSecurity("jwt", h)
+	h = handleSecurity("jwt", h, "admin")
 	h = handleSessionOrigin(h)
 	service.Mux.Handle("GET", "/api/v1/user/auth/clean/token/login", ctrl.MuxHandler("clean-login-token", h, nil))
 	service.LogInfo("mount", "ctrl", "Session", "action", "CleanLoginToken", "route", "GET /api/v1/user/auth/clean/token/login", "security", "jwt")
@@ -1519,7 +1075,7 @@ func MountSessionController(service *goa.Service, ctrl SessionController) {
 		}
 		return ctrl.CleanMergeToken(rctx)
 	}
-	h = handleSecurity("jwt", h)
+	h = handleSecurity("jwt", h, "admin")
 	h = handleSessionOrigin(h)
 	service.Mux.Handle("GET", "/api/v1/user/auth/clean/token/merge", ctrl.MuxHandler("clean-merge-token", h, nil))
 	service.LogInfo("mount", "ctrl", "Session", "action", "CleanMergeToken", "route", "GET /api/v1/user/auth/clean/token/merge", "security", "jwt")
@@ -1536,7 +1092,7 @@ func MountSessionController(service *goa.Service, ctrl SessionController) {
 		}
 		return ctrl.CleanSessions(rctx)
 	}
-	h = handleSecurity("jwt", h)
+	h = handleSecurity("jwt", h, "admin")
 	h = handleSessionOrigin(h)
 	service.Mux.Handle("GET", "/api/v1/user/auth/clean/sessions", ctrl.MuxHandler("clean-sessions", h, nil))
 	service.LogInfo("mount", "ctrl", "Session", "action", "CleanSessions", "route", "GET /api/v1/user/auth/clean/sessions", "security", "jwt")
@@ -1609,6 +1165,52 @@ func MountSessionController(service *goa.Service, ctrl SessionController) {
 	service.Mux.Handle("POST", "/api/v1/user/auth/logout/:session-id", ctrl.MuxHandler("logout-specific", h, nil))
 	service.LogInfo("mount", "ctrl", "Session", "action", "LogoutSpecific", "route", "POST /api/v1/user/auth/logout/:session-id", "security", "jwt")
 
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewRedeemLoginCodeSessionContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		// Build the payload
+		if rawPayload := goa.ContextRequest(ctx).Payload; rawPayload != nil {
+			rctx.Payload = rawPayload.(*LoginCodeParams)
+		} else {
+			return goa.MissingPayloadError()
+		}
+		return ctrl.RedeemLoginCode(rctx)
+	}
+	h = handleSecurity("key", h)
+	h = handleSessionOrigin(h)
+	service.Mux.Handle("POST", "/api/v1/user/auth/login-code/redeem", ctrl.MuxHandler("redeem-login-code", h, unmarshalRedeemLoginCodeSessionPayload))
+	service.LogInfo("mount", "ctrl", "Session", "action", "RedeemLoginCode", "route", "POST /api/v1/user/auth/login-code/redeem", "security", "key")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewRedeemPhoneLoginCodeSessionContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		// Build the payload
+		if rawPayload := goa.ContextRequest(ctx).Payload; rawPayload != nil {
+			rctx.Payload = rawPayload.(*PhoneLoginParams)
+		} else {
+			return goa.MissingPayloadError()
+		}
+		return ctrl.RedeemPhoneLoginCode(rctx)
+	}
+	h = handleSecurity("key", h)
+	h = handleSessionOrigin(h)
+	service.Mux.Handle("POST", "/api/v1/user/auth/phone-login-code/redeem", ctrl.MuxHandler("redeem-phone-login-code", h, unmarshalRedeemPhoneLoginCodeSessionPayload))
+	service.LogInfo("mount", "ctrl", "Session", "action", "RedeemPhoneLoginCode", "route", "POST /api/v1/user/auth/phone-login-code/redeem", "security", "key")
+
 	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		// Check if there was an error loading the request
 		if err := goa.ContextError(ctx); err != nil {
@@ -1646,31 +1248,118 @@ func MountSessionController(service *goa.Service, ctrl SessionController) {
 	h = handleSessionOrigin(h)
 	service.Mux.Handle("POST", "/api/v1/user/auth/session", ctrl.MuxHandler("refresh", h, nil))
 	service.LogInfo("mount", "ctrl", "Session", "action", "Refresh", "route", "POST /api/v1/user/auth/session")
-}
-
-// handleSessionOrigin applies the CORS response headers corresponding to the origin.
-func handleSessionOrigin(h goa.Handler) goa.Handler {
 
-	return func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
-		origin := req.Header.Get("Origin")
-		if origin == "" {
-			// Not a CORS request
-			return h(ctx, rw, req)
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
 		}
-		if cors.MatchOrigin(origin, "*") {
-			ctx = goa.WithLogContext(ctx, "origin", origin)
-			rw.Header().Set("Access-Control-Allow-Origin", origin)
-			rw.Header().Set("Access-Control-Allow-Credentials", "false")
-			if acrm := req.Header.Get("Access-Control-Request-Method"); acrm != "" {
-				// We are handling a preflight request
-				rw.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
-			}
-			return h(ctx, rw, req)
+		// Build the context
+		rctx, err := NewSendLoginCodeSessionContext(ctx, req, service)
+		if err != nil {
+			return err
 		}
-
-		return h(ctx, rw, req)
+		return ctrl.SendLoginCode(rctx)
 	}
-}
+	h = handleSecurity("key", h)
+	h = handleSessionOrigin(h)
+	service.Mux.Handle("POST", "/api/v1/user/auth/login-code", ctrl.MuxHandler("send-login-code", h, nil))
+	service.LogInfo("mount", "ctrl", "Session", "action", "SendLoginCode", "route", "POST /api/v1/user/auth/login-code", "security", "key")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewSendLoginLinkSessionContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.SendLoginLink(rctx)
+	}
+	h = handleSecurity("key", h)
+	h = handleSessionOrigin(h)
+	service.Mux.Handle("POST", "/api/v1/user/auth/login-link", ctrl.MuxHandler("send-login-link", h, nil))
+	service.LogInfo("mount", "ctrl", "Session", "action", "SendLoginLink", "route", "POST /api/v1/user/auth/login-link", "security", "key")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewSendPhoneLoginCodeSessionContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		// Build the payload
+		if rawPayload := goa.ContextRequest(ctx).Payload; rawPayload != nil {
+			rctx.Payload = rawPayload.(*SendPhoneLoginCodeSessionPayload)
+		} else {
+			return goa.MissingPayloadError()
+		}
+		return ctrl.SendPhoneLoginCode(rctx)
+	}
+	h = handleSecurity("key", h)
+	h = handleSessionOrigin(h)
+	service.Mux.Handle("POST", "/api/v1/user/auth/phone-login-code", ctrl.MuxHandler("send-phone-login-code", h, unmarshalSendPhoneLoginCodeSessionPayload))
+	service.LogInfo("mount", "ctrl", "Session", "action", "SendPhoneLoginCode", "route", "POST /api/v1/user/auth/phone-login-code", "security", "key")
+}
+
+// handleSessionOrigin applies the CORS response headers corresponding to the origin.
+func handleSessionOrigin(h goa.Handler) goa.Handler {
+
+	return func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		origin := req.Header.Get("Origin")
+		if origin == "" {
+			// Not a CORS request
+			return h(ctx, rw, req)
+		}
+		if cors.MatchOrigin(origin, "*") {
+			ctx = goa.WithLogContext(ctx, "origin", origin)
+			rw.Header().Set("Access-Control-Allow-Origin", origin)
+			rw.Header().Set("Access-Control-Allow-Credentials", "false")
+			if acrm := req.Header.Get("Access-Control-Request-Method"); acrm != "" {
+				// We are handling a preflight request
+				rw.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
+			}
+			return h(ctx, rw, req)
+		}
+
+		return h(ctx, rw, req)
+	}
+}
+
+// unmarshalRedeemLoginCodeSessionPayload unmarshals the request body into the context request data Payload field.
+func unmarshalRedeemLoginCodeSessionPayload(ctx context.Context, service *goa.Service, req *http.Request) error {
+	payload := &loginCodeParams{}
+	if err := service.DecodeRequest(req, payload); err != nil {
+		return err
+	}
+	if err := payload.Validate(); err != nil {
+		// Initialize payload with private data structure so it can be logged
+		goa.ContextRequest(ctx).Payload = payload
+		return err
+	}
+	goa.ContextRequest(ctx).Payload = payload.Publicize()
+	return nil
+}
+
+// unmarshalRedeemPhoneLoginCodeSessionPayload unmarshals the request body into the context request data Payload field.
+func unmarshalRedeemPhoneLoginCodeSessionPayload(ctx context.Context, service *goa.Service, req *http.Request) error {
+	payload := &phoneLoginParams{}
+	if err := service.DecodeRequest(req, payload); err != nil {
+		return err
+	}
+	if err := payload.Validate(); err != nil {
+		// Initialize payload with private data structure so it can be logged
+		goa.ContextRequest(ctx).Payload = payload
+		return err
+	}
+	goa.ContextRequest(ctx).Payload = payload.Publicize()
+	return nil
+}
 
 // unmarshalRedeemTokenSessionPayload unmarshals the request body into the context request data Payload field.
 func unmarshalRedeemTokenSessionPayload(ctx context.Context, service *goa.Service, req *http.Request) error {
@@ -1687,27 +1376,43 @@ func unmarshalRedeemTokenSessionPayload(ctx context.Context, service *goa.Servic
 	return nil
 }
 
-// TwitterController is the controller interface for the Twitter actions.
-type TwitterController interface {
+// unmarshalSendPhoneLoginCodeSessionPayload unmarshals the request body into the context request data Payload field.
+func unmarshalSendPhoneLoginCodeSessionPayload(ctx context.Context, service *goa.Service, req *http.Request) error {
+	payload := &sendPhoneLoginCodeSessionPayload{}
+	if err := service.DecodeRequest(req, payload); err != nil {
+		return err
+	}
+	if err := payload.Validate(); err != nil {
+		// Initialize payload with private data structure so it can be logged
+		goa.ContextRequest(ctx).Payload = payload
+		return err
+	}
+	goa.ContextRequest(ctx).Payload = payload.Publicize()
+	return nil
+}
+
+// SocialController is the controller interface for the Social actions.
+type SocialController interface {
 	goa.Muxer
-	AttachToAccount(*AttachToAccountTwitterContext) error
-	DetachFromAccount(*DetachFromAccountTwitterContext) error
-	Login(*LoginTwitterContext) error
-	Receive(*ReceiveTwitterContext) error
-	Register(*RegisterTwitterContext) error
-	RegisterURL(*RegisterURLTwitterContext) error
+	AttachToAccount(*AttachToAccountSocialContext) error
+	DetachFromAccount(*DetachFromAccountSocialContext) error
+	Login(*LoginSocialContext) error
+	Receive(*ReceiveSocialContext) error
+	ReceiveForm(*ReceiveFormSocialContext) error
+	Register(*RegisterSocialContext) error
+	RegisterURL(*RegisterURLSocialContext) error
 }
 
-// MountTwitterController "mounts" a Twitter resource controller on the given service.
-func MountTwitterController(service *goa.Service, ctrl TwitterController) {
+// MountSocialController "mounts" a Social resource controller on the given service.
+func MountSocialController(service *goa.Service, ctrl SocialController) {
 	initService(service)
 	var h goa.Handler
-	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/twitter/attach", ctrl.MuxHandler("preflight", handleTwitterOrigin(cors.HandlePreflight()), nil))
-	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/twitter/detach", ctrl.MuxHandler("preflight", handleTwitterOrigin(cors.HandlePreflight()), nil))
-	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/twitter/login", ctrl.MuxHandler("preflight", handleTwitterOrigin(cors.HandlePreflight()), nil))
-	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/twitter/receive", ctrl.MuxHandler("preflight", handleTwitterOrigin(cors.HandlePreflight()), nil))
-	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/twitter/register", ctrl.MuxHandler("preflight", handleTwitterOrigin(cors.HandlePreflight()), nil))
-	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/twitter/register-start", ctrl.MuxHandler("preflight", handleTwitterOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/:provider/attach", ctrl.MuxHandler("preflight", handleSocialOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/:provider/detach", ctrl.MuxHandler("preflight", handleSocialOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/:provider/login", ctrl.MuxHandler("preflight", handleSocialOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/:provider/receive", ctrl.MuxHandler("preflight", handleSocialOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/:provider/register", ctrl.MuxHandler("preflight", handleSocialOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/:provider/register-start", ctrl.MuxHandler("preflight", handleSocialOrigin(cors.HandlePreflight()), nil))
 
 	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		// Check if there was an error loading the request
@@ -1715,16 +1420,16 @@ func MountTwitterController(service *goa.Service, ctrl TwitterController) {
 			return err
 		}
 		// Build the context
-		rctx, err := NewAttachToAccountTwitterContext(ctx, req, service)
+		rctx, err := NewAttachToAccountSocialContext(ctx, req, service)
 		if err != nil {
 			return err
 		}
 		return ctrl.AttachToAccount(rctx)
 	}
-	h = handleSecurity("jwt", h)
-	h = handleTwitterOrigin(h)
-	service.Mux.Handle("POST", "/api/v1/user/auth/twitter/attach", ctrl.MuxHandler("attach-to-account", h, nil))
-	service.LogInfo("mount", "ctrl", "Twitter", "action", "AttachToAccount", "route", "POST /api/v1/user/auth/twitter/attach", "security", "jwt")
+	h = handleSecurity("jwt", h, "account")
+	h = handleSocialOrigin(h)
+	service.Mux.Handle("POST", "/api/v1/user/auth/:provider/attach", ctrl.MuxHandler("attach-to-account", h, nil))
+	service.LogInfo("mount", "ctrl", "Social", "action", "AttachToAccount", "route", "POST /api/v1/user/auth/:provider/attach", "security", "jwt")
 
 	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		// Check if there was an error loading the request
@@ -1732,16 +1437,16 @@ func MountTwitterController(service *goa.Service, ctrl TwitterController) {
 			return err
 		}
 		// Build the context
-		rctx, err := NewDetachFromAccountTwitterContext(ctx, req, service)
+		rctx, err := NewDetachFromAccountSocialContext(ctx, req, service)
 		if err != nil {
 			return err
 		}
 		return ctrl.DetachFromAccount(rctx)
 	}
-	h = handleSecurity("jwt", h)
-	h = handleTwitterOrigin(h)
-	service.Mux.Handle("POST", "/api/v1/user/auth/twitter/detach", ctrl.MuxHandler("detach-from-account", h, nil))
-	service.LogInfo("mount", "ctrl", "Twitter", "action", "DetachFromAccount", "route", "POST /api/v1/user/auth/twitter/detach", "security", "jwt")
+	h = handleSecurity("jwt", h, "account")
+	h = handleSocialOrigin(h)
+	service.Mux.Handle("POST", "/api/v1/user/auth/:provider/detach", ctrl.MuxHandler("detach-from-account", h, nil))
+	service.LogInfo("mount", "ctrl", "Social", "action", "DetachFromAccount", "route", "POST /api/v1/user/auth/:provider/detach", "security", "jwt")
 
 	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		// Check if there was an error loading the request
@@ -1749,16 +1454,16 @@ func MountTwitterController(service *goa.Service, ctrl TwitterController) {
 			return err
 		}
 		// Build the context
-		rctx, err := NewLoginTwitterContext(ctx, req, service)
+		rctx, err := NewLoginSocialContext(ctx, req, service)
 		if err != nil {
 			return err
 		}
 		return ctrl.Login(rctx)
 	}
 	h = handleSecurity("key", h)
-	h = handleTwitterOrigin(h)
-	service.Mux.Handle("GET", "/api/v1/user/auth/twitter/login", ctrl.MuxHandler("login", h, nil))
-	service.LogInfo("mount", "ctrl", "Twitter", "action", "Login", "route", "GET /api/v1/user/auth/twitter/login", "security", "key")
+	h = handleSocialOrigin(h)
+	service.Mux.Handle("GET", "/api/v1/user/auth/:provider/login", ctrl.MuxHandler("login", h, nil))
+	service.LogInfo("mount", "ctrl", "Social", "action", "Login", "route", "GET /api/v1/user/auth/:provider/login", "security", "key")
 
 	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		// Check if there was an error loading the request
@@ -1766,16 +1471,16 @@ func MountTwitterController(service *goa.Service, ctrl TwitterController) {
 			return err
 		}
 		// Build the context
-		rctx, err := NewReceiveTwitterContext(ctx, req, service)
+		rctx, err := NewReceiveSocialContext(ctx, req, service)
 		if err != nil {
 			return err
 		}
 		return ctrl.Receive(rctx)
 	}
 	h = handleSecurity("key", h)
-	h = handleTwitterOrigin(h)
-	service.Mux.Handle("GET", "/api/v1/user/auth/twitter/receive", ctrl.MuxHandler("receive", h, nil))
-	service.LogInfo("mount", "ctrl", "Twitter", "action", "Receive", "route", "GET /api/v1/user/auth/twitter/receive", "security", "key")
+	h = handleSocialOrigin(h)
+	service.Mux.Handle("GET", "/api/v1/user/auth/:provider/receive", ctrl.MuxHandler("receive", h, nil))
+	service.LogInfo("mount", "ctrl", "Social", "action", "Receive", "route", "GET /api/v1/user/auth/:provider/receive", "security", "key")
 
 	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		// Check if there was an error loading the request
@@ -1783,22 +1488,44 @@ func MountTwitterController(service *goa.Service, ctrl TwitterController) {
 			return err
 		}
 		// Build the context
-		rctx, err := NewRegisterTwitterContext(ctx, req, service)
+		rctx, err := NewReceiveFormSocialContext(ctx, req, service)
 		if err != nil {
 			return err
 		}
 		// Build the payload
 		if rawPayload := goa.ContextRequest(ctx).Payload; rawPayload != nil {
-			rctx.Payload = rawPayload.(*TwitterRegisterParams)
+			rctx.Payload = rawPayload.(*SocialReceiveFormParams)
+		} else {
+			return goa.MissingPayloadError()
+		}
+		return ctrl.ReceiveForm(rctx)
+	}
+	h = handleSocialOrigin(h)
+	service.Mux.Handle("POST", "/api/v1/user/auth/:provider/receive", ctrl.MuxHandler("receive-form", h, unmarshalReceiveFormSocialPayload))
+	service.LogInfo("mount", "ctrl", "Social", "action", "ReceiveForm", "route", "POST /api/v1/user/auth/:provider/receive")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewRegisterSocialContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		// Build the payload
+		if rawPayload := goa.ContextRequest(ctx).Payload; rawPayload != nil {
+			rctx.Payload = rawPayload.(*SocialRegisterParams)
 		} else {
 			return goa.MissingPayloadError()
 		}
 		return ctrl.Register(rctx)
 	}
 	h = handleSecurity("key", h)
-	h = handleTwitterOrigin(h)
-	service.Mux.Handle("POST", "/api/v1/user/auth/twitter/register", ctrl.MuxHandler("register", h, unmarshalRegisterTwitterPayload))
-	service.LogInfo("mount", "ctrl", "Twitter", "action", "Register", "route", "POST /api/v1/user/auth/twitter/register", "security", "key")
+	h = handleSocialOrigin(h)
+	service.Mux.Handle("POST", "/api/v1/user/auth/:provider/register", ctrl.MuxHandler("register", h, unmarshalRegisterSocialPayload))
+	service.LogInfo("mount", "ctrl", "Social", "action", "Register", "route", "POST /api/v1/user/auth/:provider/register", "security", "key")
 
 	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		// Check if there was an error loading the request
@@ -1806,20 +1533,20 @@ func MountTwitterController(service *goa.Service, ctrl TwitterController) {
 			return err
 		}
 		// Build the context
-		rctx, err := NewRegisterURLTwitterContext(ctx, req, service)
+		rctx, err := NewRegisterURLSocialContext(ctx, req, service)
 		if err != nil {
 			return err
 		}
 		return ctrl.RegisterURL(rctx)
 	}
 	h = handleSecurity("key", h)
-	h = handleTwitterOrigin(h)
-	service.Mux.Handle("GET", "/api/v1/user/auth/twitter/register-start", ctrl.MuxHandler("register-url", h, nil))
-	service.LogInfo("mount", "ctrl", "Twitter", "action", "RegisterURL", "route", "GET /api/v1/user/auth/twitter/register-start", "security", "key")
+	h = handleSocialOrigin(h)
+	service.Mux.Handle("GET", "/api/v1/user/auth/:provider/register-start", ctrl.MuxHandler("register-url", h, nil))
+	service.LogInfo("mount", "ctrl", "Social", "action", "RegisterURL", "route", "GET /api/v1/user/auth/:provider/register-start", "security", "key")
 }
 
-// handleTwitterOrigin applies the CORS response headers corresponding to the origin.
-func handleTwitterOrigin(h goa.Handler) goa.Handler {
+// handleSocialOrigin applies the CORS response headers corresponding to the origin.
+func handleSocialOrigin(h goa.Handler) goa.Handler {
 
 	return func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		origin := req.Header.Get("Origin")
@@ -1842,9 +1569,9 @@ func handleTwitterOrigin(h goa.Handler) goa.Handler {
 	}
 }
 
-// unmarshalRegisterTwitterPayload unmarshals the request body into the context request data Payload field.
-func unmarshalRegisterTwitterPayload(ctx context.Context, service *goa.Service, req *http.Request) error {
-	payload := &twitterRegisterParams{}
+// unmarshalReceiveFormSocialPayload unmarshals the request body into the context request data Payload field.
+func unmarshalReceiveFormSocialPayload(ctx context.Context, service *goa.Service, req *http.Request) error {
+	payload := &socialReceiveFormParams{}
 	if err := service.DecodeRequest(req, payload); err != nil {
 		return err
 	}
@@ -1857,36 +1584,34 @@ func unmarshalRegisterTwitterPayload(ctx context.Context, service *goa.Service,
 	return nil
 }
 
-// UserController is the controller interface for the User actions.
-type UserController interface {
+// unmarshalRegisterSocialPayload unmarshals the request body into the context request data Payload field.
+func unmarshalRegisterSocialPayload(ctx context.Context, service *goa.Service, req *http.Request) error {
+	payload := &socialRegisterParams{}
+	if err := service.DecodeRequest(req, payload); err != nil {
+		return err
+	}
+	if err := payload.Validate(); err != nil {
+		// Initialize payload with private data structure so it can be logged
+		goa.ContextRequest(ctx).Payload = payload
+		return err
+	}
+	goa.ContextRequest(ctx).Payload = payload.Publicize()
+	return nil
+}
+
+// TokenController is the controller interface for the Token actions.
+type TokenController interface {
 	goa.Muxer
-	AddPlugin(*AddPluginUserContext) error
-	Deactivate(*DeactivateUserContext) error
-	GetAllUsers(*GetAllUsersUserContext) error
-	GetByEmail(*GetByEmailUserContext) error
-	GetMany(*GetManyUserContext) error
-	GetAuths(*GetAuthsUserContext) error
-	ResendVerifyEmail(*ResendVerifyEmailUserContext) error
-	Retrieve(*RetrieveUserContext) error
-	Update(*UpdateUserContext) error
-	UpdateAdmin(*UpdateAdminUserContext) error
-	UpdatePluginPermissions(*UpdatePluginPermissionsUserContext) error
-	ValidateEmail(*ValidateEmailUserContext) error
+	Introspect(*IntrospectTokenContext) error
+	Revoke(*RevokeTokenContext) error
 }
 
-// MountUserController "mounts" a User resource controller on the given service.
-func MountUserController(service *goa.Service, ctrl UserController) {
+// MountTokenController "mounts" a Token resource controller on the given service.
+func MountTokenController(service *goa.Service, ctrl TokenController) {
 	initService(service)
 	var h goa.Handler
-	service.Mux.Handle("OPTIONS", "/api/v1/user/user/plugins", ctrl.MuxHandler("preflight", handleUserOrigin(cors.HandlePreflight()), nil))
-	service.Mux.Handle("OPTIONS", "/api/v1/user/user", ctrl.MuxHandler("preflight", handleUserOrigin(cors.HandlePreflight()), nil))
-	service.Mux.Handle("OPTIONS", "/api/v1/user/user/all", ctrl.MuxHandler("preflight", handleUserOrigin(cors.HandlePreflight()), nil))
-	service.Mux.Handle("OPTIONS", "/api/v1/user/user/email", ctrl.MuxHandler("preflight", handleUserOrigin(cors.HandlePreflight()), nil))
-	service.Mux.Handle("OPTIONS", "/api/v1/user/user/multi", ctrl.MuxHandler("preflight", handleUserOrigin(cors.HandlePreflight()), nil))
-	service.Mux.Handle("OPTIONS", "/api/v1/user/user/authstat", ctrl.MuxHandler("preflight", handleUserOrigin(cors.HandlePreflight()), nil))
-	service.Mux.Handle("OPTIONS", "/api/v1/user/user/resend-verify", ctrl.MuxHandler("preflight", handleUserOrigin(cors.HandlePreflight()), nil))
-	service.Mux.Handle("OPTIONS", "/api/v1/user/user/update-user", ctrl.MuxHandler("preflight", handleUserOrigin(cors.HandlePreflight()), nil))
-	service.Mux.Handle("OPTIONS", "/verifyemail/:validateID", ctrl.MuxHandler("preflight", handleUserOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/api/v1/user/introspect", ctrl.MuxHandler("preflight", handleTokenOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/api/v1/user/revoke", ctrl.MuxHandler("preflight", handleTokenOrigin(cors.HandlePreflight()), nil))
 
 	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		// Check if there was an error loading the request
@@ -1894,22 +1619,22 @@ func MountUserController(service *goa.Service, ctrl UserController) {
 			return err
 		}
 		// Build the context
-		rctx, err := NewAddPluginUserContext(ctx, req, service)
+		rctx, err := NewIntrospectTokenContext(ctx, req, service)
 		if err != nil {
 			return err
 		}
 		// Build the payload
 		if rawPayload := goa.ContextRequest(ctx).Payload; rawPayload != nil {
-			rctx.Payload = rawPayload.(*UserPlugin)
+			rctx.Payload = rawPayload.(*TokenParams)
 		} else {
 			return goa.MissingPayloadError()
 		}
-		return ctrl.AddPlugin(rctx)
+		return ctrl.Introspect(rctx)
 	}
 	h = handleSecurity("key", h)
-	h = handleUserOrigin(h)
-	service.Mux.Handle("POST", "/api/v1/user/user/plugins", ctrl.MuxHandler("add-plugin", h, unmarshalAddPluginUserPayload))
-	service.LogInfo("mount", "ctrl", "User", "action", "AddPlugin", "route", "POST /api/v1/user/user/plugins", "security", "key")
+	h = handleTokenOrigin(h)
+	service.Mux.Handle("POST", "/api/v1/user/introspect", ctrl.MuxHandler("introspect", h, unmarshalIntrospectTokenPayload))
+	service.LogInfo("mount", "ctrl", "Token", "action", "Introspect", "route", "POST /api/v1/user/introspect", "security", "key")
 
 	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		// Check if there was an error loading the request
@@ -1917,50 +1642,741 @@ func MountUserController(service *goa.Service, ctrl UserController) {
 			return err
 		}
 		// Build the context
-		rctx, err := NewDeactivateUserContext(ctx, req, service)
+		rctx, err := NewRevokeTokenContext(ctx, req, service)
 		if err != nil {
 			return err
 		}
-		return ctrl.Deactivate(rctx)
+		// Build the payload
+		if rawPayload := goa.ContextRequest(ctx).Payload; rawPayload != nil {
+			rctx.Payload = rawPayload.(*TokenParams)
+		} else {
+			return goa.MissingPayloadError()
+		}
+		return ctrl.Revoke(rctx)
 	}
-	h = handleSecurity("jwt", h)
-	h = handleUserOrigin(h)
-	service.Mux.Handle("DELETE", "/api/v1/user/user", ctrl.MuxHandler("deactivate", h, nil))
-	service.LogInfo("mount", "ctrl", "User", "action", "Deactivate", "route", "DELETE /api/v1/user/user", "security", "jwt")
+	h = handleSecurity("key", h)
+	h = handleTokenOrigin(h)
+	service.Mux.Handle("POST", "/api/v1/user/revoke", ctrl.MuxHandler("revoke", h, unmarshalRevokeTokenPayload))
+	service.LogInfo("mount", "ctrl", "Token", "action", "Revoke", "route", "POST /api/v1/user/revoke", "security", "key")
+}
 
-	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
-		// Check if there was an error loading the request
-		if err := goa.ContextError(ctx); err != nil {
-			return err
+// handleTokenOrigin applies the CORS response headers corresponding to the origin.
+func handleTokenOrigin(h goa.Handler) goa.Handler {
+
+	return func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		origin := req.Header.Get("Origin")
+		if origin == "" {
+			// Not a CORS request
+			return h(ctx, rw, req)
 		}
-		// Build the context
-		rctx, err := NewGetAllUsersUserContext(ctx, req, service)
-		if err != nil {
-			return err
+		if cors.MatchOrigin(origin, "*") {
+			ctx = goa.WithLogContext(ctx, "origin", origin)
+			rw.Header().Set("Access-Control-Allow-Origin", origin)
+			rw.Header().Set("Access-Control-Allow-Credentials", "false")
+			if acrm := req.Header.Get("Access-Control-Request-Method"); acrm != "" {
+				// We are handling a preflight request
+				rw.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
+			}
+			return h(ctx, rw, req)
 		}
-		return ctrl.GetAllUsers(rctx)
+
+		return h(ctx, rw, req)
 	}
-	h = handleSecurity("jwt", h)
-	h = handleUserOrigin(h)
-	service.Mux.Handle("GET", "/api/v1/user/user/all", ctrl.MuxHandler("get-all-users", h, nil))
-	service.LogInfo("mount", "ctrl", "User", "action", "GetAllUsers", "route", "GET /api/v1/user/user/all", "security", "jwt")
+}
 
-	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
-		// Check if there was an error loading the request
-		if err := goa.ContextError(ctx); err != nil {
-			return err
-		}
-		// Build the context
-		rctx, err := NewGetByEmailUserContext(ctx, req, service)
-		if err != nil {
-			return err
-		}
-		return ctrl.GetByEmail(rctx)
+// unmarshalIntrospectTokenPayload unmarshals the request body into the context request data Payload field.
+func unmarshalIntrospectTokenPayload(ctx context.Context, service *goa.Service, req *http.Request) error {
+	payload := &tokenParams{}
+	if err := service.DecodeRequest(req, payload); err != nil {
+		return err
+	}
+	if err := payload.Validate(); err != nil {
+		// Initialize payload with private data structure so it can be logged
+		goa.ContextRequest(ctx).Payload = payload
+		return err
+	}
+	goa.ContextRequest(ctx).Payload = payload.Publicize()
+	return nil
+}
+
+// unmarshalRevokeTokenPayload unmarshals the request body into the context request data Payload field.
+func unmarshalRevokeTokenPayload(ctx context.Context, service *goa.Service, req *http.Request) error {
+	payload := &tokenParams{}
+	if err := service.DecodeRequest(req, payload); err != nil {
+		return err
+	}
+	if err := payload.Validate(); err != nil {
+		// Initialize payload with private data structure so it can be logged
+		goa.ContextRequest(ctx).Payload = payload
+		return err
+	}
+	goa.ContextRequest(ctx).Payload = payload.Publicize()
+	return nil
+}
+
+// TwitterController is the controller interface for the Twitter actions.
+type TwitterController interface {
+	goa.Muxer
+	AttachToAccount(*AttachToAccountTwitterContext) error
+	DetachFromAccount(*DetachFromAccountTwitterContext) error
+	Login(*LoginTwitterContext) error
+	Receive(*ReceiveTwitterContext) error
+	Register(*RegisterTwitterContext) error
+	RegisterURL(*RegisterURLTwitterContext) error
+}
+
+// MountTwitterController "mounts" a Twitter resource controller on the given service.
+func MountTwitterController(service *goa.Service, ctrl TwitterController) {
+	initService(service)
+	var h goa.Handler
+	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/twitter/attach", ctrl.MuxHandler("preflight", handleTwitterOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/twitter/detach", ctrl.MuxHandler("preflight", handleTwitterOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/twitter/login", ctrl.MuxHandler("preflight", handleTwitterOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/twitter/receive", ctrl.MuxHandler("preflight", handleTwitterOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/twitter/register", ctrl.MuxHandler("preflight", handleTwitterOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/twitter/register-start", ctrl.MuxHandler("preflight", handleTwitterOrigin(cors.HandlePreflight()), nil))
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewAttachToAccountTwitterContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.AttachToAccount(rctx)
+	}
+	h = handleSecurity("jwt", h, "account")
+	h = handleTwitterOrigin(h)
+	service.Mux.Handle("POST", "/api/v1/user/auth/twitter/attach", ctrl.MuxHandler("attach-to-account", h, nil))
+	service.LogInfo("mount", "ctrl", "Twitter", "action", "AttachToAccount", "route", "POST /api/v1/user/auth/twitter/attach", "security", "jwt")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewDetachFromAccountTwitterContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.DetachFromAccount(rctx)
+	}
+	h = handleSecurity("jwt", h, "account")
+	h = handleTwitterOrigin(h)
+	service.Mux.Handle("POST", "/api/v1/user/auth/twitter/detach", ctrl.MuxHandler("detach-from-account", h, nil))
+	service.LogInfo("mount", "ctrl", "Twitter", "action", "DetachFromAccount", "route", "POST /api/v1/user/auth/twitter/detach", "security", "jwt")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewLoginTwitterContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.Login(rctx)
+	}
+	h = handleSecurity("key", h)
+	h = handleTwitterOrigin(h)
+	service.Mux.Handle("GET", "/api/v1/user/auth/twitter/login", ctrl.MuxHandler("login", h, nil))
+	service.LogInfo("mount", "ctrl", "Twitter", "action", "Login", "route", "GET /api/v1/user/auth/twitter/login", "security", "key")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewReceiveTwitterContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.Receive(rctx)
+	}
+	h = handleSecurity("key", h)
+	h = handleTwitterOrigin(h)
+	service.Mux.Handle("GET", "/api/v1/user/auth/twitter/receive", ctrl.MuxHandler("receive", h, nil))
+	service.LogInfo("mount", "ctrl", "Twitter", "action", "Receive", "route", "GET /api/v1/user/auth/twitter/receive", "security", "key")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewRegisterTwitterContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		// Build the payload
+		if rawPayload := goa.ContextRequest(ctx).Payload; rawPayload != nil {
+			rctx.Payload = rawPayload.(*TwitterRegisterParams)
+		} else {
+			return goa.MissingPayloadError()
+		}
+		return ctrl.Register(rctx)
+	}
+	h = handleSecurity("key", h)
+	h = handleTwitterOrigin(h)
+	service.Mux.Handle("POST", "/api/v1/user/auth/twitter/register", ctrl.MuxHandler("register", h, unmarshalRegisterTwitterPayload))
+	service.LogInfo("mount", "ctrl", "Twitter", "action", "Register", "route", "POST /api/v1/user/auth/twitter/register", "security", "key")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewRegisterURLTwitterContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.RegisterURL(rctx)
+	}
+	h = handleSecurity("key", h)
+	h = handleTwitterOrigin(h)
+	service.Mux.Handle("GET", "/api/v1/user/auth/twitter/register-start", ctrl.MuxHandler("register-url", h, nil))
+	service.LogInfo("mount", "ctrl", "Twitter", "action", "RegisterURL", "route", "GET /api/v1/user/auth/twitter/register-start", "security", "key")
+}
+
+// handleTwitterOrigin applies the CORS response headers corresponding to the origin.
+func handleTwitterOrigin(h goa.Handler) goa.Handler {
+
+	return func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		origin := req.Header.Get("Origin")
+		if origin == "" {
+			// Not a CORS request
+			return h(ctx, rw, req)
+		}
+		if cors.MatchOrigin(origin, "*") {
+			ctx = goa.WithLogContext(ctx, "origin", origin)
+			rw.Header().Set("Access-Control-Allow-Origin", origin)
+			rw.Header().Set("Access-Control-Allow-Credentials", "false")
+			if acrm := req.Header.Get("Access-Control-Request-Method"); acrm != "" {
+				// We are handling a preflight request
+				rw.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
+			}
+			return h(ctx, rw, req)
+		}
+
+		return h(ctx, rw, req)
+	}
+}
+
+// unmarshalRegisterTwitterPayload unmarshals the request body into the context request data Payload field.
+func unmarshalRegisterTwitterPayload(ctx context.Context, service *goa.Service, req *http.Request) error {
+	payload := &twitterRegisterParams{}
+	if err := service.DecodeRequest(req, payload); err != nil {
+		return err
+	}
+	if err := payload.Validate(); err != nil {
+		// Initialize payload with private data structure so it can be logged
+		goa.ContextRequest(ctx).Payload = payload
+		return err
+	}
+	goa.ContextRequest(ctx).Payload = payload.Publicize()
+	return nil
+}
+
+// TwoFactorController is the controller interface for the TwoFactor actions.
+type TwoFactorController interface {
+	goa.Muxer
+	Confirm(*ConfirmTwoFactorContext) error
+	Disable(*DisableTwoFactorContext) error
+	Enroll(*EnrollTwoFactorContext) error
+	SendPhoneCode(*SendPhoneCodeTwoFactorContext) error
+	Verify(*VerifyTwoFactorContext) error
+}
+
+// MountTwoFactorController "mounts" a TwoFactor resource controller on the given service.
+func MountTwoFactorController(service *goa.Service, ctrl TwoFactorController) {
+	initService(service)
+	var h goa.Handler
+	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/two-factor/confirm", ctrl.MuxHandler("preflight", handleTwoFactorOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/two-factor/disable", ctrl.MuxHandler("preflight", handleTwoFactorOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/two-factor/enroll", ctrl.MuxHandler("preflight", handleTwoFactorOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/two-factor/phone-code", ctrl.MuxHandler("preflight", handleTwoFactorOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/two-factor/verify", ctrl.MuxHandler("preflight", handleTwoFactorOrigin(cors.HandlePreflight()), nil))
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewConfirmTwoFactorContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		// Build the payload
+		if rawPayload := goa.ContextRequest(ctx).Payload; rawPayload != nil {
+			rctx.Payload = rawPayload.(*TwoFactorPasscodeParams)
+		} else {
+			return goa.MissingPayloadError()
+		}
+		return ctrl.Confirm(rctx)
+	}
+	h = handleSecurity("jwt", h, "account")
+	h = handleTwoFactorOrigin(h)
+	service.Mux.Handle("POST", "/api/v1/user/auth/two-factor/confirm", ctrl.MuxHandler("confirm", h, unmarshalConfirmTwoFactorPayload))
+	service.LogInfo("mount", "ctrl", "TwoFactor", "action", "Confirm", "route", "POST /api/v1/user/auth/two-factor/confirm", "security", "jwt")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewDisableTwoFactorContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		// Build the payload
+		if rawPayload := goa.ContextRequest(ctx).Payload; rawPayload != nil {
+			rctx.Payload = rawPayload.(*TwoFactorPasscodeParams)
+		} else {
+			return goa.MissingPayloadError()
+		}
+		return ctrl.Disable(rctx)
+	}
+	h = handleSecurity("jwt", h, "account")
+	h = handleTwoFactorOrigin(h)
+	service.Mux.Handle("POST", "/api/v1/user/auth/two-factor/disable", ctrl.MuxHandler("disable", h, unmarshalDisableTwoFactorPayload))
+	service.LogInfo("mount", "ctrl", "TwoFactor", "action", "Disable", "route", "POST /api/v1/user/auth/two-factor/disable", "security", "jwt")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewEnrollTwoFactorContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.Enroll(rctx)
+	}
+	h = handleSecurity("jwt", h, "account")
+	h = handleTwoFactorOrigin(h)
+	service.Mux.Handle("POST", "/api/v1/user/auth/two-factor/enroll", ctrl.MuxHandler("enroll", h, nil))
+	service.LogInfo("mount", "ctrl", "TwoFactor", "action", "Enroll", "route", "POST /api/v1/user/auth/two-factor/enroll", "security", "jwt")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewSendPhoneCodeTwoFactorContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.SendPhoneCode(rctx)
+	}
+	h = handleTwoFactorOrigin(h)
+	service.Mux.Handle("POST", "/api/v1/user/auth/two-factor/phone-code", ctrl.MuxHandler("send-phone-code", h, nil))
+	service.LogInfo("mount", "ctrl", "TwoFactor", "action", "SendPhoneCode", "route", "POST /api/v1/user/auth/two-factor/phone-code")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewVerifyTwoFactorContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		// Build the payload
+		if rawPayload := goa.ContextRequest(ctx).Payload; rawPayload != nil {
+			rctx.Payload = rawPayload.(*TwoFactorVerifyParams)
+		} else {
+			return goa.MissingPayloadError()
+		}
+		return ctrl.Verify(rctx)
+	}
+	h = handleTwoFactorOrigin(h)
+	service.Mux.Handle("POST", "/api/v1/user/auth/two-factor/verify", ctrl.MuxHandler("verify", h, unmarshalVerifyTwoFactorPayload))
+	service.LogInfo("mount", "ctrl", "TwoFactor", "action", "Verify", "route", "POST /api/v1/user/auth/two-factor/verify")
+}
+
+// handleTwoFactorOrigin applies the CORS response headers corresponding to the origin.
+func handleTwoFactorOrigin(h goa.Handler) goa.Handler {
+
+	return func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		origin := req.Header.Get("Origin")
+		if origin == "" {
+			// Not a CORS request
+			return h(ctx, rw, req)
+		}
+		if cors.MatchOrigin(origin, "*") {
+			ctx = goa.WithLogContext(ctx, "origin", origin)
+			rw.Header().Set("Access-Control-Allow-Origin", origin)
+			rw.Header().Set("Access-Control-Allow-Credentials", "false")
+			if acrm := req.Header.Get("Access-Control-Request-Method"); acrm != "" {
+				// We are handling a preflight request
+				rw.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
+			}
+			return h(ctx, rw, req)
+		}
+
+		return h(ctx, rw, req)
+	}
+}
+
+// unmarshalConfirmTwoFactorPayload unmarshals the request body into the context request data Payload field.
+func unmarshalConfirmTwoFactorPayload(ctx context.Context, service *goa.Service, req *http.Request) error {
+	payload := &twoFactorPasscodeParams{}
+	if err := service.DecodeRequest(req, payload); err != nil {
+		return err
+	}
+	if err := payload.Validate(); err != nil {
+		// Initialize payload with private data structure so it can be logged
+		goa.ContextRequest(ctx).Payload = payload
+		return err
+	}
+	goa.ContextRequest(ctx).Payload = payload.Publicize()
+	return nil
+}
+
+// unmarshalDisableTwoFactorPayload unmarshals the request body into the context request data Payload field.
+func unmarshalDisableTwoFactorPayload(ctx context.Context, service *goa.Service, req *http.Request) error {
+	payload := &twoFactorPasscodeParams{}
+	if err := service.DecodeRequest(req, payload); err != nil {
+		return err
+	}
+	if err := payload.Validate(); err != nil {
+		// Initialize payload with private data structure so it can be logged
+		goa.ContextRequest(ctx).Payload = payload
+		return err
+	}
+	goa.ContextRequest(ctx).Payload = payload.Publicize()
+	return nil
+}
+
+// unmarshalVerifyTwoFactorPayload unmarshals the request body into the context request data Payload field.
+func unmarshalVerifyTwoFactorPayload(ctx context.Context, service *goa.Service, req *http.Request) error {
+	payload := &twoFactorVerifyParams{}
+	if err := service.DecodeRequest(req, payload); err != nil {
+		return err
+	}
+	if err := payload.Validate(); err != nil {
+		// Initialize payload with private data structure so it can be logged
+		goa.ContextRequest(ctx).Payload = payload
+		return err
+	}
+	goa.ContextRequest(ctx).Payload = payload.Publicize()
+	return nil
+}
+
+// UserController is the controller interface for the User actions.
+type UserController interface {
+	goa.Muxer
+	AddPlugin(*AddPluginUserContext) error
+	Deactivate(*DeactivateUserContext) error
+	GetAllUsers(*GetAllUsersUserContext) error
+	GetByEmail(*GetByEmailUserContext) error
+	GetMany(*GetManyUserContext) error
+	GetAuths(*GetAuthsUserContext) error
+	ResendVerifyEmail(*ResendVerifyEmailUserContext) error
+	Retrieve(*RetrieveUserContext) error
+	SendPhoneCode(*SendPhoneCodeUserContext) error
+	SendVerifyCode(*SendVerifyCodeUserContext) error
+	Unlock(*UnlockUserContext) error
+	Update(*UpdateUserContext) error
+	UpdateAdmin(*UpdateAdminUserContext) error
+	UpdatePluginPermissions(*UpdatePluginPermissionsUserContext) error
+	ValidateEmail(*ValidateEmailUserContext) error
+	VerifyEmailCode(*VerifyEmailCodeUserContext) error
+	VerifyPhone(*VerifyPhoneUserContext) error
+}
+
+// MountUserController "mounts" a User resource controller on the given service.
+func MountUserController(service *goa.Service, ctrl UserController) {
+	initService(service)
+	var h goa.Handler
+	service.Mux.Handle("OPTIONS", "/api/v1/user/user/plugins", ctrl.MuxHandler("preflight", handleUserOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/api/v1/user/user", ctrl.MuxHandler("preflight", handleUserOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/api/v1/user/user/all", ctrl.MuxHandler("preflight", handleUserOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/api/v1/user/user/email", ctrl.MuxHandler("preflight", handleUserOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/api/v1/user/user/multi", ctrl.MuxHandler("preflight", handleUserOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/api/v1/user/user/authstat", ctrl.MuxHandler("preflight", handleUserOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/api/v1/user/user/resend-verify", ctrl.MuxHandler("preflight", handleUserOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/api/v1/user/user/phone/code", ctrl.MuxHandler("preflight", handleUserOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/api/v1/user/user/verify-code", ctrl.MuxHandler("preflight", handleUserOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/api/v1/user/user/unlock", ctrl.MuxHandler("preflight", handleUserOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/api/v1/user/user/update-user", ctrl.MuxHandler("preflight", handleUserOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/verifyemail/:validateID", ctrl.MuxHandler("preflight", handleUserOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/api/v1/user/user/verify-code/confirm", ctrl.MuxHandler("preflight", handleUserOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/api/v1/user/user/phone/verify", ctrl.MuxHandler("preflight", handleUserOrigin(cors.HandlePreflight()), nil))
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewAddPluginUserContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		// Build the payload
+		if rawPayload := goa.ContextRequest(ctx).Payload; rawPayload != nil {
+			rctx.Payload = rawPayload.(*UserPlugin)
+		} else {
+			return goa.MissingPayloadError()
+		}
+		return ctrl.AddPlugin(rctx)
+	}
+	h = handleSecurity("key", h)
+	h = handleUserOrigin(h)
+	service.Mux.Handle("POST", "/api/v1/user/user/plugins", ctrl.MuxHandler("add-plugin", h, unmarshalAddPluginUserPayload))
+	service.LogInfo("mount", "ctrl", "User", "action", "AddPlugin", "route", "POST /api/v1/user/user/plugins", "security", "key")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewDeactivateUserContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.Deactivate(rctx)
+	}
+	h = handleSecurity("jwt", h, "account")
+	h = handleUserOrigin(h)
+	service.Mux.Handle("DELETE", "/api/v1/user/user", ctrl.MuxHandler("deactivate", h, nil))
+	service.LogInfo("mount", "ctrl", "User", "action", "Deactivate", "route", "DELETE /api/v1/user/user", "security", "jwt")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewGetAllUsersUserContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.GetAllUsers(rctx)
+	}
+	h = handleSecurity("jwt", h, "admin")
+	h = handleUserOrigin(h)
+	service.Mux.Handle("GET", "/api/v1/user/user/all", ctrl.MuxHandler("get-all-users", h, nil))
+	service.LogInfo("mount", "ctrl", "User", "action", "GetAllUsers", "route", "GET /api/v1/user/user/all", "security", "jwt")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewGetByEmailUserContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.GetByEmail(rctx)
+	}
+	h = handleSecurity("key", h)
+	h = handleUserOrigin(h)
+	service.Mux.Handle("GET", "/api/v1/user/user/email", ctrl.MuxHandler("get-by-email", h, nil))
+	service.LogInfo("mount", "ctrl", "User", "action", "GetByEmail", "route", "GET /api/v1/user/user/email", "security", "key")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewGetManyUserContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.GetMany(rctx)
+	}
+	h = handleSecurity("key", h)
+	h = handleUserOrigin(h)
+	service.Mux.Handle("GET", "/api/v1/user/user/multi", ctrl.MuxHandler("get-many", h, nil))
+	service.LogInfo("mount", "ctrl", "User", "action", "GetMany", "route", "GET /api/v1/user/user/multi", "security", "key")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewGetAuthsUserContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.GetAuths(rctx)
+	}
+	h = handleSecurity("key", h)
+	h = handleUserOrigin(h)
+	service.Mux.Handle("GET", "/api/v1/user/user/authstat", ctrl.MuxHandler("getAuths", h, nil))
+	service.LogInfo("mount", "ctrl", "User", "action", "GetAuths", "route", "GET /api/v1/user/user/authstat", "security", "key")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewResendVerifyEmailUserContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.ResendVerifyEmail(rctx)
+	}
+	h = handleSecurity("key", h)
+	h = handleUserOrigin(h)
+	service.Mux.Handle("POST", "/api/v1/user/user/resend-verify", ctrl.MuxHandler("resend-verify-email", h, nil))
+	service.LogInfo("mount", "ctrl", "User", "action", "ResendVerifyEmail", "route", "POST /api/v1/user/user/resend-verify", "security", "key")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewRetrieveUserContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.Retrieve(rctx)
+	}
+	h = handleSecurity("key", h)
+	h = handleUserOrigin(h)
+	service.Mux.Handle("GET", "/api/v1/user/user", ctrl.MuxHandler("retrieve", h, nil))
+	service.LogInfo("mount", "ctrl", "User", "action", "Retrieve", "route", "GET /api/v1/user/user", "security", "key")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewSendPhoneCodeUserContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.SendPhoneCode(rctx)
+	}
+	h = handleSecurity("jwt", h, "profile")
+	h = handleUserOrigin(h)
+	service.Mux.Handle("POST", "/api/v1/user/user/phone/code", ctrl.MuxHandler("send-phone-code", h, nil))
+	service.LogInfo("mount", "ctrl", "User", "action", "SendPhoneCode", "route", "POST /api/v1/user/user/phone/code", "security", "jwt")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewSendVerifyCodeUserContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.SendVerifyCode(rctx)
+	}
+	h = handleSecurity("jwt", h, "profile")
+	h = handleUserOrigin(h)
+	service.Mux.Handle("POST", "/api/v1/user/user/verify-code", ctrl.MuxHandler("send-verify-code", h, nil))
+	service.LogInfo("mount", "ctrl", "User", "action", "SendVerifyCode", "route", "POST /api/v1/user/user/verify-code", "security", "jwt")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewUnlockUserContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.Unlock(rctx)
+	}
+	h = handleSecurity("jwt", h, "admin")
+	h = handleUserOrigin(h)
+	service.Mux.Handle("POST", "/api/v1/user/user/unlock", ctrl.MuxHandler("unlock", h, nil))
+	service.LogInfo("mount", "ctrl", "User", "action", "Unlock", "route", "POST /api/v1/user/user/unlock", "security", "jwt")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewUpdateUserContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		// Build the payload
+		if rawPayload := goa.ContextRequest(ctx).Payload; rawPayload != nil {
+			rctx.Payload = rawPayload.(*UserParams)
+		} else {
+			return goa.MissingPayloadError()
+		}
+		return ctrl.Update(rctx)
+	}
+	h = handleSecurity("jwt", h, "profile")
+	h = handleUserOrigin(h)
+	service.Mux.Handle("PATCH", "/api/v1/user/user", ctrl.MuxHandler("update", h, unmarshalUpdateUserPayload))
+	service.LogInfo("mount", "ctrl", "User", "action", "Update", "route", "PATCH /api/v1/user/user", "security", "jwt")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewUpdateAdminUserContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		// Build the payload
+		if rawPayload := goa.ContextRequest(ctx).Payload; rawPayload != nil {
+			rctx.Payload = rawPayload.(*UserParamsAdmin)
+		} else {
+			return goa.MissingPayloadError()
+		}
+		return ctrl.UpdateAdmin(rctx)
+	}
+	h = handleSecurity("jwt", h, "admin")
+	h = handleUserOrigin(h)
+	service.Mux.Handle("PATCH", "/api/v1/user/user/update-user", ctrl.MuxHandler("update-admin", h, unmarshalUpdateAdminUserPayload))
+	service.LogInfo("mount", "ctrl", "User", "action", "UpdateAdmin", "route", "PATCH /api/v1/user/user/update-user", "security", "jwt")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewUpdatePluginPermissionsUserContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		// Build the payload
+		if rawPayload := goa.ContextRequest(ctx).Payload; rawPayload != nil {
+			rctx.Payload = rawPayload.(*UserPlugin)
+		} else {
+			return goa.MissingPayloadError()
+		}
+		return ctrl.UpdatePluginPermissions(rctx)
 	}
 	h = handleSecurity("key", h)
 	h = handleUserOrigin(h)
-	service.Mux.Handle("GET", "/api/v1/user/user/email", ctrl.MuxHandler("get-by-email", h, nil))
-	service.LogInfo("mount", "ctrl", "User", "action", "GetByEmail", "route", "GET /api/v1/user/user/email", "security", "key")
+	service.Mux.Handle("PUT", "/api/v1/user/user/plugins", ctrl.MuxHandler("update-plugin-permissions", h, unmarshalUpdatePluginPermissionsUserPayload))
+	service.LogInfo("mount", "ctrl", "User", "action", "UpdatePluginPermissions", "route", "PUT /api/v1/user/user/plugins", "security", "key")
 
 	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		// Check if there was an error loading the request
@@ -1968,16 +2384,16 @@ func MountUserController(service *goa.Service, ctrl UserController) {
 			return err
 		}
 		// Build the context
-		rctx, err := NewGetManyUserContext(ctx, req, service)
+		rctx, err := NewValidateEmailUserContext(ctx, req, service)
 		if err != nil {
 			return err
 		}
-		return ctrl.GetMany(rctx)
+		return ctrl.ValidateEmail(rctx)
 	}
 	h = handleSecurity("key", h)
 	h = handleUserOrigin(h)
-	service.Mux.Handle("GET", "/api/v1/user/user/multi", ctrl.MuxHandler("get-many", h, nil))
-	service.LogInfo("mount", "ctrl", "User", "action", "GetMany", "route", "GET /api/v1/user/user/multi", "security", "key")
+	service.Mux.Handle("GET", "/verifyemail/:validateID", ctrl.MuxHandler("validate-email", h, nil))
+	service.LogInfo("mount", "ctrl", "User", "action", "ValidateEmail", "route", "GET /verifyemail/:validateID", "security", "key")
 
 	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		// Check if there was an error loading the request
@@ -1985,16 +2401,22 @@ func MountUserController(service *goa.Service, ctrl UserController) {
 			return err
 		}
 		// Build the context
-		rctx, err := NewGetAuthsUserContext(ctx, req, service)
+		rctx, err := NewVerifyEmailCodeUserContext(ctx, req, service)
 		if err != nil {
 			return err
 		}
-		return ctrl.GetAuths(rctx)
+		// Build the payload
+		if rawPayload := goa.ContextRequest(ctx).Payload; rawPayload != nil {
+			rctx.Payload = rawPayload.(*EmailCodeParams)
+		} else {
+			return goa.MissingPayloadError()
+		}
+		return ctrl.VerifyEmailCode(rctx)
 	}
-	h = handleSecurity("key", h)
+	h = handleSecurity("jwt", h, "profile")
 	h = handleUserOrigin(h)
-	service.Mux.Handle("GET", "/api/v1/user/user/authstat", ctrl.MuxHandler("getAuths", h, nil))
-	service.LogInfo("mount", "ctrl", "User", "action", "GetAuths", "route", "GET /api/v1/user/user/authstat", "security", "key")
+	service.Mux.Handle("POST", "/api/v1/user/user/verify-code/confirm", ctrl.MuxHandler("verify-email-code", h, unmarshalVerifyEmailCodeUserPayload))
+	service.LogInfo("mount", "ctrl", "User", "action", "VerifyEmailCode", "route", "POST /api/v1/user/user/verify-code/confirm", "security", "jwt")
 
 	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		// Check if there was an error loading the request
@@ -2002,16 +2424,144 @@ func MountUserController(service *goa.Service, ctrl UserController) {
 			return err
 		}
 		// Build the context
-		rctx, err := NewResendVerifyEmailUserContext(ctx, req, service)
+		rctx, err := NewVerifyPhoneUserContext(ctx, req, service)
 		if err != nil {
 			return err
 		}
-		return ctrl.ResendVerifyEmail(rctx)
+		// Build the payload
+		if rawPayload := goa.ContextRequest(ctx).Payload; rawPayload != nil {
+			rctx.Payload = rawPayload.(*PhoneCodeParams)
+		} else {
+			return goa.MissingPayloadError()
+		}
+		return ctrl.VerifyPhone(rctx)
 	}
-	h = handleSecurity("key", h)
+	h = handleSecurity("jwt", h, "profile")
 	h = handleUserOrigin(h)
-	service.Mux.Handle("POST", "/api/v1/user/user/resend-verify", ctrl.MuxHandler("resend-verify-email", h, nil))
-	service.LogInfo("mount", "ctrl", "User", "action", "ResendVerifyEmail", "route", "POST /api/v1/user/user/resend-verify", "security", "key")
+	service.Mux.Handle("POST", "/api/v1/user/user/phone/verify", ctrl.MuxHandler("verify-phone", h, unmarshalVerifyPhoneUserPayload))
+	service.LogInfo("mount", "ctrl", "User", "action", "VerifyPhone", "route", "POST /api/v1/user/user/phone/verify", "security", "jwt")
+}
+
+// handleUserOrigin applies the CORS response headers corresponding to the origin.
+func handleUserOrigin(h goa.Handler) goa.Handler {
+
+	return func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		origin := req.Header.Get("Origin")
+		if origin == "" {
+			// Not a CORS request
+			return h(ctx, rw, req)
+		}
+		if cors.MatchOrigin(origin, "*") {
+			ctx = goa.WithLogContext(ctx, "origin", origin)
+			rw.Header().Set("Access-Control-Allow-Origin", origin)
+			rw.Header().Set("Access-Control-Allow-Credentials", "false")
+			if acrm := req.Header.Get("Access-Control-Request-Method"); acrm != "" {
+				// We are handling a preflight request
+				rw.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
+			}
+			return h(ctx, rw, req)
+		}
+
+		return h(ctx, rw, req)
+	}
+}
+
+// unmarshalAddPluginUserPayload unmarshals the request body into the context request data Payload field.
+func unmarshalAddPluginUserPayload(ctx context.Context, service *goa.Service, req *http.Request) error {
+	payload := &userPlugin{}
+	if err := service.DecodeRequest(req, payload); err != nil {
+		return err
+	}
+	goa.ContextRequest(ctx).Payload = payload.Publicize()
+	return nil
+}
+
+// unmarshalUpdateUserPayload unmarshals the request body into the context request data Payload field.
+func unmarshalUpdateUserPayload(ctx context.Context, service *goa.Service, req *http.Request) error {
+	payload := &userParams{}
+	if err := service.DecodeRequest(req, payload); err != nil {
+		return err
+	}
+	if err := payload.Validate(); err != nil {
+		// Initialize payload with private data structure so it can be logged
+		goa.ContextRequest(ctx).Payload = payload
+		return err
+	}
+	goa.ContextRequest(ctx).Payload = payload.Publicize()
+	return nil
+}
+
+// unmarshalUpdateAdminUserPayload unmarshals the request body into the context request data Payload field.
+func unmarshalUpdateAdminUserPayload(ctx context.Context, service *goa.Service, req *http.Request) error {
+	payload := &userParamsAdmin{}
+	if err := service.DecodeRequest(req, payload); err != nil {
+		return err
+	}
+	goa.ContextRequest(ctx).Payload = payload.Publicize()
+	return nil
+}
+
+// unmarshalUpdatePluginPermissionsUserPayload unmarshals the request body into the context request data Payload field.
+func unmarshalUpdatePluginPermissionsUserPayload(ctx context.Context, service *goa.Service, req *http.Request) error {
+	payload := &userPlugin{}
+	if err := service.DecodeRequest(req, payload); err != nil {
+		return err
+	}
+	goa.ContextRequest(ctx).Payload = payload.Publicize()
+	return nil
+}
+
+// unmarshalVerifyEmailCodeUserPayload unmarshals the request body into the context request data Payload field.
+func unmarshalVerifyEmailCodeUserPayload(ctx context.Context, service *goa.Service, req *http.Request) error {
+	payload := &emailCodeParams{}
+	if err := service.DecodeRequest(req, payload); err != nil {
+		return err
+	}
+	if err := payload.Validate(); err != nil {
+		// Initialize payload with private data structure so it can be logged
+		goa.ContextRequest(ctx).Payload = payload
+		return err
+	}
+	goa.ContextRequest(ctx).Payload = payload.Publicize()
+	return nil
+}
+
+// unmarshalVerifyPhoneUserPayload unmarshals the request body into the context request data Payload field.
+func unmarshalVerifyPhoneUserPayload(ctx context.Context, service *goa.Service, req *http.Request) error {
+	payload := &phoneCodeParams{}
+	if err := service.DecodeRequest(req, payload); err != nil {
+		return err
+	}
+	if err := payload.Validate(); err != nil {
+		// Initialize payload with private data structure so it can be logged
+		goa.ContextRequest(ctx).Payload = payload
+		return err
+	}
+	goa.ContextRequest(ctx).Payload = payload.Publicize()
+	return nil
+}
+
+// WebauthnController is the controller interface for the Webauthn actions.
+type WebauthnController interface {
+	goa.Muxer
+	List(*ListWebauthnContext) error
+	LoginFinish(*LoginFinishWebauthnContext) error
+	LoginStart(*LoginStartWebauthnContext) error
+	RegisterFinish(*RegisterFinishWebauthnContext) error
+	RegisterStart(*RegisterStartWebauthnContext) error
+	Remove(*RemoveWebauthnContext) error
+}
+
+// MountWebauthnController "mounts" a Webauthn resource controller on the given service.
+func MountWebauthnController(service *goa.Service, ctrl WebauthnController) {
+	initService(service)
+	var h goa.Handler
+	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/webauthn", ctrl.MuxHandler("preflight", handleWebauthnOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/webauthn/login/finish", ctrl.MuxHandler("preflight", handleWebauthnOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/webauthn/login/start", ctrl.MuxHandler("preflight", handleWebauthnOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/webauthn/register/finish", ctrl.MuxHandler("preflight", handleWebauthnOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/webauthn/register/start", ctrl.MuxHandler("preflight", handleWebauthnOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/api/v1/user/auth/webauthn/:id", ctrl.MuxHandler("preflight", handleWebauthnOrigin(cors.HandlePreflight()), nil))
 
 	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		// Check if there was an error loading the request
@@ -2019,16 +2569,16 @@ func MountUserController(service *goa.Service, ctrl UserController) {
 			return err
 		}
 		// Build the context
-		rctx, err := NewRetrieveUserContext(ctx, req, service)
+		rctx, err := NewListWebauthnContext(ctx, req, service)
 		if err != nil {
 			return err
 		}
-		return ctrl.Retrieve(rctx)
+		return ctrl.List(rctx)
 	}
-	h = handleSecurity("key", h)
-	h = handleUserOrigin(h)
-	service.Mux.Handle("GET", "/api/v1/user/user", ctrl.MuxHandler("retrieve", h, nil))
-	service.LogInfo("mount", "ctrl", "User", "action", "Retrieve", "route", "GET /api/v1/user/user", "security", "key")
+	h = handleSecurity("jwt", h, "account")
+	h = handleWebauthnOrigin(h)
+	service.Mux.Handle("GET", "/api/v1/user/auth/webauthn", ctrl.MuxHandler("list", h, nil))
+	service.LogInfo("mount", "ctrl", "Webauthn", "action", "List", "route", "GET /api/v1/user/auth/webauthn", "security", "jwt")
 
 	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		// Check if there was an error loading the request
@@ -2036,22 +2586,22 @@ func MountUserController(service *goa.Service, ctrl UserController) {
 			return err
 		}
 		// Build the context
-		rctx, err := NewUpdateUserContext(ctx, req, service)
+		rctx, err := NewLoginFinishWebauthnContext(ctx, req, service)
 		if err != nil {
 			return err
 		}
 		// Build the payload
 		if rawPayload := goa.ContextRequest(ctx).Payload; rawPayload != nil {
-			rctx.Payload = rawPayload.(*UserParams)
+			rctx.Payload = rawPayload.(*WebauthnFinishParams)
 		} else {
 			return goa.MissingPayloadError()
 		}
-		return ctrl.Update(rctx)
+		return ctrl.LoginFinish(rctx)
 	}
-	h = handleSecurity("jwt", h)
-	h = handleUserOrigin(h)
-	service.Mux.Handle("PATCH", "/api/v1/user/user", ctrl.MuxHandler("update", h, unmarshalUpdateUserPayload))
-	service.LogInfo("mount", "ctrl", "User", "action", "Update", "route", "PATCH /api/v1/user/user", "security", "jwt")
+	h = handleSecurity("key", h)
+	h = handleWebauthnOrigin(h)
+	service.Mux.Handle("POST", "/api/v1/user/auth/webauthn/login/finish", ctrl.MuxHandler("login-finish", h, unmarshalLoginFinishWebauthnPayload))
+	service.LogInfo("mount", "ctrl", "Webauthn", "action", "LoginFinish", "route", "POST /api/v1/user/auth/webauthn/login/finish", "security", "key")
 
 	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		// Check if there was an error loading the request
@@ -2059,22 +2609,22 @@ func MountUserController(service *goa.Service, ctrl UserController) {
 			return err
 		}
 		// Build the context
-		rctx, err := NewUpdateAdminUserContext(ctx, req, service)
+		rctx, err := NewLoginStartWebauthnContext(ctx, req, service)
 		if err != nil {
 			return err
 		}
 		// Build the payload
 		if rawPayload := goa.ContextRequest(ctx).Payload; rawPayload != nil {
-			rctx.Payload = rawPayload.(*UserParamsAdmin)
+			rctx.Payload = rawPayload.(*WebauthnLoginParams)
 		} else {
 			return goa.MissingPayloadError()
 		}
-		return ctrl.UpdateAdmin(rctx)
+		return ctrl.LoginStart(rctx)
 	}
-	h = handleSecurity("jwt", h)
-	h = handleUserOrigin(h)
-	service.Mux.Handle("PATCH", "/api/v1/user/user/update-user", ctrl.MuxHandler("update-admin", h, unmarshalUpdateAdminUserPayload))
-	service.LogInfo("mount", "ctrl", "User", "action", "UpdateAdmin", "route", "PATCH /api/v1/user/user/update-user", "security", "jwt")
+	h = handleSecurity("key", h)
+	h = handleWebauthnOrigin(h)
+	service.Mux.Handle("POST", "/api/v1/user/auth/webauthn/login/start", ctrl.MuxHandler("login-start", h, unmarshalLoginStartWebauthnPayload))
+	service.LogInfo("mount", "ctrl", "Webauthn", "action", "LoginStart", "route", "POST /api/v1/user/auth/webauthn/login/start", "security", "key")
 
 	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		// Check if there was an error loading the request
@@ -2082,22 +2632,22 @@ func MountUserController(service *goa.Service, ctrl UserController) {
 			return err
 		}
 		// Build the context
-		rctx, err := NewUpdatePluginPermissionsUserContext(ctx, req, service)
+		rctx, err := NewRegisterFinishWebauthnContext(ctx, req, service)
 		if err != nil {
 			return err
 		}
 		// Build the payload
 		if rawPayload := goa.ContextRequest(ctx).Payload; rawPayload != nil {
-			rctx.Payload = rawPayload.(*UserPlugin)
+			rctx.Payload = rawPayload.(*WebauthnFinishParams)
 		} else {
 			return goa.MissingPayloadError()
 		}
-		return ctrl.UpdatePluginPermissions(rctx)
+		return ctrl.RegisterFinish(rctx)
 	}
-	h = handleSecurity("key", h)
-	h = handleUserOrigin(h)
-	service.Mux.Handle("PUT", "/api/v1/user/user/plugins", ctrl.MuxHandler("update-plugin-permissions", h, unmarshalUpdatePluginPermissionsUserPayload))
-	service.LogInfo("mount", "ctrl", "User", "action", "UpdatePluginPermissions", "route", "PUT /api/v1/user/user/plugins", "security", "key")
+	h = handleSecurity("jwt", h, "account")
+	h = handleWebauthnOrigin(h)
+	service.Mux.Handle("POST", "/api/v1/user/auth/webauthn/register/finish", ctrl.MuxHandler("register-finish", h, unmarshalRegisterFinishWebauthnPayload))
+	service.LogInfo("mount", "ctrl", "Webauthn", "action", "RegisterFinish", "route", "POST /api/v1/user/auth/webauthn/register/finish", "security", "jwt")
 
 	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		// Check if there was an error loading the request
@@ -2105,20 +2655,37 @@ func MountUserController(service *goa.Service, ctrl UserController) {
 			return err
 		}
 		// Build the context
-		rctx, err := NewValidateEmailUserContext(ctx, req, service)
+		rctx, err := NewRegisterStartWebauthnContext(ctx, req, service)
 		if err != nil {
 			return err
 		}
-		return ctrl.ValidateEmail(rctx)
+		return ctrl.RegisterStart(rctx)
 	}
-	h = handleSecurity("key", h)
-	h = handleUserOrigin(h)
-	service.Mux.Handle("GET", "/verifyemail/:validateID", ctrl.MuxHandler("validate-email", h, nil))
-	service.LogInfo("mount", "ctrl", "User", "action", "ValidateEmail", "route", "GET /verifyemail/:validateID", "security", "key")
+	h = handleSecurity("jwt", h, "account")
+	h = handleWebauthnOrigin(h)
+	service.Mux.Handle("POST", "/api/v1/user/auth/webauthn/register/start", ctrl.MuxHandler("register-start", h, nil))
+	service.LogInfo("mount", "ctrl", "Webauthn", "action", "RegisterStart", "route", "POST /api/v1/user/auth/webauthn/register/start", "security", "jwt")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewRemoveWebauthnContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.Remove(rctx)
+	}
+	h = handleSecurity("jwt", h, "account")
+	h = handleWebauthnOrigin(h)
+	service.Mux.Handle("DELETE", "/api/v1/user/auth/webauthn/:id", ctrl.MuxHandler("remove", h, nil))
+	service.LogInfo("mount", "ctrl", "Webauthn", "action", "Remove", "route", "DELETE /api/v1/user/auth/webauthn/:id", "security", "jwt")
 }
 
-// handleUserOrigin applies the CORS response headers corresponding to the origin.
-func handleUserOrigin(h goa.Handler) goa.Handler {
+// handleWebauthnOrigin applies the CORS response headers corresponding to the origin.
+func handleWebauthnOrigin(h goa.Handler) goa.Handler {
 
 	return func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
 		origin := req.Header.Get("Origin")
@@ -2141,19 +2708,24 @@ func handleUserOrigin(h goa.Handler) goa.Handler {
 	}
 }
 
-// unmarshalAddPluginUserPayload unmarshals the request body into the context request data Payload field.
-func unmarshalAddPluginUserPayload(ctx context.Context, service *goa.Service, req *http.Request) error {
-	payload := &userPlugin{}
+// unmarshalLoginFinishWebauthnPayload unmarshals the request body into the context request data Payload field.
+func unmarshalLoginFinishWebauthnPayload(ctx context.Context, service *goa.Service, req *http.Request) error {
+	payload := &webauthnFinishParams{}
 	if err := service.DecodeRequest(req, payload); err != nil {
 		return err
 	}
+	if err := payload.Validate(); err != nil {
+		// Initialize payload with private data structure so it can be logged
+		goa.ContextRequest(ctx).Payload = payload
+		return err
+	}
 	goa.ContextRequest(ctx).Payload = payload.Publicize()
 	return nil
 }
 
-// unmarshalUpdateUserPayload unmarshals the request body into the context request data Payload field.
-func unmarshalUpdateUserPayload(ctx context.Context, service *goa.Service, req *http.Request) error {
-	payload := &userParams{}
+// unmarshalLoginStartWebauthnPayload unmarshals the request body into the context request data Payload field.
+func unmarshalLoginStartWebauthnPayload(ctx context.Context, service *goa.Service, req *http.Request) error {
+	payload := &webauthnLoginParams{}
 	if err := service.DecodeRequest(req, payload); err != nil {
 		return err
 	}
@@ -2166,22 +2738,88 @@ func unmarshalUpdateUserPayload(ctx context.Context, service *goa.Service, req *
 	return nil
 }
 
-// unmarshalUpdateAdminUserPayload unmarshals the request body into the context request data Payload field.
-func unmarshalUpdateAdminUserPayload(ctx context.Context, service *goa.Service, req *http.Request) error {
-	payload := &userParamsAdmin{}
+// unmarshalRegisterFinishWebauthnPayload unmarshals the request body into the context request data Payload field.
+func unmarshalRegisterFinishWebauthnPayload(ctx context.Context, service *goa.Service, req *http.Request) error {
+	payload := &webauthnFinishParams{}
 	if err := service.DecodeRequest(req, payload); err != nil {
 		return err
 	}
+	if err := payload.Validate(); err != nil {
+		// Initialize payload with private data structure so it can be logged
+		goa.ContextRequest(ctx).Payload = payload
+		return err
+	}
 	goa.ContextRequest(ctx).Payload = payload.Publicize()
 	return nil
 }
 
-// unmarshalUpdatePluginPermissionsUserPayload unmarshals the request body into the context request data Payload field.
-func unmarshalUpdatePluginPermissionsUserPayload(ctx context.Context, service *goa.Service, req *http.Request) error {
-	payload := &userPlugin{}
-	if err := service.DecodeRequest(req, payload); err != nil {
-		return err
+// WellKnownController is the controller interface for the WellKnown actions.
+type WellKnownController interface {
+	goa.Muxer
+	KeySet(*KeySetWellKnownContext) error
+	OpenidConfiguration(*OpenidConfigurationWellKnownContext) error
+}
+
+// MountWellKnownController "mounts" a WellKnown resource controller on the given service.
+func MountWellKnownController(service *goa.Service, ctrl WellKnownController) {
+	initService(service)
+	var h goa.Handler
+	service.Mux.Handle("OPTIONS", "/.well-known/jwks.json", ctrl.MuxHandler("preflight", handleWellKnownOrigin(cors.HandlePreflight()), nil))
+	service.Mux.Handle("OPTIONS", "/.well-known/openid-configuration", ctrl.MuxHandler("preflight", handleWellKnownOrigin(cors.HandlePreflight()), nil))
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewKeySetWellKnownContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.KeySet(rctx)
+	}
+	h = handleWellKnownOrigin(h)
+	service.Mux.Handle("GET", "/.well-known/jwks.json", ctrl.MuxHandler("key-set", h, nil))
+	service.LogInfo("mount", "ctrl", "WellKnown", "action", "KeySet", "route", "GET /.well-known/jwks.json")
+
+	h = func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		// Check if there was an error loading the request
+		if err := goa.ContextError(ctx); err != nil {
+			return err
+		}
+		// Build the context
+		rctx, err := NewOpenidConfigurationWellKnownContext(ctx, req, service)
+		if err != nil {
+			return err
+		}
+		return ctrl.OpenidConfiguration(rctx)
+	}
+	h = handleWellKnownOrigin(h)
+	service.Mux.Handle("GET", "/.well-known/openid-configuration", ctrl.MuxHandler("openid-configuration", h, nil))
+	service.LogInfo("mount", "ctrl", "WellKnown", "action", "OpenidConfiguration", "route", "GET /.well-known/openid-configuration")
+}
+
+// handleWellKnownOrigin applies the CORS response headers corresponding to the origin.
+func handleWellKnownOrigin(h goa.Handler) goa.Handler {
+
+	return func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+		origin := req.Header.Get("Origin")
+		if origin == "" {
+			// Not a CORS request
+			return h(ctx, rw, req)
+		}
+		if cors.MatchOrigin(origin, "*") {
+			ctx = goa.WithLogContext(ctx, "origin", origin)
+			rw.Header().Set("Access-Control-Allow-Origin", origin)
+			rw.Header().Set("Access-Control-Allow-Credentials", "false")
+			if acrm := req.Header.Get("Access-Control-Request-Method"); acrm != "" {
+				// We are handling a preflight request
+				rw.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
+			}
+			return h(ctx, rw, req)
+		}
+
+		return h(ctx, rw, req)
 	}
-	goa.ContextRequest(ctx).Payload = payload.Publicize()
-	return nil
 }
@@ -37,39 +37,12 @@ func (mt *AllSessions) Validate() (err error) {
 	return
 }
 
-// Information used to pre-populate the register page (default view)
-//
-// Identifier: amazon-register-media; view=default
-type AmazonRegisterMedia struct {
-	// An email extracted from the Amazon account
-	Email string `form:"email" json:"email" yaml:"email" xml:"email"`
-	// The given name for the user, pulled from the Amazon account
-	FirstName string `form:"firstName" json:"firstName" yaml:"firstName" xml:"firstName"`
-	// The family name for the user, pulled from the Amazon account
-	LastName string `form:"lastName" json:"lastName" yaml:"lastName" xml:"lastName"`
-	// A key used to connect the register request with the specific account
-	OauthKey uuid.UUID `form:"oauthKey" json:"oauthKey" yaml:"oauthKey" xml:"oauthKey"`
-}
-
-// Validate validates the AmazonRegisterMedia media type instance.
-func (mt *AmazonRegisterMedia) Validate() (err error) {
-	if mt.Email == "" {
-		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "email"))
-	}
-	if mt.FirstName == "" {
-		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "firstName"))
-	}
-	if mt.LastName == "" {
-		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "lastName"))
-	}
-
-	return
-}
-
 // If other Oauths or Auths exists on account. (default view)
 //
 // Identifier: auth-status; view=default
 type AuthStatus struct {
+	// True if user has amazon Oauth signin
+	Amazon bool `form:"amazon" json:"amazon" yaml:"amazon" xml:"amazon"`
 	// True if user has facebook Oauth signin
 	Facebook bool `form:"facebook" json:"facebook" yaml:"facebook" xml:"facebook"`
 	// True if user has google Oauth signin
@@ -78,15 +51,25 @@ type AuthStatus struct {
 	Linkedin bool `form:"linkedin" json:"linkedin" yaml:"linkedin" xml:"linkedin"`
 	// True if user has microsoft Oauth signin
 	Microsoft bool `form:"microsoft" json:"microsoft" yaml:"microsoft" xml:"microsoft"`
+	// Names of every OAuth provider the user can signin with
+	Social []string `form:"social" json:"social" yaml:"social" xml:"social"`
 	// True if user has password signin
 	Standard bool `form:"standard" json:"standard" yaml:"standard" xml:"standard"`
 	// True if user has twitter Oauth signin
 	Twitter bool `form:"twitter" json:"twitter" yaml:"twitter" xml:"twitter"`
+	// True if user has two factor authentication enabled
+	TwoFactor bool `form:"twoFactor" json:"twoFactor" yaml:"twoFactor" xml:"twoFactor"`
+	// True if user has a passkey registered
+	Webauthn bool `form:"webauthn" json:"webauthn" yaml:"webauthn" xml:"webauthn"`
 }
 
 // Validate validates the AuthStatus media type instance.
 func (mt *AuthStatus) Validate() (err error) {
 
+	if mt.Social == nil {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "social"))
+	}
+
 	return
 }
 
@@ -126,156 +109,324 @@ func (mt *Bookmark) Validate() (err error) {
 	return
 }
 
-// Information used to pre-populate the register page (default view)
+// A public key that tokens are signed with, in the JWK format (default view)
 //
-// Identifier: facebook-register-media; view=default
-type FacebookRegisterMedia struct {
-	// An email extracted from the Facebook account
-	Email string `form:"email" json:"email" yaml:"email" xml:"email"`
-	// The given name for the user, pulled from the Facebook account
-	FirstName string `form:"firstName" json:"firstName" yaml:"firstName" xml:"firstName"`
-	// The family name for the user, pulled from the Facebook account
-	LastName string `form:"lastName" json:"lastName" yaml:"lastName" xml:"lastName"`
-	// A key used to connect the register request with the specific account
-	OauthKey uuid.UUID `form:"oauthKey" json:"oauthKey" yaml:"oauthKey" xml:"oauthKey"`
+// Identifier: json-web-key; view=default
+type JSONWebKey struct {
+	// Algorithm the key signs with
+	Alg string `form:"alg" json:"alg" yaml:"alg" xml:"alg"`
+	// Curve of an EC or OKP key
+	Crv *string `form:"crv,omitempty" json:"crv,omitempty" yaml:"crv,omitempty" xml:"crv,omitempty"`
+	// Exponent of an RSA key
+	E *string `form:"e,omitempty" json:"e,omitempty" yaml:"e,omitempty" xml:"e,omitempty"`
+	// Key ID, matches the kid header of the tokens signed with the key
+	Kid string `form:"kid" json:"kid" yaml:"kid" xml:"kid"`
+	// Key type
+	Kty string `form:"kty" json:"kty" yaml:"kty" xml:"kty"`
+	// Modulus of an RSA key
+	N *string `form:"n,omitempty" json:"n,omitempty" yaml:"n,omitempty" xml:"n,omitempty"`
+	// Intended use of the key
+	Use string `form:"use" json:"use" yaml:"use" xml:"use"`
+	// X coordinate of an EC key, or the public key of an OKP key
+	X *string `form:"x,omitempty" json:"x,omitempty" yaml:"x,omitempty" xml:"x,omitempty"`
+	// Y coordinate of an EC key
+	Y *string `form:"y,omitempty" json:"y,omitempty" yaml:"y,omitempty" xml:"y,omitempty"`
+}
+
+// Validate validates the JSONWebKey media type instance.
+func (mt *JSONWebKey) Validate() (err error) {
+	if mt.Kty == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "kty"))
+	}
+	if mt.Use == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "use"))
+	}
+	if mt.Kid == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "kid"))
+	}
+	if mt.Alg == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "alg"))
+	}
+	return
 }
 
-// Validate validates the FacebookRegisterMedia media type instance.
-func (mt *FacebookRegisterMedia) Validate() (err error) {
-	if mt.Email == "" {
-		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "email"))
-	}
-	if mt.FirstName == "" {
-		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "firstName"))
+// The public keys that tokens may be verified with (default view)
+//
+// Identifier: json-web-key-set; view=default
+type JSONWebKeySet struct {
+	// Keys that are signing or have signed tokens that are still valid
+	Keys []*JSONWebKey `form:"keys" json:"keys" yaml:"keys" xml:"keys"`
+}
+
+// Validate validates the JSONWebKeySet media type instance.
+func (mt *JSONWebKeySet) Validate() (err error) {
+	if mt.Keys == nil {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "keys"))
 	}
-	if mt.LastName == "" {
-		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "lastName"))
+	for _, e := range mt.Keys {
+		if e != nil {
+			if err2 := e.Validate(); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
 	}
-
 	return
 }
 
-// Information used to pre-populate the register page (default view)
+// An individual newsletter subscriber (default view)
 //
-// Identifier: google-register-media; view=default
-type GoogleRegisterMedia struct {
-	// An email extracted from the Google account
-	Email string `form:"email" json:"email" yaml:"email" xml:"email"`
-	// The given name for the user, pulled from the Google account
-	FirstName string `form:"firstName" json:"firstName" yaml:"firstName" xml:"firstName"`
-	// The family name for the user, pulled from the Google account
-	LastName string `form:"lastName" json:"lastName" yaml:"lastName" xml:"lastName"`
-	// A key used to connect the register request with the specific account
-	OauthKey uuid.UUID `form:"oauthKey" json:"oauthKey" yaml:"oauthKey" xml:"oauthKey"`
+// Identifier: newsletter-subscriber; view=default
+type NewsletterSubscriber struct {
+	Email        string    `form:"email" json:"email" yaml:"email" xml:"email"`
+	IsActive     bool      `form:"isActive" json:"isActive" yaml:"isActive" xml:"isActive"`
+	SubscribedAt time.Time `form:"subscribedAt" json:"subscribedAt" yaml:"subscribedAt" xml:"subscribedAt"`
 }
 
-// Validate validates the GoogleRegisterMedia media type instance.
-func (mt *GoogleRegisterMedia) Validate() (err error) {
+// Validate validates the NewsletterSubscriber media type instance.
+func (mt *NewsletterSubscriber) Validate() (err error) {
 	if mt.Email == "" {
 		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "email"))
 	}
-	if mt.FirstName == "" {
-		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "firstName"))
-	}
-	if mt.LastName == "" {
-		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "lastName"))
-	}
 
+	if err2 := goa.ValidateFormat(goa.FormatEmail, mt.Email); err2 != nil {
+		err = goa.MergeErrors(err, goa.InvalidFormatError(`response.email`, mt.Email, goa.FormatEmail, err2))
+	}
 	return
 }
 
-// Information used to pre-populate the register page (default view)
+// Newsletter-SubscriberCollection is the media type for an array of Newsletter-Subscriber (default view)
 //
-// Identifier: linkedin-register-media; view=default
-type LinkedinRegisterMedia struct {
-	// An email extracted from the Linkedin account
-	Email string `form:"email" json:"email" yaml:"email" xml:"email"`
-	// The given name for the user, pulled from the Linkedin account
-	FirstName string `form:"firstName" json:"firstName" yaml:"firstName" xml:"firstName"`
-	// The family name for the user, pulled from the Linkedin account
-	LastName string `form:"lastName" json:"lastName" yaml:"lastName" xml:"lastName"`
-	// A key used to connect the register request with the specific account
-	OauthKey uuid.UUID `form:"oauthKey" json:"oauthKey" yaml:"oauthKey" xml:"oauthKey"`
+// Identifier: newsletter-subscriber; type=collection; view=default
+type NewsletterSubscriberCollection []*NewsletterSubscriber
+
+// Validate validates the NewsletterSubscriberCollection media type instance.
+func (mt NewsletterSubscriberCollection) Validate() (err error) {
+	for _, e := range mt {
+		if e != nil {
+			if err2 := e.Validate(); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
 }
 
-// Validate validates the LinkedinRegisterMedia media type instance.
-func (mt *LinkedinRegisterMedia) Validate() (err error) {
-	if mt.Email == "" {
-		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "email"))
+// An application registered to login users (default view)
+//
+// Identifier: oauth-client; view=default
+type OauthClient struct {
+	// Audience of the tokens issued to the application
+	Audience string `form:"audience" json:"audience" yaml:"audience" xml:"audience"`
+	// ID of the client, also its API key
+	ClientID string `form:"clientID" json:"clientID" yaml:"clientID" xml:"clientID"`
+	// Secret of a confidential client, only returned when it is created or replaced
+	ClientSecret *string `form:"clientSecret,omitempty" json:"clientSecret,omitempty" yaml:"clientSecret,omitempty" xml:"clientSecret,omitempty"`
+	// Whether the client has a secret
+	Confidential bool `form:"confidential" json:"confidential" yaml:"confidential" xml:"confidential"`
+	// Grant types the application can use
+	GrantTypes []string `form:"grantTypes" json:"grantTypes" yaml:"grantTypes" xml:"grantTypes"`
+	// URL of the logo of the application
+	LogoURL *string `form:"logoURL,omitempty" json:"logoURL,omitempty" yaml:"logoURL,omitempty" xml:"logoURL,omitempty"`
+	// Name of the application
+	Name string `form:"name" json:"name" yaml:"name" xml:"name"`
+	// URLs users can be sent back to after they login or from links in emails
+	RedirectURIs []string `form:"redirectURIs" json:"redirectURIs" yaml:"redirectURIs" xml:"redirectURIs"`
+	// Scopes of the tokens issued to the application
+	Scopes []string `form:"scopes" json:"scopes" yaml:"scopes" xml:"scopes"`
+	// Whether the application is run by someone else
+	ThirdParty bool `form:"thirdParty" json:"thirdParty" yaml:"thirdParty" xml:"thirdParty"`
+	// Time the client was registered
+	TimeCreated time.Time `form:"timeCreated" json:"timeCreated" yaml:"timeCreated" xml:"timeCreated"`
+}
+
+// Validate validates the OauthClient media type instance.
+func (mt *OauthClient) Validate() (err error) {
+	if mt.ClientID == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "clientID"))
+	}
+	if mt.Name == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "name"))
+	}
+	if mt.RedirectURIs == nil {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "redirectURIs"))
+	}
+	if mt.GrantTypes == nil {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "grantTypes"))
+	}
+	if mt.Audience == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "audience"))
+	}
+	if mt.Scopes == nil {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "scopes"))
 	}
-	if mt.FirstName == "" {
-		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "firstName"))
+
+	return
+}
+
+// OauthClientCollection is the media type for an array of OauthClient (default view)
+//
+// Identifier: oauth-client; type=collection; view=default
+type OauthClientCollection []*OauthClient
+
+// Validate validates the OauthClientCollection media type instance.
+func (mt OauthClientCollection) Validate() (err error) {
+	for _, e := range mt {
+		if e != nil {
+			if err2 := e.Validate(); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
 	}
-	if mt.LastName == "" {
-		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "lastName"))
+	return
+}
+
+// An OAuth 2.0 error response (RFC 6749) (default view)
+//
+// Identifier: oauth-error; view=default
+type OauthError struct {
+	// Error code
+	Error string `form:"error" json:"error" yaml:"error" xml:"error"`
+	// Description of the error
+	ErrorDescription *string `form:"error_description,omitempty" json:"error_description,omitempty" yaml:"error_description,omitempty" xml:"error_description,omitempty"`
+}
+
+// Validate validates the OauthError media type instance.
+func (mt *OauthError) Validate() (err error) {
+	if mt.Error == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "error"))
 	}
+	return
+}
+
+// Where to send the browser to finish an OpenID Connect authorization request (default view)
+//
+// Identifier: oauth-redirect; view=default
+type OauthRedirect struct {
+	// Redirect URI of the application, with the authorization code and state
+	RedirectURL string `form:"redirectURL" json:"redirectURL" yaml:"redirectURL" xml:"redirectURL"`
+}
 
+// Validate validates the OauthRedirect media type instance.
+func (mt *OauthRedirect) Validate() (err error) {
+	if mt.RedirectURL == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "redirectURL"))
+	}
 	return
 }
 
-// Information used to pre-populate the register page (default view)
+// An OpenID Connect authorization request waiting for the user to approve it (default view)
 //
-// Identifier: microsoft-register-media; view=default
-type MicrosoftRegisterMedia struct {
-	// An email extracted from the Microsoft account
-	Email string `form:"email" json:"email" yaml:"email" xml:"email"`
-	// The given name for the user, pulled from the Microsoft account
-	FirstName string `form:"firstName" json:"firstName" yaml:"firstName" xml:"firstName"`
-	// The family name for the user, pulled from the Microsoft account
-	LastName string `form:"lastName" json:"lastName" yaml:"lastName" xml:"lastName"`
-	// A key used to connect the register request with the specific account
-	OauthKey uuid.UUID `form:"oauthKey" json:"oauthKey" yaml:"oauthKey" xml:"oauthKey"`
+// Identifier: oauth-request; view=default
+type OauthRequest struct {
+	// URL of the logo of the application
+	ClientLogo *string `form:"clientLogo,omitempty" json:"clientLogo,omitempty" yaml:"clientLogo,omitempty" xml:"clientLogo,omitempty"`
+	// Name of the application asking the user to login
+	ClientName string `form:"clientName" json:"clientName" yaml:"clientName" xml:"clientName"`
+	// Scopes the application will be granted
+	Scopes []string `form:"scopes" json:"scopes" yaml:"scopes" xml:"scopes"`
 }
 
-// Validate validates the MicrosoftRegisterMedia media type instance.
-func (mt *MicrosoftRegisterMedia) Validate() (err error) {
-	if mt.Email == "" {
-		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "email"))
+// Validate validates the OauthRequest media type instance.
+func (mt *OauthRequest) Validate() (err error) {
+	if mt.ClientName == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "clientName"))
 	}
-	if mt.FirstName == "" {
-		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "firstName"))
+	if mt.Scopes == nil {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "scopes"))
 	}
-	if mt.LastName == "" {
-		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "lastName"))
+	return
+}
+
+// Tokens issued by the OpenID Connect token endpoint (default view)
+//
+// Identifier: oauth-token; view=default
+type OauthToken struct {
+	// Auth token, sent in the Authorization header
+	AccessToken string `form:"access_token" json:"access_token" yaml:"access_token" xml:"access_token"`
+	// Seconds until the access token expires
+	ExpiresIn int `form:"expires_in" json:"expires_in" yaml:"expires_in" xml:"expires_in"`
+	// ID token, only issued for the authorization_code grant
+	IDToken *string `form:"id_token,omitempty" json:"id_token,omitempty" yaml:"id_token,omitempty" xml:"id_token,omitempty"`
+	// Session token, which can be exchanged for new tokens once, not issued to service accounts
+	RefreshToken *string `form:"refresh_token,omitempty" json:"refresh_token,omitempty" yaml:"refresh_token,omitempty" xml:"refresh_token,omitempty"`
+	// Space separated scopes of the access token, left out when they are unchanged by a refresh
+	Scope *string `form:"scope,omitempty" json:"scope,omitempty" yaml:"scope,omitempty" xml:"scope,omitempty"`
+	// Always Bearer
+	TokenType string `form:"token_type" json:"token_type" yaml:"token_type" xml:"token_type"`
+}
+
+// Validate validates the OauthToken media type instance.
+func (mt *OauthToken) Validate() (err error) {
+	if mt.AccessToken == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "access_token"))
+	}
+	if mt.TokenType == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "token_type"))
 	}
 
 	return
 }
 
-// An individual newsletter subscriber (default view)
+// OpenID Connect discovery document (default view)
 //
-// Identifier: newsletter-subscriber; view=default
-type NewsletterSubscriber struct {
-	Email        string    `form:"email" json:"email" yaml:"email" xml:"email"`
-	IsActive     bool      `form:"isActive" json:"isActive" yaml:"isActive" xml:"isActive"`
-	SubscribedAt time.Time `form:"subscribedAt" json:"subscribedAt" yaml:"subscribedAt" xml:"subscribedAt"`
+// Identifier: openid-configuration; view=default
+type OpenidConfiguration struct {
+	AuthorizationEndpoint             string   `form:"authorization_endpoint" json:"authorization_endpoint" yaml:"authorization_endpoint" xml:"authorization_endpoint"`
+	ClaimsSupported                   []string `form:"claims_supported,omitempty" json:"claims_supported,omitempty" yaml:"claims_supported,omitempty" xml:"claims_supported,omitempty"`
+	CodeChallengeMethodsSupported     []string `form:"code_challenge_methods_supported,omitempty" json:"code_challenge_methods_supported,omitempty" yaml:"code_challenge_methods_supported,omitempty" xml:"code_challenge_methods_supported,omitempty"`
+	GrantTypesSupported               []string `form:"grant_types_supported,omitempty" json:"grant_types_supported,omitempty" yaml:"grant_types_supported,omitempty" xml:"grant_types_supported,omitempty"`
+	IDTokenSigningAlgValuesSupported  []string `form:"id_token_signing_alg_values_supported" json:"id_token_signing_alg_values_supported" yaml:"id_token_signing_alg_values_supported" xml:"id_token_signing_alg_values_supported"`
+	Issuer                            string   `form:"issuer" json:"issuer" yaml:"issuer" xml:"issuer"`
+	JwksURI                           string   `form:"jwks_uri" json:"jwks_uri" yaml:"jwks_uri" xml:"jwks_uri"`
+	ResponseTypesSupported            []string `form:"response_types_supported" json:"response_types_supported" yaml:"response_types_supported" xml:"response_types_supported"`
+	ScopesSupported                   []string `form:"scopes_supported,omitempty" json:"scopes_supported,omitempty" yaml:"scopes_supported,omitempty" xml:"scopes_supported,omitempty"`
+	SubjectTypesSupported             []string `form:"subject_types_supported" json:"subject_types_supported" yaml:"subject_types_supported" xml:"subject_types_supported"`
+	TokenEndpoint                     string   `form:"token_endpoint" json:"token_endpoint" yaml:"token_endpoint" xml:"token_endpoint"`
+	TokenEndpointAuthMethodsSupported []string `form:"token_endpoint_auth_methods_supported,omitempty" json:"token_endpoint_auth_methods_supported,omitempty" yaml:"token_endpoint_auth_methods_supported,omitempty" xml:"token_endpoint_auth_methods_supported,omitempty"`
+	UserinfoEndpoint                  string   `form:"userinfo_endpoint" json:"userinfo_endpoint" yaml:"userinfo_endpoint" xml:"userinfo_endpoint"`
 }
 
-// Validate validates the NewsletterSubscriber media type instance.
-func (mt *NewsletterSubscriber) Validate() (err error) {
-	if mt.Email == "" {
-		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "email"))
+// Validate validates the OpenidConfiguration media type instance.
+func (mt *OpenidConfiguration) Validate() (err error) {
+	if mt.Issuer == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "issuer"))
 	}
-
-	if err2 := goa.ValidateFormat(goa.FormatEmail, mt.Email); err2 != nil {
-		err = goa.MergeErrors(err, goa.InvalidFormatError(`response.email`, mt.Email, goa.FormatEmail, err2))
+	if mt.AuthorizationEndpoint == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "authorization_endpoint"))
+	}
+	if mt.TokenEndpoint == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "token_endpoint"))
+	}
+	if mt.UserinfoEndpoint == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "userinfo_endpoint"))
+	}
+	if mt.JwksURI == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "jwks_uri"))
+	}
+	if mt.ResponseTypesSupported == nil {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "response_types_supported"))
+	}
+	if mt.SubjectTypesSupported == nil {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "subject_types_supported"))
+	}
+	if mt.IDTokenSigningAlgValuesSupported == nil {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "id_token_signing_alg_values_supported"))
 	}
 	return
 }
 
-// Newsletter-SubscriberCollection is the media type for an array of Newsletter-Subscriber (default view)
+// A new set of recovery codes, these are only shown once (default view)
 //
-// Identifier: newsletter-subscriber; type=collection; view=default
-type NewsletterSubscriberCollection []*NewsletterSubscriber
+// Identifier: recovery-codes; view=default
+type RecoveryCodes struct {
+	// Single use codes that can be used in place of a two factor passcode if the authenticator is lost
+	Codes []string `form:"codes" json:"codes" yaml:"codes" xml:"codes"`
+}
 
-// Validate validates the NewsletterSubscriberCollection media type instance.
-func (mt NewsletterSubscriberCollection) Validate() (err error) {
-	for _, e := range mt {
-		if e != nil {
-			if err2 := e.Validate(); err2 != nil {
-				err = goa.MergeErrors(err, err2)
-			}
-		}
+// Validate validates the RecoveryCodes media type instance.
+func (mt *RecoveryCodes) Validate() (err error) {
+	if mt.Codes == nil {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "codes"))
 	}
 	return
 }
@@ -354,6 +505,94 @@ func (mt SessionCollection) Validate() (err error) {
 	return
 }
 
+// A connection with a provider the browser is sent to (default view)
+//
+// Identifier: social-connection-media; view=default
+type SocialConnectionMedia struct {
+	// Secret the connection is bound to, the front-end keeps it in the browser and sends it to receive with the code
+	Binding string `form:"binding" json:"binding" yaml:"binding" xml:"binding"`
+	// URL of the provider the front-end should redirect the browser to
+	URL string `form:"url" json:"url" yaml:"url" xml:"url"`
+}
+
+// Validate validates the SocialConnectionMedia media type instance.
+func (mt *SocialConnectionMedia) Validate() (err error) {
+	if mt.URL == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "url"))
+	}
+	if mt.Binding == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "binding"))
+	}
+	return
+}
+
+// Information used to pre-populate the register page (default view)
+//
+// Identifier: social-register-media; view=default
+type SocialRegisterMedia struct {
+	// An email extracted from the account with the provider
+	Email string `form:"email" json:"email" yaml:"email" xml:"email"`
+	// The given name for the user, pulled from the account with the provider
+	FirstName string `form:"firstName" json:"firstName" yaml:"firstName" xml:"firstName"`
+	// The family name for the user, pulled from the account with the provider
+	LastName string `form:"lastName" json:"lastName" yaml:"lastName" xml:"lastName"`
+	// A key used to connect the register request with the specific account
+	OauthKey uuid.UUID `form:"oauthKey" json:"oauthKey" yaml:"oauthKey" xml:"oauthKey"`
+}
+
+// Validate validates the SocialRegisterMedia media type instance.
+func (mt *SocialRegisterMedia) Validate() (err error) {
+	if mt.Email == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "email"))
+	}
+	if mt.FirstName == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "firstName"))
+	}
+	if mt.LastName == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "lastName"))
+	}
+
+	return
+}
+
+// The state of a token, every other attribute is left out when it is not active (default view)
+//
+// Identifier: token-introspection; view=default
+type TokenIntrospection struct {
+	// Whether the token is valid and its session has not ended
+	Active bool `form:"active" json:"active" yaml:"active" xml:"active"`
+	// Whether the user is an admin
+	Admin *bool `form:"admin,omitempty" json:"admin,omitempty" yaml:"admin,omitempty" xml:"admin,omitempty"`
+	// Audience of the client the token was issued to
+	Aud *string `form:"aud,omitempty" json:"aud,omitempty" yaml:"aud,omitempty" xml:"aud,omitempty"`
+	// Whether the user is an event author
+	EventAuthor *bool `form:"event_author,omitempty" json:"event_author,omitempty" yaml:"event_author,omitempty" xml:"event_author,omitempty"`
+	// Time the token expires, in seconds since the epoch
+	Exp *int `form:"exp,omitempty" json:"exp,omitempty" yaml:"exp,omitempty" xml:"exp,omitempty"`
+	// Time the token was issued, in seconds since the epoch
+	Iat *int `form:"iat,omitempty" json:"iat,omitempty" yaml:"iat,omitempty" xml:"iat,omitempty"`
+	// Issuer of the token
+	Iss *string `form:"iss,omitempty" json:"iss,omitempty" yaml:"iss,omitempty" xml:"iss,omitempty"`
+	// ID of the token
+	Jti *string `form:"jti,omitempty" json:"jti,omitempty" yaml:"jti,omitempty" xml:"jti,omitempty"`
+	// Whether the user is a plugin author
+	PluginAuthor *bool `form:"plugin_author,omitempty" json:"plugin_author,omitempty" yaml:"plugin_author,omitempty" xml:"plugin_author,omitempty"`
+	// Space separated scopes of the token
+	Scope *string `form:"scope,omitempty" json:"scope,omitempty" yaml:"scope,omitempty" xml:"scope,omitempty"`
+	// ID of the session the token was issued to
+	SessionID *string `form:"session_id,omitempty" json:"session_id,omitempty" yaml:"session_id,omitempty" xml:"session_id,omitempty"`
+	// ID of the user
+	Sub *string `form:"sub,omitempty" json:"sub,omitempty" yaml:"sub,omitempty" xml:"sub,omitempty"`
+	// access_token for auth tokens and refresh_token for session tokens
+	TokenType *string `form:"token_type,omitempty" json:"token_type,omitempty" yaml:"token_type,omitempty" xml:"token_type,omitempty"`
+}
+
+// Validate validates the TokenIntrospection media type instance.
+func (mt *TokenIntrospection) Validate() (err error) {
+
+	return
+}
+
 // Information used to pre-populate the register page (default view)
 //
 // Identifier: twitter-register-media; view=default
@@ -383,6 +622,43 @@ func (mt *TwitterRegisterMedia) Validate() (err error) {
 	return
 }
 
+// Returned instead of a session when the account has two factor authentication enabled (default view)
+//
+// Identifier: two-factor-challenge; view=default
+type TwoFactorChallenge struct {
+	// Token to send back with the passcode to finish logging in
+	Challenge uuid.UUID `form:"challenge" json:"challenge" yaml:"challenge" xml:"challenge"`
+	// Time after which the challenge can no longer be used
+	ExpiresAt time.Time `form:"expiresAt" json:"expiresAt" yaml:"expiresAt" xml:"expiresAt"`
+}
+
+// Validate validates the TwoFactorChallenge media type instance.
+func (mt *TwoFactorChallenge) Validate() (err error) {
+
+	return
+}
+
+// The secret for setting up an authenticator app (default view)
+//
+// Identifier: two-factor-enroll; view=default
+type TwoFactorEnroll struct {
+	// Base32 encoded TOTP secret
+	Secret string `form:"secret" json:"secret" yaml:"secret" xml:"secret"`
+	// otpauth URI of the secret, suitable for showing as a QR code
+	URI string `form:"uri" json:"uri" yaml:"uri" xml:"uri"`
+}
+
+// Validate validates the TwoFactorEnroll media type instance.
+func (mt *TwoFactorEnroll) Validate() (err error) {
+	if mt.Secret == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "secret"))
+	}
+	if mt.URI == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "uri"))
+	}
+	return
+}
+
 // A user in the system (admin view)
 //
 // Identifier: user; view=admin
@@ -393,6 +669,8 @@ type UserAdmin struct {
 	ChangingEmail *string `form:"changingEmail,omitempty" json:"changingEmail,omitempty" yaml:"changingEmail,omitempty" xml:"changingEmail,omitempty"`
 	// Email attached to the account of the user
 	Email string `form:"email" json:"email" yaml:"email" xml:"email"`
+	// Number of incorrect passwords entered since the last successful login
+	FailedLogins *int `form:"failedLogins,omitempty" json:"failedLogins,omitempty" yaml:"failedLogins,omitempty" xml:"failedLogins,omitempty"`
 	// Given name for the user
 	FirstName string `form:"firstName" json:"firstName" yaml:"firstName" xml:"firstName"`
 	Gender    string `form:"gender" json:"gender" yaml:"gender" xml:"gender"`
@@ -408,6 +686,8 @@ type UserAdmin struct {
 	IsPluginAuthor bool `form:"isPluginAuthor" json:"isPluginAuthor" yaml:"isPluginAuthor" xml:"isPluginAuthor"`
 	// Family name for the user
 	LastName string `form:"lastName" json:"lastName" yaml:"lastName" xml:"lastName"`
+	// When password login is locked until, not set if the account is not locked
+	LockedUntil *time.Time `form:"lockedUntil,omitempty" json:"lockedUntil,omitempty" yaml:"lockedUntil,omitempty" xml:"lockedUntil,omitempty"`
 	// Phone Number Of the user
 	Phone string `form:"phone" json:"phone" yaml:"phone" xml:"phone"`
 	// IDs of all plugins that the user has installed
@@ -415,6 +695,8 @@ type UserAdmin struct {
 	ProfileImage string             `form:"profileImage" json:"profileImage" yaml:"profileImage" xml:"profileImage"`
 	// Whether the user has verified their email
 	VerifiedEmail bool `form:"verifiedEmail" json:"verifiedEmail" yaml:"verifiedEmail" xml:"verifiedEmail"`
+	// Whether the user has verified their phone number
+	VerifiedPhone *bool `form:"verifiedPhone,omitempty" json:"verifiedPhone,omitempty" yaml:"verifiedPhone,omitempty" xml:"verifiedPhone,omitempty"`
 }
 
 // Validate validates the UserAdmin media type instance.
@@ -528,6 +810,8 @@ type UserOwner struct {
 	ProfileImage string             `form:"profileImage" json:"profileImage" yaml:"profileImage" xml:"profileImage"`
 	// Whether the user has verified their email
 	VerifiedEmail bool `form:"verifiedEmail" json:"verifiedEmail" yaml:"verifiedEmail" xml:"verifiedEmail"`
+	// Whether the user has verified their phone number
+	VerifiedPhone *bool `form:"verifiedPhone,omitempty" json:"verifiedPhone,omitempty" yaml:"verifiedPhone,omitempty" xml:"verifiedPhone,omitempty"`
 }
 
 // Validate validates the UserOwner media type instance.
@@ -565,6 +849,35 @@ func (mt *UserOwner) Validate() (err error) {
 	return
 }
 
+// OpenID Connect standard claims about a user, for the scopes of the access token (default view)
+//
+// Identifier: user-info; view=default
+type UserInfo struct {
+	Email         *string `form:"email,omitempty" json:"email,omitempty" yaml:"email,omitempty" xml:"email,omitempty"`
+	EmailVerified *bool   `form:"email_verified,omitempty" json:"email_verified,omitempty" yaml:"email_verified,omitempty" xml:"email_verified,omitempty"`
+	// Last name
+	FamilyName *string `form:"family_name,omitempty" json:"family_name,omitempty" yaml:"family_name,omitempty" xml:"family_name,omitempty"`
+	Gender     *string `form:"gender,omitempty" json:"gender,omitempty" yaml:"gender,omitempty" xml:"gender,omitempty"`
+	// First name
+	GivenName *string `form:"given_name,omitempty" json:"given_name,omitempty" yaml:"given_name,omitempty" xml:"given_name,omitempty"`
+	// Full name
+	Name                *string `form:"name,omitempty" json:"name,omitempty" yaml:"name,omitempty" xml:"name,omitempty"`
+	PhoneNumber         *string `form:"phone_number,omitempty" json:"phone_number,omitempty" yaml:"phone_number,omitempty" xml:"phone_number,omitempty"`
+	PhoneNumberVerified *bool   `form:"phone_number_verified,omitempty" json:"phone_number_verified,omitempty" yaml:"phone_number_verified,omitempty" xml:"phone_number_verified,omitempty"`
+	// URL of the profile image
+	Picture *string `form:"picture,omitempty" json:"picture,omitempty" yaml:"picture,omitempty" xml:"picture,omitempty"`
+	// ID of the user
+	Sub string `form:"sub" json:"sub" yaml:"sub" xml:"sub"`
+}
+
+// Validate validates the UserInfo media type instance.
+func (mt *UserInfo) Validate() (err error) {
+	if mt.Sub == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "sub"))
+	}
+	return
+}
+
 // Details about all the plugins that user has installed (default view)
 //
 // Identifier: user-plugin-media; view=default
@@ -636,3 +949,65 @@ func (mt UserOwnerCollection) Validate() (err error) {
 	}
 	return
 }
+
+// A passkey or security key registered to the user (default view)
+//
+// Identifier: webauthn-credential; view=default
+type WebauthnCredential struct {
+	// Unique unchanging credential ID
+	ID string `form:"id" json:"id" yaml:"id" xml:"id"`
+	// Time that the authenticator was last used to login
+	LastUsed *time.Time `form:"lastUsed,omitempty" json:"lastUsed,omitempty" yaml:"lastUsed,omitempty" xml:"lastUsed,omitempty"`
+	// Name the user gave the authenticator
+	Name string `form:"name" json:"name" yaml:"name" xml:"name"`
+	// Time that the authenticator was registered
+	TimeCreated time.Time `form:"timeCreated" json:"timeCreated" yaml:"timeCreated" xml:"timeCreated"`
+}
+
+// Validate validates the WebauthnCredential media type instance.
+func (mt *WebauthnCredential) Validate() (err error) {
+	if mt.ID == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "id"))
+	}
+	if mt.Name == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "name"))
+	}
+
+	return
+}
+
+// WebauthnCredentialCollection is the media type for an array of WebauthnCredential (default view)
+//
+// Identifier: webauthn-credential; type=collection; view=default
+type WebauthnCredentialCollection []*WebauthnCredential
+
+// Validate validates the WebauthnCredentialCollection media type instance.
+func (mt WebauthnCredentialCollection) Validate() (err error) {
+	for _, e := range mt {
+		if e != nil {
+			if err2 := e.Validate(); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// Options to pass to navigator.credentials.create() or navigator.credentials.get() in the browser (default view)
+//
+// Identifier: webauthn-options; view=default
+type WebauthnOptions struct {
+	// ID of the ceremony, must be sent back when finishing it
+	Ceremony uuid.UUID `form:"ceremony" json:"ceremony" yaml:"ceremony" xml:"ceremony"`
+	// The PublicKeyCredentialCreationOptions or PublicKeyCredentialRequestOptions
+	Options interface{} `form:"options" json:"options" yaml:"options" xml:"options"`
+}
+
+// Validate validates the WebauthnOptions media type instance.
+func (mt *WebauthnOptions) Validate() (err error) {
+
+	if mt.Options == nil {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`response`, "options"))
+	}
+	return
+}
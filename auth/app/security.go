@@ -32,6 +32,11 @@ func NewJWTSecurity() *goa.JWTSecurity {
 		In:       goa.LocHeader,
 		Name:     "Authorization",
 		TokenURL: "http://localhost:3000/api/auth/login",
+		Scopes: map[string]string{
+			"account": "Change how the user logs in and deactivate their account",
+			"admin":   "Use the admin actions, the user must also be an admin",
+			"profile": "Read and change the user's profile and bookmarks",
+		},
 	}
 	return &def
 }
@@ -17,133 +17,6 @@ import (
 	"unicode/utf8"
 )
 
-// amazonRegisterParams user type.
-type amazonRegisterParams struct {
-	// The email that will be connected to the account
-	Email *string `form:"email,omitempty" json:"email,omitempty" yaml:"email,omitempty" xml:"email,omitempty"`
-	// The given name for the user
-	FirstName *string `form:"firstName,omitempty" json:"firstName,omitempty" yaml:"firstName,omitempty" xml:"firstName,omitempty"`
-	// The recaptcha response code
-	GRecaptchaResponse *string `form:"gRecaptchaResponse,omitempty" json:"gRecaptchaResponse,omitempty" yaml:"gRecaptchaResponse,omitempty" xml:"gRecaptchaResponse,omitempty"`
-	// The family name for the user
-	LastName *string `form:"lastName,omitempty" json:"lastName,omitempty" yaml:"lastName,omitempty" xml:"lastName,omitempty"`
-	// The key given when the register was approved
-	OauthKey *uuid.UUID `form:"oauthKey,omitempty" json:"oauthKey,omitempty" yaml:"oauthKey,omitempty" xml:"oauthKey,omitempty"`
-}
-
-// Validate validates the amazonRegisterParams type instance.
-func (ut *amazonRegisterParams) Validate() (err error) {
-	if ut.Email == nil {
-		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "email"))
-	}
-	if ut.FirstName == nil {
-		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "firstName"))
-	}
-	if ut.LastName == nil {
-		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "lastName"))
-	}
-	if ut.OauthKey == nil {
-		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "oauthKey"))
-	}
-	if ut.GRecaptchaResponse == nil {
-		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "gRecaptchaResponse"))
-	}
-	if ut.Email != nil {
-		if err2 := goa.ValidateFormat(goa.FormatEmail, *ut.Email); err2 != nil {
-			err = goa.MergeErrors(err, goa.InvalidFormatError(`request.email`, *ut.Email, goa.FormatEmail, err2))
-		}
-	}
-	if ut.FirstName != nil {
-		if utf8.RuneCountInString(*ut.FirstName) < 2 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError(`request.firstName`, *ut.FirstName, utf8.RuneCountInString(*ut.FirstName), 2, true))
-		}
-	}
-	if ut.FirstName != nil {
-		if utf8.RuneCountInString(*ut.FirstName) > 50 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError(`request.firstName`, *ut.FirstName, utf8.RuneCountInString(*ut.FirstName), 50, false))
-		}
-	}
-	if ut.LastName != nil {
-		if utf8.RuneCountInString(*ut.LastName) < 2 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError(`request.lastName`, *ut.LastName, utf8.RuneCountInString(*ut.LastName), 2, true))
-		}
-	}
-	if ut.LastName != nil {
-		if utf8.RuneCountInString(*ut.LastName) > 50 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError(`request.lastName`, *ut.LastName, utf8.RuneCountInString(*ut.LastName), 50, false))
-		}
-	}
-	return
-}
-
-// Publicize creates AmazonRegisterParams from amazonRegisterParams
-func (ut *amazonRegisterParams) Publicize() *AmazonRegisterParams {
-	var pub AmazonRegisterParams
-	if ut.Email != nil {
-		pub.Email = *ut.Email
-	}
-	if ut.FirstName != nil {
-		pub.FirstName = *ut.FirstName
-	}
-	if ut.GRecaptchaResponse != nil {
-		pub.GRecaptchaResponse = *ut.GRecaptchaResponse
-	}
-	if ut.LastName != nil {
-		pub.LastName = *ut.LastName
-	}
-	if ut.OauthKey != nil {
-		pub.OauthKey = *ut.OauthKey
-	}
-	return &pub
-}
-
-// AmazonRegisterParams user type.
-type AmazonRegisterParams struct {
-	// The email that will be connected to the account
-	Email string `form:"email" json:"email" yaml:"email" xml:"email"`
-	// The given name for the user
-	FirstName string `form:"firstName" json:"firstName" yaml:"firstName" xml:"firstName"`
-	// The recaptcha response code
-	GRecaptchaResponse string `form:"gRecaptchaResponse" json:"gRecaptchaResponse" yaml:"gRecaptchaResponse" xml:"gRecaptchaResponse"`
-	// The family name for the user
-	LastName string `form:"lastName" json:"lastName" yaml:"lastName" xml:"lastName"`
-	// The key given when the register was approved
-	OauthKey uuid.UUID `form:"oauthKey" json:"oauthKey" yaml:"oauthKey" xml:"oauthKey"`
-}
-
-// Validate validates the AmazonRegisterParams type instance.
-func (ut *AmazonRegisterParams) Validate() (err error) {
-	if ut.Email == "" {
-		err = goa.MergeErrors(err, goa.MissingAttributeError(`type`, "email"))
-	}
-	if ut.FirstName == "" {
-		err = goa.MergeErrors(err, goa.MissingAttributeError(`type`, "firstName"))
-	}
-	if ut.LastName == "" {
-		err = goa.MergeErrors(err, goa.MissingAttributeError(`type`, "lastName"))
-	}
-
-	if ut.GRecaptchaResponse == "" {
-		err = goa.MergeErrors(err, goa.MissingAttributeError(`type`, "gRecaptchaResponse"))
-	}
-	if err2 := goa.ValidateFormat(goa.FormatEmail, ut.Email); err2 != nil {
-		err = goa.MergeErrors(err, goa.InvalidFormatError(`type.email`, ut.Email, goa.FormatEmail, err2))
-	}
-	if utf8.RuneCountInString(ut.FirstName) < 2 {
-		err = goa.MergeErrors(err, goa.InvalidLengthError(`type.firstName`, ut.FirstName, utf8.RuneCountInString(ut.FirstName), 2, true))
-	}
-	if utf8.RuneCountInString(ut.FirstName) > 50 {
-		err = goa.MergeErrors(err, goa.InvalidLengthError(`type.firstName`, ut.FirstName, utf8.RuneCountInString(ut.FirstName), 50, false))
-	}
-	if utf8.RuneCountInString(ut.LastName) < 2 {
-		err = goa.MergeErrors(err, goa.InvalidLengthError(`type.lastName`, ut.LastName, utf8.RuneCountInString(ut.LastName), 2, true))
-	}
-	if utf8.RuneCountInString(ut.LastName) > 50 {
-		err = goa.MergeErrors(err, goa.InvalidLengthError(`type.lastName`, ut.LastName, utf8.RuneCountInString(ut.LastName), 50, false))
-	}
-	return
-}
-
 // bookmarkParams user type.
 type bookmarkParams struct {
 	Category    *string `form:"category,omitempty" json:"category,omitempty" yaml:"category,omitempty" xml:"category,omitempty"`
@@ -281,527 +154,612 @@ func (ut *ChangePasswordParams) Validate() (err error) {
 	return
 }
 
-// facebookRegisterParams user type.
-type facebookRegisterParams struct {
-	// The email that will be connected to the account
-	Email *string `form:"email,omitempty" json:"email,omitempty" yaml:"email,omitempty" xml:"email,omitempty"`
-	// The given name for the user
-	FirstName *string `form:"firstName,omitempty" json:"firstName,omitempty" yaml:"firstName,omitempty" xml:"firstName,omitempty"`
-	// The recaptcha response code
-	GRecaptchaResponse *string `form:"gRecaptchaResponse,omitempty" json:"gRecaptchaResponse,omitempty" yaml:"gRecaptchaResponse,omitempty" xml:"gRecaptchaResponse,omitempty"`
-	// The family name for the user
-	LastName *string `form:"lastName,omitempty" json:"lastName,omitempty" yaml:"lastName,omitempty" xml:"lastName,omitempty"`
-	// The key given when the register was approved
-	OauthKey *uuid.UUID `form:"oauthKey,omitempty" json:"oauthKey,omitempty" yaml:"oauthKey,omitempty" xml:"oauthKey,omitempty"`
+// emailCodeParams user type.
+type emailCodeParams struct {
+	// The 6 digit code that was emailed to the user
+	Code *string `form:"code,omitempty" json:"code,omitempty" yaml:"code,omitempty" xml:"code,omitempty"`
 }
 
-// Validate validates the facebookRegisterParams type instance.
-func (ut *facebookRegisterParams) Validate() (err error) {
-	if ut.Email == nil {
-		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "email"))
+// Validate validates the emailCodeParams type instance.
+func (ut *emailCodeParams) Validate() (err error) {
+	if ut.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "code"))
 	}
-	if ut.FirstName == nil {
-		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "firstName"))
-	}
-	if ut.LastName == nil {
-		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "lastName"))
+	if ut.Code != nil {
+		if ok := goa.ValidatePattern(`^[0-9]{6}$`, *ut.Code); !ok {
+			err = goa.MergeErrors(err, goa.InvalidPatternError(`request.code`, *ut.Code, `^[0-9]{6}$`))
+		}
 	}
-	if ut.OauthKey == nil {
-		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "oauthKey"))
+	return
+}
+
+// Publicize creates EmailCodeParams from emailCodeParams
+func (ut *emailCodeParams) Publicize() *EmailCodeParams {
+	var pub EmailCodeParams
+	if ut.Code != nil {
+		pub.Code = *ut.Code
 	}
-	if ut.GRecaptchaResponse == nil {
-		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "gRecaptchaResponse"))
+	return &pub
+}
+
+// EmailCodeParams user type.
+type EmailCodeParams struct {
+	// The 6 digit code that was emailed to the user
+	Code string `form:"code" json:"code" yaml:"code" xml:"code"`
+}
+
+// Validate validates the EmailCodeParams type instance.
+func (ut *EmailCodeParams) Validate() (err error) {
+	if ut.Code == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`type`, "code"))
 	}
-	if ut.Email != nil {
-		if err2 := goa.ValidateFormat(goa.FormatEmail, *ut.Email); err2 != nil {
-			err = goa.MergeErrors(err, goa.InvalidFormatError(`request.email`, *ut.Email, goa.FormatEmail, err2))
-		}
+	if ok := goa.ValidatePattern(`^[0-9]{6}$`, ut.Code); !ok {
+		err = goa.MergeErrors(err, goa.InvalidPatternError(`type.code`, ut.Code, `^[0-9]{6}$`))
 	}
-	if ut.FirstName != nil {
-		if utf8.RuneCountInString(*ut.FirstName) < 2 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError(`request.firstName`, *ut.FirstName, utf8.RuneCountInString(*ut.FirstName), 2, true))
-		}
+	return
+}
+
+// loginCodeParams user type.
+type loginCodeParams struct {
+	// The 6 digit code that was emailed to the user
+	Code *string `form:"code,omitempty" json:"code,omitempty" yaml:"code,omitempty" xml:"code,omitempty"`
+	// The email address of the account to login to
+	Email *string `form:"email,omitempty" json:"email,omitempty" yaml:"email,omitempty" xml:"email,omitempty"`
+}
+
+// Validate validates the loginCodeParams type instance.
+func (ut *loginCodeParams) Validate() (err error) {
+	if ut.Email == nil {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "email"))
 	}
-	if ut.FirstName != nil {
-		if utf8.RuneCountInString(*ut.FirstName) > 50 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError(`request.firstName`, *ut.FirstName, utf8.RuneCountInString(*ut.FirstName), 50, false))
-		}
+	if ut.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "code"))
 	}
-	if ut.LastName != nil {
-		if utf8.RuneCountInString(*ut.LastName) < 2 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError(`request.lastName`, *ut.LastName, utf8.RuneCountInString(*ut.LastName), 2, true))
+	if ut.Code != nil {
+		if ok := goa.ValidatePattern(`^[0-9]{6}$`, *ut.Code); !ok {
+			err = goa.MergeErrors(err, goa.InvalidPatternError(`request.code`, *ut.Code, `^[0-9]{6}$`))
 		}
 	}
-	if ut.LastName != nil {
-		if utf8.RuneCountInString(*ut.LastName) > 50 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError(`request.lastName`, *ut.LastName, utf8.RuneCountInString(*ut.LastName), 50, false))
+	if ut.Email != nil {
+		if err2 := goa.ValidateFormat(goa.FormatEmail, *ut.Email); err2 != nil {
+			err = goa.MergeErrors(err, goa.InvalidFormatError(`request.email`, *ut.Email, goa.FormatEmail, err2))
 		}
 	}
 	return
 }
 
-// Publicize creates FacebookRegisterParams from facebookRegisterParams
-func (ut *facebookRegisterParams) Publicize() *FacebookRegisterParams {
-	var pub FacebookRegisterParams
+// Publicize creates LoginCodeParams from loginCodeParams
+func (ut *loginCodeParams) Publicize() *LoginCodeParams {
+	var pub LoginCodeParams
+	if ut.Code != nil {
+		pub.Code = *ut.Code
+	}
 	if ut.Email != nil {
 		pub.Email = *ut.Email
 	}
-	if ut.FirstName != nil {
-		pub.FirstName = *ut.FirstName
-	}
-	if ut.GRecaptchaResponse != nil {
-		pub.GRecaptchaResponse = *ut.GRecaptchaResponse
-	}
-	if ut.LastName != nil {
-		pub.LastName = *ut.LastName
-	}
-	if ut.OauthKey != nil {
-		pub.OauthKey = *ut.OauthKey
-	}
 	return &pub
 }
 
-// FacebookRegisterParams user type.
-type FacebookRegisterParams struct {
-	// The email that will be connected to the account
+// LoginCodeParams user type.
+type LoginCodeParams struct {
+	// The 6 digit code that was emailed to the user
+	Code string `form:"code" json:"code" yaml:"code" xml:"code"`
+	// The email address of the account to login to
 	Email string `form:"email" json:"email" yaml:"email" xml:"email"`
-	// The given name for the user
-	FirstName string `form:"firstName" json:"firstName" yaml:"firstName" xml:"firstName"`
-	// The recaptcha response code
-	GRecaptchaResponse string `form:"gRecaptchaResponse" json:"gRecaptchaResponse" yaml:"gRecaptchaResponse" xml:"gRecaptchaResponse"`
-	// The family name for the user
-	LastName string `form:"lastName" json:"lastName" yaml:"lastName" xml:"lastName"`
-	// The key given when the register was approved
-	OauthKey uuid.UUID `form:"oauthKey" json:"oauthKey" yaml:"oauthKey" xml:"oauthKey"`
 }
 
-// Validate validates the FacebookRegisterParams type instance.
-func (ut *FacebookRegisterParams) Validate() (err error) {
+// Validate validates the LoginCodeParams type instance.
+func (ut *LoginCodeParams) Validate() (err error) {
 	if ut.Email == "" {
 		err = goa.MergeErrors(err, goa.MissingAttributeError(`type`, "email"))
 	}
-	if ut.FirstName == "" {
-		err = goa.MergeErrors(err, goa.MissingAttributeError(`type`, "firstName"))
-	}
-	if ut.LastName == "" {
-		err = goa.MergeErrors(err, goa.MissingAttributeError(`type`, "lastName"))
+	if ut.Code == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`type`, "code"))
 	}
-
-	if ut.GRecaptchaResponse == "" {
-		err = goa.MergeErrors(err, goa.MissingAttributeError(`type`, "gRecaptchaResponse"))
+	if ok := goa.ValidatePattern(`^[0-9]{6}$`, ut.Code); !ok {
+		err = goa.MergeErrors(err, goa.InvalidPatternError(`type.code`, ut.Code, `^[0-9]{6}$`))
 	}
 	if err2 := goa.ValidateFormat(goa.FormatEmail, ut.Email); err2 != nil {
 		err = goa.MergeErrors(err, goa.InvalidFormatError(`type.email`, ut.Email, goa.FormatEmail, err2))
 	}
-	if utf8.RuneCountInString(ut.FirstName) < 2 {
-		err = goa.MergeErrors(err, goa.InvalidLengthError(`type.firstName`, ut.FirstName, utf8.RuneCountInString(ut.FirstName), 2, true))
-	}
-	if utf8.RuneCountInString(ut.FirstName) > 50 {
-		err = goa.MergeErrors(err, goa.InvalidLengthError(`type.firstName`, ut.FirstName, utf8.RuneCountInString(ut.FirstName), 50, false))
-	}
-	if utf8.RuneCountInString(ut.LastName) < 2 {
-		err = goa.MergeErrors(err, goa.InvalidLengthError(`type.lastName`, ut.LastName, utf8.RuneCountInString(ut.LastName), 2, true))
-	}
-	if utf8.RuneCountInString(ut.LastName) > 50 {
-		err = goa.MergeErrors(err, goa.InvalidLengthError(`type.lastName`, ut.LastName, utf8.RuneCountInString(ut.LastName), 50, false))
-	}
 	return
 }
 
-// googleRegisterParams user type.
-type googleRegisterParams struct {
-	// The email that will be connected to the account
+// loginParams user type.
+type loginParams struct {
+	// 2 Factor Auth if user has enabled the feature
+	TwoFactor *string `form:"TwoFactor,omitempty" json:"TwoFactor,omitempty" yaml:"TwoFactor,omitempty" xml:"TwoFactor,omitempty"`
+	// The email address of the account to login to
 	Email *string `form:"email,omitempty" json:"email,omitempty" yaml:"email,omitempty" xml:"email,omitempty"`
-	// The given name for the user
-	FirstName *string `form:"firstName,omitempty" json:"firstName,omitempty" yaml:"firstName,omitempty" xml:"firstName,omitempty"`
-	// The recaptcha response code
-	GRecaptchaResponse *string `form:"gRecaptchaResponse,omitempty" json:"gRecaptchaResponse,omitempty" yaml:"gRecaptchaResponse,omitempty" xml:"gRecaptchaResponse,omitempty"`
-	// The family name for the user
-	LastName *string `form:"lastName,omitempty" json:"lastName,omitempty" yaml:"lastName,omitempty" xml:"lastName,omitempty"`
-	// The key given when the register was approved
-	OauthKey *uuid.UUID `form:"oauthKey,omitempty" json:"oauthKey,omitempty" yaml:"oauthKey,omitempty" xml:"oauthKey,omitempty"`
+	// The password of the account to login to
+	Password *string `form:"password,omitempty" json:"password,omitempty" yaml:"password,omitempty" xml:"password,omitempty"`
 }
 
-// Validate validates the googleRegisterParams type instance.
-func (ut *googleRegisterParams) Validate() (err error) {
+// Validate validates the loginParams type instance.
+func (ut *loginParams) Validate() (err error) {
 	if ut.Email == nil {
 		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "email"))
 	}
-	if ut.FirstName == nil {
-		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "firstName"))
-	}
-	if ut.LastName == nil {
-		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "lastName"))
+	if ut.Password == nil {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "password"))
 	}
-	if ut.OauthKey == nil {
-		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "oauthKey"))
+	if ut.TwoFactor != nil {
+		if utf8.RuneCountInString(*ut.TwoFactor) < 6 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError(`request.TwoFactor`, *ut.TwoFactor, utf8.RuneCountInString(*ut.TwoFactor), 6, true))
+		}
 	}
-	if ut.GRecaptchaResponse == nil {
-		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "gRecaptchaResponse"))
+	if ut.TwoFactor != nil {
+		if utf8.RuneCountInString(*ut.TwoFactor) > 8 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError(`request.TwoFactor`, *ut.TwoFactor, utf8.RuneCountInString(*ut.TwoFactor), 8, false))
+		}
 	}
 	if ut.Email != nil {
 		if err2 := goa.ValidateFormat(goa.FormatEmail, *ut.Email); err2 != nil {
 			err = goa.MergeErrors(err, goa.InvalidFormatError(`request.email`, *ut.Email, goa.FormatEmail, err2))
 		}
 	}
-	if ut.FirstName != nil {
-		if utf8.RuneCountInString(*ut.FirstName) < 2 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError(`request.firstName`, *ut.FirstName, utf8.RuneCountInString(*ut.FirstName), 2, true))
-		}
-	}
-	if ut.FirstName != nil {
-		if utf8.RuneCountInString(*ut.FirstName) > 50 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError(`request.firstName`, *ut.FirstName, utf8.RuneCountInString(*ut.FirstName), 50, false))
+	if ut.Password != nil {
+		if ok := goa.ValidatePattern(`^.*[\w].*$`, *ut.Password); !ok {
+			err = goa.MergeErrors(err, goa.InvalidPatternError(`request.password`, *ut.Password, `^.*[\w].*$`))
 		}
 	}
-	if ut.LastName != nil {
-		if utf8.RuneCountInString(*ut.LastName) < 2 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError(`request.lastName`, *ut.LastName, utf8.RuneCountInString(*ut.LastName), 2, true))
+	if ut.Password != nil {
+		if utf8.RuneCountInString(*ut.Password) < 6 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError(`request.password`, *ut.Password, utf8.RuneCountInString(*ut.Password), 6, true))
 		}
 	}
-	if ut.LastName != nil {
-		if utf8.RuneCountInString(*ut.LastName) > 50 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError(`request.lastName`, *ut.LastName, utf8.RuneCountInString(*ut.LastName), 50, false))
+	if ut.Password != nil {
+		if utf8.RuneCountInString(*ut.Password) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError(`request.password`, *ut.Password, utf8.RuneCountInString(*ut.Password), 100, false))
 		}
 	}
 	return
 }
 
-// Publicize creates GoogleRegisterParams from googleRegisterParams
-func (ut *googleRegisterParams) Publicize() *GoogleRegisterParams {
-	var pub GoogleRegisterParams
+// Publicize creates LoginParams from loginParams
+func (ut *loginParams) Publicize() *LoginParams {
+	var pub LoginParams
+	if ut.TwoFactor != nil {
+		pub.TwoFactor = ut.TwoFactor
+	}
 	if ut.Email != nil {
 		pub.Email = *ut.Email
 	}
-	if ut.FirstName != nil {
-		pub.FirstName = *ut.FirstName
-	}
-	if ut.GRecaptchaResponse != nil {
-		pub.GRecaptchaResponse = *ut.GRecaptchaResponse
-	}
-	if ut.LastName != nil {
-		pub.LastName = *ut.LastName
-	}
-	if ut.OauthKey != nil {
-		pub.OauthKey = *ut.OauthKey
+	if ut.Password != nil {
+		pub.Password = *ut.Password
 	}
 	return &pub
 }
 
-// GoogleRegisterParams user type.
-type GoogleRegisterParams struct {
-	// The email that will be connected to the account
+// LoginParams user type.
+type LoginParams struct {
+	// 2 Factor Auth if user has enabled the feature
+	TwoFactor *string `form:"TwoFactor,omitempty" json:"TwoFactor,omitempty" yaml:"TwoFactor,omitempty" xml:"TwoFactor,omitempty"`
+	// The email address of the account to login to
 	Email string `form:"email" json:"email" yaml:"email" xml:"email"`
-	// The given name for the user
-	FirstName string `form:"firstName" json:"firstName" yaml:"firstName" xml:"firstName"`
-	// The recaptcha response code
-	GRecaptchaResponse string `form:"gRecaptchaResponse" json:"gRecaptchaResponse" yaml:"gRecaptchaResponse" xml:"gRecaptchaResponse"`
-	// The family name for the user
-	LastName string `form:"lastName" json:"lastName" yaml:"lastName" xml:"lastName"`
-	// The key given when the register was approved
-	OauthKey uuid.UUID `form:"oauthKey" json:"oauthKey" yaml:"oauthKey" xml:"oauthKey"`
+	// The password of the account to login to
+	Password string `form:"password" json:"password" yaml:"password" xml:"password"`
 }
 
-// Validate validates the GoogleRegisterParams type instance.
-func (ut *GoogleRegisterParams) Validate() (err error) {
+// Validate validates the LoginParams type instance.
+func (ut *LoginParams) Validate() (err error) {
 	if ut.Email == "" {
 		err = goa.MergeErrors(err, goa.MissingAttributeError(`type`, "email"))
 	}
-	if ut.FirstName == "" {
-		err = goa.MergeErrors(err, goa.MissingAttributeError(`type`, "firstName"))
+	if ut.Password == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`type`, "password"))
 	}
-	if ut.LastName == "" {
-		err = goa.MergeErrors(err, goa.MissingAttributeError(`type`, "lastName"))
+	if ut.TwoFactor != nil {
+		if utf8.RuneCountInString(*ut.TwoFactor) < 6 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError(`type.TwoFactor`, *ut.TwoFactor, utf8.RuneCountInString(*ut.TwoFactor), 6, true))
+		}
 	}
-
-	if ut.GRecaptchaResponse == "" {
-		err = goa.MergeErrors(err, goa.MissingAttributeError(`type`, "gRecaptchaResponse"))
+	if ut.TwoFactor != nil {
+		if utf8.RuneCountInString(*ut.TwoFactor) > 8 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError(`type.TwoFactor`, *ut.TwoFactor, utf8.RuneCountInString(*ut.TwoFactor), 8, false))
+		}
 	}
 	if err2 := goa.ValidateFormat(goa.FormatEmail, ut.Email); err2 != nil {
 		err = goa.MergeErrors(err, goa.InvalidFormatError(`type.email`, ut.Email, goa.FormatEmail, err2))
 	}
-	if utf8.RuneCountInString(ut.FirstName) < 2 {
-		err = goa.MergeErrors(err, goa.InvalidLengthError(`type.firstName`, ut.FirstName, utf8.RuneCountInString(ut.FirstName), 2, true))
-	}
-	if utf8.RuneCountInString(ut.FirstName) > 50 {
-		err = goa.MergeErrors(err, goa.InvalidLengthError(`type.firstName`, ut.FirstName, utf8.RuneCountInString(ut.FirstName), 50, false))
+	if ok := goa.ValidatePattern(`^.*[\w].*$`, ut.Password); !ok {
+		err = goa.MergeErrors(err, goa.InvalidPatternError(`type.password`, ut.Password, `^.*[\w].*$`))
 	}
-	if utf8.RuneCountInString(ut.LastName) < 2 {
-		err = goa.MergeErrors(err, goa.InvalidLengthError(`type.lastName`, ut.LastName, utf8.RuneCountInString(ut.LastName), 2, true))
+	if utf8.RuneCountInString(ut.Password) < 6 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError(`type.password`, ut.Password, utf8.RuneCountInString(ut.Password), 6, true))
 	}
-	if utf8.RuneCountInString(ut.LastName) > 50 {
-		err = goa.MergeErrors(err, goa.InvalidLengthError(`type.lastName`, ut.LastName, utf8.RuneCountInString(ut.LastName), 50, false))
+	if utf8.RuneCountInString(ut.Password) > 100 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError(`type.password`, ut.Password, utf8.RuneCountInString(ut.Password), 100, false))
 	}
 	return
 }
 
-// linkedinRegisterParams user type.
-type linkedinRegisterParams struct {
-	// The email that will be connected to the account
-	Email *string `form:"email,omitempty" json:"email,omitempty" yaml:"email,omitempty" xml:"email,omitempty"`
-	// The given name for the user
-	FirstName *string `form:"firstName,omitempty" json:"firstName,omitempty" yaml:"firstName,omitempty" xml:"firstName,omitempty"`
-	// The recaptcha response code
-	GRecaptchaResponse *string `form:"gRecaptchaResponse,omitempty" json:"gRecaptchaResponse,omitempty" yaml:"gRecaptchaResponse,omitempty" xml:"gRecaptchaResponse,omitempty"`
-	// The family name for the user
-	LastName *string `form:"lastName,omitempty" json:"lastName,omitempty" yaml:"lastName,omitempty" xml:"lastName,omitempty"`
-	// The key given when the register was approved
-	OauthKey *uuid.UUID `form:"oauthKey,omitempty" json:"oauthKey,omitempty" yaml:"oauthKey,omitempty" xml:"oauthKey,omitempty"`
+// Parameters to add/remove a newsletter subscriber
+type newsletterParam struct {
+	Email        *string    `form:"email,omitempty" json:"email,omitempty" yaml:"email,omitempty" xml:"email,omitempty"`
+	IsActive     *bool      `form:"isActive,omitempty" json:"isActive,omitempty" yaml:"isActive,omitempty" xml:"isActive,omitempty"`
+	SubscribedAt *time.Time `form:"subscribedAt,omitempty" json:"subscribedAt,omitempty" yaml:"subscribedAt,omitempty" xml:"subscribedAt,omitempty"`
 }
 
-// Validate validates the linkedinRegisterParams type instance.
-func (ut *linkedinRegisterParams) Validate() (err error) {
-	if ut.Email == nil {
-		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "email"))
-	}
-	if ut.FirstName == nil {
-		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "firstName"))
+// Validate validates the newsletterParam type instance.
+func (ut *newsletterParam) Validate() (err error) {
+	if ut.Email != nil {
+		if err2 := goa.ValidateFormat(goa.FormatEmail, *ut.Email); err2 != nil {
+			err = goa.MergeErrors(err, goa.InvalidFormatError(`request.email`, *ut.Email, goa.FormatEmail, err2))
+		}
 	}
-	if ut.LastName == nil {
-		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "lastName"))
+	return
+}
+
+// Publicize creates NewsletterParam from newsletterParam
+func (ut *newsletterParam) Publicize() *NewsletterParam {
+	var pub NewsletterParam
+	if ut.Email != nil {
+		pub.Email = ut.Email
 	}
-	if ut.OauthKey == nil {
-		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "oauthKey"))
+	if ut.IsActive != nil {
+		pub.IsActive = ut.IsActive
 	}
-	if ut.GRecaptchaResponse == nil {
-		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "gRecaptchaResponse"))
+	if ut.SubscribedAt != nil {
+		pub.SubscribedAt = ut.SubscribedAt
 	}
+	return &pub
+}
+
+// Parameters to add/remove a newsletter subscriber
+type NewsletterParam struct {
+	Email        *string    `form:"email,omitempty" json:"email,omitempty" yaml:"email,omitempty" xml:"email,omitempty"`
+	IsActive     *bool      `form:"isActive,omitempty" json:"isActive,omitempty" yaml:"isActive,omitempty" xml:"isActive,omitempty"`
+	SubscribedAt *time.Time `form:"subscribedAt,omitempty" json:"subscribedAt,omitempty" yaml:"subscribedAt,omitempty" xml:"subscribedAt,omitempty"`
+}
+
+// Validate validates the NewsletterParam type instance.
+func (ut *NewsletterParam) Validate() (err error) {
 	if ut.Email != nil {
 		if err2 := goa.ValidateFormat(goa.FormatEmail, *ut.Email); err2 != nil {
-			err = goa.MergeErrors(err, goa.InvalidFormatError(`request.email`, *ut.Email, goa.FormatEmail, err2))
-		}
-	}
-	if ut.FirstName != nil {
-		if utf8.RuneCountInString(*ut.FirstName) < 2 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError(`request.firstName`, *ut.FirstName, utf8.RuneCountInString(*ut.FirstName), 2, true))
+			err = goa.MergeErrors(err, goa.InvalidFormatError(`type.email`, *ut.Email, goa.FormatEmail, err2))
 		}
 	}
-	if ut.FirstName != nil {
-		if utf8.RuneCountInString(*ut.FirstName) > 50 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError(`request.firstName`, *ut.FirstName, utf8.RuneCountInString(*ut.FirstName), 50, false))
-		}
+	return
+}
+
+// oauthClientParams user type.
+type oauthClientParams struct {
+	// Audience of the tokens issued to the application, the default audience when it is not set
+	Audience *string `form:"audience,omitempty" json:"audience,omitempty" yaml:"audience,omitempty" xml:"audience,omitempty"`
+	// Whether the application can keep a secret, only used when it is registered
+	Confidential *bool `form:"confidential,omitempty" json:"confidential,omitempty" yaml:"confidential,omitempty" xml:"confidential,omitempty"`
+	// Grant types the application can use: authorization_code, refresh_token and client_credentials for service accounts
+	GrantTypes []string `form:"grantTypes,omitempty" json:"grantTypes,omitempty" yaml:"grantTypes,omitempty" xml:"grantTypes,omitempty"`
+	// URL of the logo of the application
+	LogoURL *string `form:"logoURL,omitempty" json:"logoURL,omitempty" yaml:"logoURL,omitempty" xml:"logoURL,omitempty"`
+	// Name of the application, shown to users when it asks them to login
+	Name *string `form:"name,omitempty" json:"name,omitempty" yaml:"name,omitempty" xml:"name,omitempty"`
+	// URLs users can be sent back to after they login or from links in emails
+	RedirectURIs []string `form:"redirectURIs,omitempty" json:"redirectURIs,omitempty" yaml:"redirectURIs,omitempty" xml:"redirectURIs,omitempty"`
+	// Scopes of the tokens issued to the application, the default scopes when it is not set. Service accounts only get these scopes.
+	Scopes []string `form:"scopes,omitempty" json:"scopes,omitempty" yaml:"scopes,omitempty" xml:"scopes,omitempty"`
+	// Whether the application is run by someone else. Its tokens have its client ID as the audience unless it is set, and only the scopes registered to it.
+	ThirdParty *bool `form:"thirdParty,omitempty" json:"thirdParty,omitempty" yaml:"thirdParty,omitempty" xml:"thirdParty,omitempty"`
+}
+
+// Validate validates the oauthClientParams type instance.
+func (ut *oauthClientParams) Validate() (err error) {
+	if ut.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "name"))
 	}
-	if ut.LastName != nil {
-		if utf8.RuneCountInString(*ut.LastName) < 2 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError(`request.lastName`, *ut.LastName, utf8.RuneCountInString(*ut.LastName), 2, true))
+	if ut.LogoURL != nil {
+		if err2 := goa.ValidateFormat(goa.FormatURI, *ut.LogoURL); err2 != nil {
+			err = goa.MergeErrors(err, goa.InvalidFormatError(`request.logoURL`, *ut.LogoURL, goa.FormatURI, err2))
 		}
 	}
-	if ut.LastName != nil {
-		if utf8.RuneCountInString(*ut.LastName) > 50 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError(`request.lastName`, *ut.LastName, utf8.RuneCountInString(*ut.LastName), 50, false))
+	if ut.Name != nil {
+		if utf8.RuneCountInString(*ut.Name) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError(`request.name`, *ut.Name, utf8.RuneCountInString(*ut.Name), 1, true))
 		}
 	}
 	return
 }
 
-// Publicize creates LinkedinRegisterParams from linkedinRegisterParams
-func (ut *linkedinRegisterParams) Publicize() *LinkedinRegisterParams {
-	var pub LinkedinRegisterParams
-	if ut.Email != nil {
-		pub.Email = *ut.Email
+// Publicize creates OauthClientParams from oauthClientParams
+func (ut *oauthClientParams) Publicize() *OauthClientParams {
+	var pub OauthClientParams
+	if ut.Audience != nil {
+		pub.Audience = ut.Audience
 	}
-	if ut.FirstName != nil {
-		pub.FirstName = *ut.FirstName
+	if ut.Confidential != nil {
+		pub.Confidential = ut.Confidential
 	}
-	if ut.GRecaptchaResponse != nil {
-		pub.GRecaptchaResponse = *ut.GRecaptchaResponse
+	if ut.GrantTypes != nil {
+		pub.GrantTypes = ut.GrantTypes
 	}
-	if ut.LastName != nil {
-		pub.LastName = *ut.LastName
+	if ut.LogoURL != nil {
+		pub.LogoURL = ut.LogoURL
 	}
-	if ut.OauthKey != nil {
-		pub.OauthKey = *ut.OauthKey
+	if ut.Name != nil {
+		pub.Name = *ut.Name
+	}
+	if ut.RedirectURIs != nil {
+		pub.RedirectURIs = ut.RedirectURIs
+	}
+	if ut.Scopes != nil {
+		pub.Scopes = ut.Scopes
+	}
+	if ut.ThirdParty != nil {
+		pub.ThirdParty = ut.ThirdParty
 	}
 	return &pub
 }
 
-// LinkedinRegisterParams user type.
-type LinkedinRegisterParams struct {
-	// The email that will be connected to the account
-	Email string `form:"email" json:"email" yaml:"email" xml:"email"`
-	// The given name for the user
-	FirstName string `form:"firstName" json:"firstName" yaml:"firstName" xml:"firstName"`
-	// The recaptcha response code
-	GRecaptchaResponse string `form:"gRecaptchaResponse" json:"gRecaptchaResponse" yaml:"gRecaptchaResponse" xml:"gRecaptchaResponse"`
-	// The family name for the user
-	LastName string `form:"lastName" json:"lastName" yaml:"lastName" xml:"lastName"`
-	// The key given when the register was approved
-	OauthKey uuid.UUID `form:"oauthKey" json:"oauthKey" yaml:"oauthKey" xml:"oauthKey"`
+// OauthClientParams user type.
+type OauthClientParams struct {
+	// Audience of the tokens issued to the application, the default audience when it is not set
+	Audience *string `form:"audience,omitempty" json:"audience,omitempty" yaml:"audience,omitempty" xml:"audience,omitempty"`
+	// Whether the application can keep a secret, only used when it is registered
+	Confidential *bool `form:"confidential,omitempty" json:"confidential,omitempty" yaml:"confidential,omitempty" xml:"confidential,omitempty"`
+	// Grant types the application can use: authorization_code, refresh_token and client_credentials for service accounts
+	GrantTypes []string `form:"grantTypes,omitempty" json:"grantTypes,omitempty" yaml:"grantTypes,omitempty" xml:"grantTypes,omitempty"`
+	// URL of the logo of the application
+	LogoURL *string `form:"logoURL,omitempty" json:"logoURL,omitempty" yaml:"logoURL,omitempty" xml:"logoURL,omitempty"`
+	// Name of the application, shown to users when it asks them to login
+	Name string `form:"name" json:"name" yaml:"name" xml:"name"`
+	// URLs users can be sent back to after they login or from links in emails
+	RedirectURIs []string `form:"redirectURIs,omitempty" json:"redirectURIs,omitempty" yaml:"redirectURIs,omitempty" xml:"redirectURIs,omitempty"`
+	// Scopes of the tokens issued to the application, the default scopes when it is not set. Service accounts only get these scopes.
+	Scopes []string `form:"scopes,omitempty" json:"scopes,omitempty" yaml:"scopes,omitempty" xml:"scopes,omitempty"`
+	// Whether the application is run by someone else. Its tokens have its client ID as the audience unless it is set, and only the scopes registered to it.
+	ThirdParty *bool `form:"thirdParty,omitempty" json:"thirdParty,omitempty" yaml:"thirdParty,omitempty" xml:"thirdParty,omitempty"`
 }
 
-// Validate validates the LinkedinRegisterParams type instance.
-func (ut *LinkedinRegisterParams) Validate() (err error) {
-	if ut.Email == "" {
-		err = goa.MergeErrors(err, goa.MissingAttributeError(`type`, "email"))
+// Validate validates the OauthClientParams type instance.
+func (ut *OauthClientParams) Validate() (err error) {
+	if ut.Name == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`type`, "name"))
 	}
-	if ut.FirstName == "" {
-		err = goa.MergeErrors(err, goa.MissingAttributeError(`type`, "firstName"))
+	if ut.LogoURL != nil {
+		if err2 := goa.ValidateFormat(goa.FormatURI, *ut.LogoURL); err2 != nil {
+			err = goa.MergeErrors(err, goa.InvalidFormatError(`type.logoURL`, *ut.LogoURL, goa.FormatURI, err2))
+		}
 	}
-	if ut.LastName == "" {
-		err = goa.MergeErrors(err, goa.MissingAttributeError(`type`, "lastName"))
+	if utf8.RuneCountInString(ut.Name) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError(`type.name`, ut.Name, utf8.RuneCountInString(ut.Name), 1, true))
 	}
+	return
+}
 
-	if ut.GRecaptchaResponse == "" {
-		err = goa.MergeErrors(err, goa.MissingAttributeError(`type`, "gRecaptchaResponse"))
+// oauthTokenParams user type.
+type oauthTokenParams struct {
+	// ID of the client, unless it is sent with HTTP Basic authentication
+	ClientID *string `form:"client_id,omitempty" json:"client_id,omitempty" yaml:"client_id,omitempty" xml:"client_id,omitempty"`
+	// Secret of a confidential client, unless it is sent with HTTP Basic authentication
+	ClientSecret *string `form:"client_secret,omitempty" json:"client_secret,omitempty" yaml:"client_secret,omitempty" xml:"client_secret,omitempty"`
+	// Authorization code, for the authorization_code grant
+	Code *string `form:"code,omitempty" json:"code,omitempty" yaml:"code,omitempty" xml:"code,omitempty"`
+	// PKCE verifier of the code challenge, for the authorization_code grant
+	CodeVerifier *string `form:"code_verifier,omitempty" json:"code_verifier,omitempty" yaml:"code_verifier,omitempty" xml:"code_verifier,omitempty"`
+	// authorization_code, refresh_token or client_credentials
+	GrantType *string `form:"grant_type,omitempty" json:"grant_type,omitempty" yaml:"grant_type,omitempty" xml:"grant_type,omitempty"`
+	// Redirect URI the code was sent to, for the authorization_code grant
+	RedirectURI *string `form:"redirect_uri,omitempty" json:"redirect_uri,omitempty" yaml:"redirect_uri,omitempty" xml:"redirect_uri,omitempty"`
+	// Refresh token, for the refresh_token grant
+	RefreshToken *string `form:"refresh_token,omitempty" json:"refresh_token,omitempty" yaml:"refresh_token,omitempty" xml:"refresh_token,omitempty"`
+	// Space separated scopes, for the client_credentials grant, all the scopes registered to the client when it is left out
+	Scope *string `form:"scope,omitempty" json:"scope,omitempty" yaml:"scope,omitempty" xml:"scope,omitempty"`
+}
+
+// Validate validates the oauthTokenParams type instance.
+func (ut *oauthTokenParams) Validate() (err error) {
+	if ut.GrantType == nil {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "grant_type"))
 	}
-	if err2 := goa.ValidateFormat(goa.FormatEmail, ut.Email); err2 != nil {
-		err = goa.MergeErrors(err, goa.InvalidFormatError(`type.email`, ut.Email, goa.FormatEmail, err2))
+	return
+}
+
+// Publicize creates OauthTokenParams from oauthTokenParams
+func (ut *oauthTokenParams) Publicize() *OauthTokenParams {
+	var pub OauthTokenParams
+	if ut.ClientID != nil {
+		pub.ClientID = ut.ClientID
 	}
-	if utf8.RuneCountInString(ut.FirstName) < 2 {
-		err = goa.MergeErrors(err, goa.InvalidLengthError(`type.firstName`, ut.FirstName, utf8.RuneCountInString(ut.FirstName), 2, true))
+	if ut.ClientSecret != nil {
+		pub.ClientSecret = ut.ClientSecret
 	}
-	if utf8.RuneCountInString(ut.FirstName) > 50 {
-		err = goa.MergeErrors(err, goa.InvalidLengthError(`type.firstName`, ut.FirstName, utf8.RuneCountInString(ut.FirstName), 50, false))
+	if ut.Code != nil {
+		pub.Code = ut.Code
 	}
-	if utf8.RuneCountInString(ut.LastName) < 2 {
-		err = goa.MergeErrors(err, goa.InvalidLengthError(`type.lastName`, ut.LastName, utf8.RuneCountInString(ut.LastName), 2, true))
+	if ut.CodeVerifier != nil {
+		pub.CodeVerifier = ut.CodeVerifier
 	}
-	if utf8.RuneCountInString(ut.LastName) > 50 {
-		err = goa.MergeErrors(err, goa.InvalidLengthError(`type.lastName`, ut.LastName, utf8.RuneCountInString(ut.LastName), 50, false))
+	if ut.GrantType != nil {
+		pub.GrantType = *ut.GrantType
+	}
+	if ut.RedirectURI != nil {
+		pub.RedirectURI = ut.RedirectURI
+	}
+	if ut.RefreshToken != nil {
+		pub.RefreshToken = ut.RefreshToken
+	}
+	if ut.Scope != nil {
+		pub.Scope = ut.Scope
+	}
+	return &pub
+}
+
+// OauthTokenParams user type.
+type OauthTokenParams struct {
+	// ID of the client, unless it is sent with HTTP Basic authentication
+	ClientID *string `form:"client_id,omitempty" json:"client_id,omitempty" yaml:"client_id,omitempty" xml:"client_id,omitempty"`
+	// Secret of a confidential client, unless it is sent with HTTP Basic authentication
+	ClientSecret *string `form:"client_secret,omitempty" json:"client_secret,omitempty" yaml:"client_secret,omitempty" xml:"client_secret,omitempty"`
+	// Authorization code, for the authorization_code grant
+	Code *string `form:"code,omitempty" json:"code,omitempty" yaml:"code,omitempty" xml:"code,omitempty"`
+	// PKCE verifier of the code challenge, for the authorization_code grant
+	CodeVerifier *string `form:"code_verifier,omitempty" json:"code_verifier,omitempty" yaml:"code_verifier,omitempty" xml:"code_verifier,omitempty"`
+	// authorization_code, refresh_token or client_credentials
+	GrantType string `form:"grant_type" json:"grant_type" yaml:"grant_type" xml:"grant_type"`
+	// Redirect URI the code was sent to, for the authorization_code grant
+	RedirectURI *string `form:"redirect_uri,omitempty" json:"redirect_uri,omitempty" yaml:"redirect_uri,omitempty" xml:"redirect_uri,omitempty"`
+	// Refresh token, for the refresh_token grant
+	RefreshToken *string `form:"refresh_token,omitempty" json:"refresh_token,omitempty" yaml:"refresh_token,omitempty" xml:"refresh_token,omitempty"`
+	// Space separated scopes, for the client_credentials grant, all the scopes registered to the client when it is left out
+	Scope *string `form:"scope,omitempty" json:"scope,omitempty" yaml:"scope,omitempty" xml:"scope,omitempty"`
+}
+
+// Validate validates the OauthTokenParams type instance.
+func (ut *OauthTokenParams) Validate() (err error) {
+	if ut.GrantType == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`type`, "grant_type"))
 	}
 	return
 }
 
-// loginParams user type.
-type loginParams struct {
-	// 2 Factor Auth if user has enabled the feature
-	TwoFactor *string `form:"TwoFactor,omitempty" json:"TwoFactor,omitempty" yaml:"TwoFactor,omitempty" xml:"TwoFactor,omitempty"`
-	// The email address of the account to login to
-	Email *string `form:"email,omitempty" json:"email,omitempty" yaml:"email,omitempty" xml:"email,omitempty"`
-	// The password of the account to login to
-	Password *string `form:"password,omitempty" json:"password,omitempty" yaml:"password,omitempty" xml:"password,omitempty"`
+// phoneCodeParams user type.
+type phoneCodeParams struct {
+	// The 6 digit code that was texted to the user
+	Code *string `form:"code,omitempty" json:"code,omitempty" yaml:"code,omitempty" xml:"code,omitempty"`
 }
 
-// Validate validates the loginParams type instance.
-func (ut *loginParams) Validate() (err error) {
-	if ut.Email == nil {
-		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "email"))
-	}
-	if ut.Password == nil {
-		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "password"))
-	}
-	if ut.TwoFactor != nil {
-		if utf8.RuneCountInString(*ut.TwoFactor) < 6 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError(`request.TwoFactor`, *ut.TwoFactor, utf8.RuneCountInString(*ut.TwoFactor), 6, true))
-		}
-	}
-	if ut.TwoFactor != nil {
-		if utf8.RuneCountInString(*ut.TwoFactor) > 8 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError(`request.TwoFactor`, *ut.TwoFactor, utf8.RuneCountInString(*ut.TwoFactor), 8, false))
-		}
+// Validate validates the phoneCodeParams type instance.
+func (ut *phoneCodeParams) Validate() (err error) {
+	if ut.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "code"))
 	}
-	if ut.Email != nil {
-		if err2 := goa.ValidateFormat(goa.FormatEmail, *ut.Email); err2 != nil {
-			err = goa.MergeErrors(err, goa.InvalidFormatError(`request.email`, *ut.Email, goa.FormatEmail, err2))
-		}
-	}
-	if ut.Password != nil {
-		if ok := goa.ValidatePattern(`^.*[\w].*$`, *ut.Password); !ok {
-			err = goa.MergeErrors(err, goa.InvalidPatternError(`request.password`, *ut.Password, `^.*[\w].*$`))
-		}
-	}
-	if ut.Password != nil {
-		if utf8.RuneCountInString(*ut.Password) < 6 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError(`request.password`, *ut.Password, utf8.RuneCountInString(*ut.Password), 6, true))
-		}
-	}
-	if ut.Password != nil {
-		if utf8.RuneCountInString(*ut.Password) > 100 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError(`request.password`, *ut.Password, utf8.RuneCountInString(*ut.Password), 100, false))
+	if ut.Code != nil {
+		if ok := goa.ValidatePattern(`^[0-9]{6}$`, *ut.Code); !ok {
+			err = goa.MergeErrors(err, goa.InvalidPatternError(`request.code`, *ut.Code, `^[0-9]{6}$`))
 		}
 	}
 	return
 }
 
-// Publicize creates LoginParams from loginParams
-func (ut *loginParams) Publicize() *LoginParams {
-	var pub LoginParams
-	if ut.TwoFactor != nil {
-		pub.TwoFactor = ut.TwoFactor
+// Publicize creates PhoneCodeParams from phoneCodeParams
+func (ut *phoneCodeParams) Publicize() *PhoneCodeParams {
+	var pub PhoneCodeParams
+	if ut.Code != nil {
+		pub.Code = *ut.Code
 	}
-	if ut.Email != nil {
-		pub.Email = *ut.Email
+	return &pub
+}
+
+// PhoneCodeParams user type.
+type PhoneCodeParams struct {
+	// The 6 digit code that was texted to the user
+	Code string `form:"code" json:"code" yaml:"code" xml:"code"`
+}
+
+// Validate validates the PhoneCodeParams type instance.
+func (ut *PhoneCodeParams) Validate() (err error) {
+	if ut.Code == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`type`, "code"))
 	}
-	if ut.Password != nil {
-		pub.Password = *ut.Password
+	if ok := goa.ValidatePattern(`^[0-9]{6}$`, ut.Code); !ok {
+		err = goa.MergeErrors(err, goa.InvalidPatternError(`type.code`, ut.Code, `^[0-9]{6}$`))
 	}
-	return &pub
+	return
 }
 
-// LoginParams user type.
-type LoginParams struct {
-	// 2 Factor Auth if user has enabled the feature
-	TwoFactor *string `form:"TwoFactor,omitempty" json:"TwoFactor,omitempty" yaml:"TwoFactor,omitempty" xml:"TwoFactor,omitempty"`
-	// The email address of the account to login to
-	Email string `form:"email" json:"email" yaml:"email" xml:"email"`
-	// The password of the account to login to
-	Password string `form:"password" json:"password" yaml:"password" xml:"password"`
+// phoneLoginParams user type.
+type phoneLoginParams struct {
+	// The 6 digit code that was texted to the user
+	Code *string `form:"code,omitempty" json:"code,omitempty" yaml:"code,omitempty" xml:"code,omitempty"`
+	// The verified phone number of the account to login to, in E.164 format
+	Phone *string `form:"phone,omitempty" json:"phone,omitempty" yaml:"phone,omitempty" xml:"phone,omitempty"`
 }
 
-// Validate validates the LoginParams type instance.
-func (ut *LoginParams) Validate() (err error) {
-	if ut.Email == "" {
-		err = goa.MergeErrors(err, goa.MissingAttributeError(`type`, "email"))
+// Validate validates the phoneLoginParams type instance.
+func (ut *phoneLoginParams) Validate() (err error) {
+	if ut.Phone == nil {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "phone"))
 	}
-	if ut.Password == "" {
-		err = goa.MergeErrors(err, goa.MissingAttributeError(`type`, "password"))
+	if ut.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "code"))
 	}
-	if ut.TwoFactor != nil {
-		if utf8.RuneCountInString(*ut.TwoFactor) < 6 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError(`type.TwoFactor`, *ut.TwoFactor, utf8.RuneCountInString(*ut.TwoFactor), 6, true))
+	if ut.Code != nil {
+		if ok := goa.ValidatePattern(`^[0-9]{6}$`, *ut.Code); !ok {
+			err = goa.MergeErrors(err, goa.InvalidPatternError(`request.code`, *ut.Code, `^[0-9]{6}$`))
 		}
 	}
-	if ut.TwoFactor != nil {
-		if utf8.RuneCountInString(*ut.TwoFactor) > 8 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError(`type.TwoFactor`, *ut.TwoFactor, utf8.RuneCountInString(*ut.TwoFactor), 8, false))
+	if ut.Phone != nil {
+		if ok := goa.ValidatePattern(`^\+[1-9][0-9]{6,14}$`, *ut.Phone); !ok {
+			err = goa.MergeErrors(err, goa.InvalidPatternError(`request.phone`, *ut.Phone, `^\+[1-9][0-9]{6,14}$`))
 		}
 	}
-	if err2 := goa.ValidateFormat(goa.FormatEmail, ut.Email); err2 != nil {
-		err = goa.MergeErrors(err, goa.InvalidFormatError(`type.email`, ut.Email, goa.FormatEmail, err2))
+	return
+}
+
+// Publicize creates PhoneLoginParams from phoneLoginParams
+func (ut *phoneLoginParams) Publicize() *PhoneLoginParams {
+	var pub PhoneLoginParams
+	if ut.Code != nil {
+		pub.Code = *ut.Code
+	}
+	if ut.Phone != nil {
+		pub.Phone = *ut.Phone
+	}
+	return &pub
+}
+
+// PhoneLoginParams user type.
+type PhoneLoginParams struct {
+	// The 6 digit code that was texted to the user
+	Code string `form:"code" json:"code" yaml:"code" xml:"code"`
+	// The verified phone number of the account to login to, in E.164 format
+	Phone string `form:"phone" json:"phone" yaml:"phone" xml:"phone"`
+}
+
+// Validate validates the PhoneLoginParams type instance.
+func (ut *PhoneLoginParams) Validate() (err error) {
+	if ut.Phone == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`type`, "phone"))
 	}
-	if ok := goa.ValidatePattern(`^.*[\w].*$`, ut.Password); !ok {
-		err = goa.MergeErrors(err, goa.InvalidPatternError(`type.password`, ut.Password, `^.*[\w].*$`))
+	if ut.Code == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`type`, "code"))
 	}
-	if utf8.RuneCountInString(ut.Password) < 6 {
-		err = goa.MergeErrors(err, goa.InvalidLengthError(`type.password`, ut.Password, utf8.RuneCountInString(ut.Password), 6, true))
+	if ok := goa.ValidatePattern(`^[0-9]{6}$`, ut.Code); !ok {
+		err = goa.MergeErrors(err, goa.InvalidPatternError(`type.code`, ut.Code, `^[0-9]{6}$`))
 	}
-	if utf8.RuneCountInString(ut.Password) > 100 {
-		err = goa.MergeErrors(err, goa.InvalidLengthError(`type.password`, ut.Password, utf8.RuneCountInString(ut.Password), 100, false))
+	if ok := goa.ValidatePattern(`^\+[1-9][0-9]{6,14}$`, ut.Phone); !ok {
+		err = goa.MergeErrors(err, goa.InvalidPatternError(`type.phone`, ut.Phone, `^\+[1-9][0-9]{6,14}$`))
 	}
 	return
 }
 
-// microsoftRegisterParams user type.
-type microsoftRegisterParams struct {
-	// The email that will be connected to the account
+// registerParams user type.
+type registerParams struct {
+	// Category/Categories that a user might select (User interests)
+	Category []string `form:"category,omitempty" json:"category,omitempty" yaml:"category,omitempty" xml:"category,omitempty"`
+	// The email that will be attached to the account
 	Email *string `form:"email,omitempty" json:"email,omitempty" yaml:"email,omitempty" xml:"email,omitempty"`
-	// The given name for the user
+	// The user's given name
 	FirstName *string `form:"firstName,omitempty" json:"firstName,omitempty" yaml:"firstName,omitempty" xml:"firstName,omitempty"`
 	// The recaptcha response code
 	GRecaptchaResponse *string `form:"gRecaptchaResponse,omitempty" json:"gRecaptchaResponse,omitempty" yaml:"gRecaptchaResponse,omitempty" xml:"gRecaptchaResponse,omitempty"`
-	// The family name for the user
+	// The user's family name
 	LastName *string `form:"lastName,omitempty" json:"lastName,omitempty" yaml:"lastName,omitempty" xml:"lastName,omitempty"`
-	// The key given when the register was approved
-	OauthKey *uuid.UUID `form:"oauthKey,omitempty" json:"oauthKey,omitempty" yaml:"oauthKey,omitempty" xml:"oauthKey,omitempty"`
+	// The password associated with the new account
+	Password *string `form:"password,omitempty" json:"password,omitempty" yaml:"password,omitempty" xml:"password,omitempty"`
 }
 
-// Validate validates the microsoftRegisterParams type instance.
-func (ut *microsoftRegisterParams) Validate() (err error) {
+// Validate validates the registerParams type instance.
+func (ut *registerParams) Validate() (err error) {
 	if ut.Email == nil {
 		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "email"))
 	}
+	if ut.Password == nil {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "password"))
+	}
 	if ut.FirstName == nil {
 		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "firstName"))
 	}
 	if ut.LastName == nil {
 		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "lastName"))
 	}
-	if ut.OauthKey == nil {
-		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "oauthKey"))
-	}
 	if ut.GRecaptchaResponse == nil {
 		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "gRecaptchaResponse"))
 	}
+	if ut.Category == nil {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "category"))
+	}
 	if ut.Email != nil {
 		if err2 := goa.ValidateFormat(goa.FormatEmail, *ut.Email); err2 != nil {
 			err = goa.MergeErrors(err, goa.InvalidFormatError(`request.email`, *ut.Email, goa.FormatEmail, err2))
@@ -817,22 +775,35 @@ func (ut *microsoftRegisterParams) Validate() (err error) {
 			err = goa.MergeErrors(err, goa.InvalidLengthError(`request.firstName`, *ut.FirstName, utf8.RuneCountInString(*ut.FirstName), 50, false))
 		}
 	}
-	if ut.LastName != nil {
-		if utf8.RuneCountInString(*ut.LastName) < 2 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError(`request.lastName`, *ut.LastName, utf8.RuneCountInString(*ut.LastName), 2, true))
-		}
-	}
 	if ut.LastName != nil {
 		if utf8.RuneCountInString(*ut.LastName) > 50 {
 			err = goa.MergeErrors(err, goa.InvalidLengthError(`request.lastName`, *ut.LastName, utf8.RuneCountInString(*ut.LastName), 50, false))
 		}
 	}
+	if ut.Password != nil {
+		if ok := goa.ValidatePattern(`^.*[\w].*$`, *ut.Password); !ok {
+			err = goa.MergeErrors(err, goa.InvalidPatternError(`request.password`, *ut.Password, `^.*[\w].*$`))
+		}
+	}
+	if ut.Password != nil {
+		if utf8.RuneCountInString(*ut.Password) < 6 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError(`request.password`, *ut.Password, utf8.RuneCountInString(*ut.Password), 6, true))
+		}
+	}
+	if ut.Password != nil {
+		if utf8.RuneCountInString(*ut.Password) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError(`request.password`, *ut.Password, utf8.RuneCountInString(*ut.Password), 100, false))
+		}
+	}
 	return
 }
 
-// Publicize creates MicrosoftRegisterParams from microsoftRegisterParams
-func (ut *microsoftRegisterParams) Publicize() *MicrosoftRegisterParams {
-	var pub MicrosoftRegisterParams
+// Publicize creates RegisterParams from registerParams
+func (ut *registerParams) Publicize() *RegisterParams {
+	var pub RegisterParams
+	if ut.Category != nil {
+		pub.Category = ut.Category
+	}
 	if ut.Email != nil {
 		pub.Email = *ut.Email
 	}
@@ -845,41 +816,48 @@ func (ut *microsoftRegisterParams) Publicize() *MicrosoftRegisterParams {
 	if ut.LastName != nil {
 		pub.LastName = *ut.LastName
 	}
-	if ut.OauthKey != nil {
-		pub.OauthKey = *ut.OauthKey
+	if ut.Password != nil {
+		pub.Password = *ut.Password
 	}
 	return &pub
 }
 
-// MicrosoftRegisterParams user type.
-type MicrosoftRegisterParams struct {
-	// The email that will be connected to the account
+// RegisterParams user type.
+type RegisterParams struct {
+	// Category/Categories that a user might select (User interests)
+	Category []string `form:"category" json:"category" yaml:"category" xml:"category"`
+	// The email that will be attached to the account
 	Email string `form:"email" json:"email" yaml:"email" xml:"email"`
-	// The given name for the user
+	// The user's given name
 	FirstName string `form:"firstName" json:"firstName" yaml:"firstName" xml:"firstName"`
 	// The recaptcha response code
 	GRecaptchaResponse string `form:"gRecaptchaResponse" json:"gRecaptchaResponse" yaml:"gRecaptchaResponse" xml:"gRecaptchaResponse"`
-	// The family name for the user
+	// The user's family name
 	LastName string `form:"lastName" json:"lastName" yaml:"lastName" xml:"lastName"`
-	// The key given when the register was approved
-	OauthKey uuid.UUID `form:"oauthKey" json:"oauthKey" yaml:"oauthKey" xml:"oauthKey"`
+	// The password associated with the new account
+	Password string `form:"password" json:"password" yaml:"password" xml:"password"`
 }
 
-// Validate validates the MicrosoftRegisterParams type instance.
-func (ut *MicrosoftRegisterParams) Validate() (err error) {
+// Validate validates the RegisterParams type instance.
+func (ut *RegisterParams) Validate() (err error) {
 	if ut.Email == "" {
 		err = goa.MergeErrors(err, goa.MissingAttributeError(`type`, "email"))
 	}
+	if ut.Password == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`type`, "password"))
+	}
 	if ut.FirstName == "" {
 		err = goa.MergeErrors(err, goa.MissingAttributeError(`type`, "firstName"))
 	}
 	if ut.LastName == "" {
 		err = goa.MergeErrors(err, goa.MissingAttributeError(`type`, "lastName"))
 	}
-
 	if ut.GRecaptchaResponse == "" {
 		err = goa.MergeErrors(err, goa.MissingAttributeError(`type`, "gRecaptchaResponse"))
 	}
+	if ut.Category == nil {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`type`, "category"))
+	}
 	if err2 := goa.ValidateFormat(goa.FormatEmail, ut.Email); err2 != nil {
 		err = goa.MergeErrors(err, goa.InvalidFormatError(`type.email`, ut.Email, goa.FormatEmail, err2))
 	}
@@ -889,100 +867,196 @@ func (ut *MicrosoftRegisterParams) Validate() (err error) {
 	if utf8.RuneCountInString(ut.FirstName) > 50 {
 		err = goa.MergeErrors(err, goa.InvalidLengthError(`type.firstName`, ut.FirstName, utf8.RuneCountInString(ut.FirstName), 50, false))
 	}
-	if utf8.RuneCountInString(ut.LastName) < 2 {
-		err = goa.MergeErrors(err, goa.InvalidLengthError(`type.lastName`, ut.LastName, utf8.RuneCountInString(ut.LastName), 2, true))
-	}
 	if utf8.RuneCountInString(ut.LastName) > 50 {
 		err = goa.MergeErrors(err, goa.InvalidLengthError(`type.lastName`, ut.LastName, utf8.RuneCountInString(ut.LastName), 50, false))
 	}
+	if ok := goa.ValidatePattern(`^.*[\w].*$`, ut.Password); !ok {
+		err = goa.MergeErrors(err, goa.InvalidPatternError(`type.password`, ut.Password, `^.*[\w].*$`))
+	}
+	if utf8.RuneCountInString(ut.Password) < 6 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError(`type.password`, ut.Password, utf8.RuneCountInString(ut.Password), 6, true))
+	}
+	if utf8.RuneCountInString(ut.Password) > 100 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError(`type.password`, ut.Password, utf8.RuneCountInString(ut.Password), 100, false))
+	}
 	return
 }
 
-// Parameters to add/remove a newsletter subscriber
-type newsletterParam struct {
-	Email        *string    `form:"email,omitempty" json:"email,omitempty" yaml:"email,omitempty" xml:"email,omitempty"`
-	IsActive     *bool      `form:"isActive,omitempty" json:"isActive,omitempty" yaml:"isActive,omitempty" xml:"isActive,omitempty"`
-	SubscribedAt *time.Time `form:"subscribedAt,omitempty" json:"subscribedAt,omitempty" yaml:"subscribedAt,omitempty" xml:"subscribedAt,omitempty"`
+// resetPasswordParams user type.
+type resetPasswordParams struct {
+	// The new password that will be used to login to the account
+	NewPassword *string `form:"newPassword,omitempty" json:"newPassword,omitempty" yaml:"newPassword,omitempty" xml:"newPassword,omitempty"`
+	// The UUID of the password reset, send from the user's email
+	ResetCode *string `form:"resetCode,omitempty" json:"resetCode,omitempty" yaml:"resetCode,omitempty" xml:"resetCode,omitempty"`
+	// The ID of the user to reset the password of
+	UserID *string `form:"userID,omitempty" json:"userID,omitempty" yaml:"userID,omitempty" xml:"userID,omitempty"`
 }
 
-// Validate validates the newsletterParam type instance.
-func (ut *newsletterParam) Validate() (err error) {
-	if ut.Email != nil {
-		if err2 := goa.ValidateFormat(goa.FormatEmail, *ut.Email); err2 != nil {
-			err = goa.MergeErrors(err, goa.InvalidFormatError(`request.email`, *ut.Email, goa.FormatEmail, err2))
+// Validate validates the resetPasswordParams type instance.
+func (ut *resetPasswordParams) Validate() (err error) {
+	if ut.ResetCode == nil {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "resetCode"))
+	}
+	if ut.UserID == nil {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "userID"))
+	}
+	if ut.NewPassword == nil {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "newPassword"))
+	}
+	if ut.NewPassword != nil {
+		if ok := goa.ValidatePattern(`^.*[\w].*$`, *ut.NewPassword); !ok {
+			err = goa.MergeErrors(err, goa.InvalidPatternError(`request.newPassword`, *ut.NewPassword, `^.*[\w].*$`))
+		}
+	}
+	if ut.NewPassword != nil {
+		if utf8.RuneCountInString(*ut.NewPassword) < 6 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError(`request.newPassword`, *ut.NewPassword, utf8.RuneCountInString(*ut.NewPassword), 6, true))
+		}
+	}
+	if ut.NewPassword != nil {
+		if utf8.RuneCountInString(*ut.NewPassword) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError(`request.newPassword`, *ut.NewPassword, utf8.RuneCountInString(*ut.NewPassword), 100, false))
 		}
 	}
 	return
 }
 
-// Publicize creates NewsletterParam from newsletterParam
-func (ut *newsletterParam) Publicize() *NewsletterParam {
-	var pub NewsletterParam
-	if ut.Email != nil {
-		pub.Email = ut.Email
+// Publicize creates ResetPasswordParams from resetPasswordParams
+func (ut *resetPasswordParams) Publicize() *ResetPasswordParams {
+	var pub ResetPasswordParams
+	if ut.NewPassword != nil {
+		pub.NewPassword = *ut.NewPassword
 	}
-	if ut.IsActive != nil {
-		pub.IsActive = ut.IsActive
+	if ut.ResetCode != nil {
+		pub.ResetCode = *ut.ResetCode
 	}
-	if ut.SubscribedAt != nil {
-		pub.SubscribedAt = ut.SubscribedAt
+	if ut.UserID != nil {
+		pub.UserID = *ut.UserID
 	}
 	return &pub
 }
 
-// Parameters to add/remove a newsletter subscriber
-type NewsletterParam struct {
-	Email        *string    `form:"email,omitempty" json:"email,omitempty" yaml:"email,omitempty" xml:"email,omitempty"`
-	IsActive     *bool      `form:"isActive,omitempty" json:"isActive,omitempty" yaml:"isActive,omitempty" xml:"isActive,omitempty"`
-	SubscribedAt *time.Time `form:"subscribedAt,omitempty" json:"subscribedAt,omitempty" yaml:"subscribedAt,omitempty" xml:"subscribedAt,omitempty"`
+// ResetPasswordParams user type.
+type ResetPasswordParams struct {
+	// The new password that will be used to login to the account
+	NewPassword string `form:"newPassword" json:"newPassword" yaml:"newPassword" xml:"newPassword"`
+	// The UUID of the password reset, send from the user's email
+	ResetCode string `form:"resetCode" json:"resetCode" yaml:"resetCode" xml:"resetCode"`
+	// The ID of the user to reset the password of
+	UserID string `form:"userID" json:"userID" yaml:"userID" xml:"userID"`
 }
 
-// Validate validates the NewsletterParam type instance.
-func (ut *NewsletterParam) Validate() (err error) {
-	if ut.Email != nil {
-		if err2 := goa.ValidateFormat(goa.FormatEmail, *ut.Email); err2 != nil {
-			err = goa.MergeErrors(err, goa.InvalidFormatError(`type.email`, *ut.Email, goa.FormatEmail, err2))
-		}
+// Validate validates the ResetPasswordParams type instance.
+func (ut *ResetPasswordParams) Validate() (err error) {
+	if ut.ResetCode == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`type`, "resetCode"))
+	}
+	if ut.UserID == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`type`, "userID"))
+	}
+	if ut.NewPassword == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`type`, "newPassword"))
+	}
+	if ok := goa.ValidatePattern(`^.*[\w].*$`, ut.NewPassword); !ok {
+		err = goa.MergeErrors(err, goa.InvalidPatternError(`type.newPassword`, ut.NewPassword, `^.*[\w].*$`))
+	}
+	if utf8.RuneCountInString(ut.NewPassword) < 6 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError(`type.newPassword`, ut.NewPassword, utf8.RuneCountInString(ut.NewPassword), 6, true))
+	}
+	if utf8.RuneCountInString(ut.NewPassword) > 100 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError(`type.newPassword`, ut.NewPassword, utf8.RuneCountInString(ut.NewPassword), 100, false))
 	}
 	return
 }
 
-// registerParams user type.
-type registerParams struct {
-	// Category/Categories that a user might select (User interests)
-	Category []string `form:"category,omitempty" json:"category,omitempty" yaml:"category,omitempty" xml:"category,omitempty"`
-	// The email that will be attached to the account
+// socialReceiveFormParams user type.
+type socialReceiveFormParams struct {
+	// Authorization code, missing when the user didn't authorize the application
+	Code *string `form:"code,omitempty" json:"code,omitempty" yaml:"code,omitempty" xml:"code,omitempty"`
+	// Error code, when the user didn't authorize the application
+	Error *string `form:"error,omitempty" json:"error,omitempty" yaml:"error,omitempty" xml:"error,omitempty"`
+	// ID token, it is ignored as the one returned with the access token is used
+	IDToken *string `form:"id_token,omitempty" json:"id_token,omitempty" yaml:"id_token,omitempty" xml:"id_token,omitempty"`
+	// The state the connection was created with
+	State *uuid.UUID `form:"state,omitempty" json:"state,omitempty" yaml:"state,omitempty" xml:"state,omitempty"`
+	// JSON of the user's name and email, Apple only sends it the first time the user signs in
+	User *string `form:"user,omitempty" json:"user,omitempty" yaml:"user,omitempty" xml:"user,omitempty"`
+}
+
+// Validate validates the socialReceiveFormParams type instance.
+func (ut *socialReceiveFormParams) Validate() (err error) {
+	if ut.State == nil {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "state"))
+	}
+	return
+}
+
+// Publicize creates SocialReceiveFormParams from socialReceiveFormParams
+func (ut *socialReceiveFormParams) Publicize() *SocialReceiveFormParams {
+	var pub SocialReceiveFormParams
+	if ut.Code != nil {
+		pub.Code = ut.Code
+	}
+	if ut.Error != nil {
+		pub.Error = ut.Error
+	}
+	if ut.IDToken != nil {
+		pub.IDToken = ut.IDToken
+	}
+	if ut.State != nil {
+		pub.State = *ut.State
+	}
+	if ut.User != nil {
+		pub.User = ut.User
+	}
+	return &pub
+}
+
+// SocialReceiveFormParams user type.
+type SocialReceiveFormParams struct {
+	// Authorization code, missing when the user didn't authorize the application
+	Code *string `form:"code,omitempty" json:"code,omitempty" yaml:"code,omitempty" xml:"code,omitempty"`
+	// Error code, when the user didn't authorize the application
+	Error *string `form:"error,omitempty" json:"error,omitempty" yaml:"error,omitempty" xml:"error,omitempty"`
+	// ID token, it is ignored as the one returned with the access token is used
+	IDToken *string `form:"id_token,omitempty" json:"id_token,omitempty" yaml:"id_token,omitempty" xml:"id_token,omitempty"`
+	// The state the connection was created with
+	State uuid.UUID `form:"state" json:"state" yaml:"state" xml:"state"`
+	// JSON of the user's name and email, Apple only sends it the first time the user signs in
+	User *string `form:"user,omitempty" json:"user,omitempty" yaml:"user,omitempty" xml:"user,omitempty"`
+}
+
+// socialRegisterParams user type.
+type socialRegisterParams struct {
+	// The email that will be connected to the account
 	Email *string `form:"email,omitempty" json:"email,omitempty" yaml:"email,omitempty" xml:"email,omitempty"`
-	// The user's given name
+	// The given name for the user
 	FirstName *string `form:"firstName,omitempty" json:"firstName,omitempty" yaml:"firstName,omitempty" xml:"firstName,omitempty"`
 	// The recaptcha response code
 	GRecaptchaResponse *string `form:"gRecaptchaResponse,omitempty" json:"gRecaptchaResponse,omitempty" yaml:"gRecaptchaResponse,omitempty" xml:"gRecaptchaResponse,omitempty"`
-	// The user's family name
+	// The family name for the user
 	LastName *string `form:"lastName,omitempty" json:"lastName,omitempty" yaml:"lastName,omitempty" xml:"lastName,omitempty"`
-	// The password associated with the new account
-	Password *string `form:"password,omitempty" json:"password,omitempty" yaml:"password,omitempty" xml:"password,omitempty"`
+	// The key given when the register was approved
+	OauthKey *uuid.UUID `form:"oauthKey,omitempty" json:"oauthKey,omitempty" yaml:"oauthKey,omitempty" xml:"oauthKey,omitempty"`
 }
 
-// Validate validates the registerParams type instance.
-func (ut *registerParams) Validate() (err error) {
+// Validate validates the socialRegisterParams type instance.
+func (ut *socialRegisterParams) Validate() (err error) {
 	if ut.Email == nil {
 		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "email"))
 	}
-	if ut.Password == nil {
-		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "password"))
-	}
 	if ut.FirstName == nil {
 		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "firstName"))
 	}
 	if ut.LastName == nil {
 		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "lastName"))
 	}
+	if ut.OauthKey == nil {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "oauthKey"))
+	}
 	if ut.GRecaptchaResponse == nil {
 		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "gRecaptchaResponse"))
 	}
-	if ut.Category == nil {
-		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "category"))
-	}
 	if ut.Email != nil {
 		if err2 := goa.ValidateFormat(goa.FormatEmail, *ut.Email); err2 != nil {
 			err = goa.MergeErrors(err, goa.InvalidFormatError(`request.email`, *ut.Email, goa.FormatEmail, err2))
@@ -998,35 +1072,22 @@ func (ut *registerParams) Validate() (err error) {
 			err = goa.MergeErrors(err, goa.InvalidLengthError(`request.firstName`, *ut.FirstName, utf8.RuneCountInString(*ut.FirstName), 50, false))
 		}
 	}
-	if ut.LastName != nil {
-		if utf8.RuneCountInString(*ut.LastName) > 50 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError(`request.lastName`, *ut.LastName, utf8.RuneCountInString(*ut.LastName), 50, false))
-		}
-	}
-	if ut.Password != nil {
-		if ok := goa.ValidatePattern(`^.*[\w].*$`, *ut.Password); !ok {
-			err = goa.MergeErrors(err, goa.InvalidPatternError(`request.password`, *ut.Password, `^.*[\w].*$`))
-		}
-	}
-	if ut.Password != nil {
-		if utf8.RuneCountInString(*ut.Password) < 6 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError(`request.password`, *ut.Password, utf8.RuneCountInString(*ut.Password), 6, true))
+	if ut.LastName != nil {
+		if utf8.RuneCountInString(*ut.LastName) < 2 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError(`request.lastName`, *ut.LastName, utf8.RuneCountInString(*ut.LastName), 2, true))
 		}
 	}
-	if ut.Password != nil {
-		if utf8.RuneCountInString(*ut.Password) > 100 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError(`request.password`, *ut.Password, utf8.RuneCountInString(*ut.Password), 100, false))
+	if ut.LastName != nil {
+		if utf8.RuneCountInString(*ut.LastName) > 50 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError(`request.lastName`, *ut.LastName, utf8.RuneCountInString(*ut.LastName), 50, false))
 		}
 	}
 	return
 }
 
-// Publicize creates RegisterParams from registerParams
-func (ut *registerParams) Publicize() *RegisterParams {
-	var pub RegisterParams
-	if ut.Category != nil {
-		pub.Category = ut.Category
-	}
+// Publicize creates SocialRegisterParams from socialRegisterParams
+func (ut *socialRegisterParams) Publicize() *SocialRegisterParams {
+	var pub SocialRegisterParams
 	if ut.Email != nil {
 		pub.Email = *ut.Email
 	}
@@ -1039,48 +1100,41 @@ func (ut *registerParams) Publicize() *RegisterParams {
 	if ut.LastName != nil {
 		pub.LastName = *ut.LastName
 	}
-	if ut.Password != nil {
-		pub.Password = *ut.Password
+	if ut.OauthKey != nil {
+		pub.OauthKey = *ut.OauthKey
 	}
 	return &pub
 }
 
-// RegisterParams user type.
-type RegisterParams struct {
-	// Category/Categories that a user might select (User interests)
-	Category []string `form:"category" json:"category" yaml:"category" xml:"category"`
-	// The email that will be attached to the account
+// SocialRegisterParams user type.
+type SocialRegisterParams struct {
+	// The email that will be connected to the account
 	Email string `form:"email" json:"email" yaml:"email" xml:"email"`
-	// The user's given name
+	// The given name for the user
 	FirstName string `form:"firstName" json:"firstName" yaml:"firstName" xml:"firstName"`
 	// The recaptcha response code
 	GRecaptchaResponse string `form:"gRecaptchaResponse" json:"gRecaptchaResponse" yaml:"gRecaptchaResponse" xml:"gRecaptchaResponse"`
-	// The user's family name
+	// The family name for the user
 	LastName string `form:"lastName" json:"lastName" yaml:"lastName" xml:"lastName"`
-	// The password associated with the new account
-	Password string `form:"password" json:"password" yaml:"password" xml:"password"`
+	// The key given when the register was approved
+	OauthKey uuid.UUID `form:"oauthKey" json:"oauthKey" yaml:"oauthKey" xml:"oauthKey"`
 }
 
-// Validate validates the RegisterParams type instance.
-func (ut *RegisterParams) Validate() (err error) {
+// Validate validates the SocialRegisterParams type instance.
+func (ut *SocialRegisterParams) Validate() (err error) {
 	if ut.Email == "" {
 		err = goa.MergeErrors(err, goa.MissingAttributeError(`type`, "email"))
 	}
-	if ut.Password == "" {
-		err = goa.MergeErrors(err, goa.MissingAttributeError(`type`, "password"))
-	}
 	if ut.FirstName == "" {
 		err = goa.MergeErrors(err, goa.MissingAttributeError(`type`, "firstName"))
 	}
 	if ut.LastName == "" {
 		err = goa.MergeErrors(err, goa.MissingAttributeError(`type`, "lastName"))
 	}
+
 	if ut.GRecaptchaResponse == "" {
 		err = goa.MergeErrors(err, goa.MissingAttributeError(`type`, "gRecaptchaResponse"))
 	}
-	if ut.Category == nil {
-		err = goa.MergeErrors(err, goa.MissingAttributeError(`type`, "category"))
-	}
 	if err2 := goa.ValidateFormat(goa.FormatEmail, ut.Email); err2 != nil {
 		err = goa.MergeErrors(err, goa.InvalidFormatError(`type.email`, ut.Email, goa.FormatEmail, err2))
 	}
@@ -1090,104 +1144,55 @@ func (ut *RegisterParams) Validate() (err error) {
 	if utf8.RuneCountInString(ut.FirstName) > 50 {
 		err = goa.MergeErrors(err, goa.InvalidLengthError(`type.firstName`, ut.FirstName, utf8.RuneCountInString(ut.FirstName), 50, false))
 	}
+	if utf8.RuneCountInString(ut.LastName) < 2 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError(`type.lastName`, ut.LastName, utf8.RuneCountInString(ut.LastName), 2, true))
+	}
 	if utf8.RuneCountInString(ut.LastName) > 50 {
 		err = goa.MergeErrors(err, goa.InvalidLengthError(`type.lastName`, ut.LastName, utf8.RuneCountInString(ut.LastName), 50, false))
 	}
-	if ok := goa.ValidatePattern(`^.*[\w].*$`, ut.Password); !ok {
-		err = goa.MergeErrors(err, goa.InvalidPatternError(`type.password`, ut.Password, `^.*[\w].*$`))
-	}
-	if utf8.RuneCountInString(ut.Password) < 6 {
-		err = goa.MergeErrors(err, goa.InvalidLengthError(`type.password`, ut.Password, utf8.RuneCountInString(ut.Password), 6, true))
-	}
-	if utf8.RuneCountInString(ut.Password) > 100 {
-		err = goa.MergeErrors(err, goa.InvalidLengthError(`type.password`, ut.Password, utf8.RuneCountInString(ut.Password), 100, false))
-	}
 	return
 }
 
-// resetPasswordParams user type.
-type resetPasswordParams struct {
-	// The new password that will be used to login to the account
-	NewPassword *string `form:"newPassword,omitempty" json:"newPassword,omitempty" yaml:"newPassword,omitempty" xml:"newPassword,omitempty"`
-	// The UUID of the password reset, send from the user's email
-	ResetCode *string `form:"resetCode,omitempty" json:"resetCode,omitempty" yaml:"resetCode,omitempty" xml:"resetCode,omitempty"`
-	// The ID of the user to reset the password of
-	UserID *string `form:"userID,omitempty" json:"userID,omitempty" yaml:"userID,omitempty" xml:"userID,omitempty"`
+// tokenParams user type.
+type tokenParams struct {
+	// An auth token or session token
+	Token *string `form:"token,omitempty" json:"token,omitempty" yaml:"token,omitempty" xml:"token,omitempty"`
+	// access_token or refresh_token, the other type is tried when it is wrong
+	TokenTypeHint *string `form:"token_type_hint,omitempty" json:"token_type_hint,omitempty" yaml:"token_type_hint,omitempty" xml:"token_type_hint,omitempty"`
 }
 
-// Validate validates the resetPasswordParams type instance.
-func (ut *resetPasswordParams) Validate() (err error) {
-	if ut.ResetCode == nil {
-		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "resetCode"))
-	}
-	if ut.UserID == nil {
-		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "userID"))
-	}
-	if ut.NewPassword == nil {
-		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "newPassword"))
-	}
-	if ut.NewPassword != nil {
-		if ok := goa.ValidatePattern(`^.*[\w].*$`, *ut.NewPassword); !ok {
-			err = goa.MergeErrors(err, goa.InvalidPatternError(`request.newPassword`, *ut.NewPassword, `^.*[\w].*$`))
-		}
-	}
-	if ut.NewPassword != nil {
-		if utf8.RuneCountInString(*ut.NewPassword) < 6 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError(`request.newPassword`, *ut.NewPassword, utf8.RuneCountInString(*ut.NewPassword), 6, true))
-		}
-	}
-	if ut.NewPassword != nil {
-		if utf8.RuneCountInString(*ut.NewPassword) > 100 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError(`request.newPassword`, *ut.NewPassword, utf8.RuneCountInString(*ut.NewPassword), 100, false))
-		}
+// Validate validates the tokenParams type instance.
+func (ut *tokenParams) Validate() (err error) {
+	if ut.Token == nil {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "token"))
 	}
 	return
 }
 
-// Publicize creates ResetPasswordParams from resetPasswordParams
-func (ut *resetPasswordParams) Publicize() *ResetPasswordParams {
-	var pub ResetPasswordParams
-	if ut.NewPassword != nil {
-		pub.NewPassword = *ut.NewPassword
+// Publicize creates TokenParams from tokenParams
+func (ut *tokenParams) Publicize() *TokenParams {
+	var pub TokenParams
+	if ut.Token != nil {
+		pub.Token = *ut.Token
 	}
-	if ut.ResetCode != nil {
-		pub.ResetCode = *ut.ResetCode
-	}
-	if ut.UserID != nil {
-		pub.UserID = *ut.UserID
+	if ut.TokenTypeHint != nil {
+		pub.TokenTypeHint = ut.TokenTypeHint
 	}
 	return &pub
 }
 
-// ResetPasswordParams user type.
-type ResetPasswordParams struct {
-	// The new password that will be used to login to the account
-	NewPassword string `form:"newPassword" json:"newPassword" yaml:"newPassword" xml:"newPassword"`
-	// The UUID of the password reset, send from the user's email
-	ResetCode string `form:"resetCode" json:"resetCode" yaml:"resetCode" xml:"resetCode"`
-	// The ID of the user to reset the password of
-	UserID string `form:"userID" json:"userID" yaml:"userID" xml:"userID"`
+// TokenParams user type.
+type TokenParams struct {
+	// An auth token or session token
+	Token string `form:"token" json:"token" yaml:"token" xml:"token"`
+	// access_token or refresh_token, the other type is tried when it is wrong
+	TokenTypeHint *string `form:"token_type_hint,omitempty" json:"token_type_hint,omitempty" yaml:"token_type_hint,omitempty" xml:"token_type_hint,omitempty"`
 }
 
-// Validate validates the ResetPasswordParams type instance.
-func (ut *ResetPasswordParams) Validate() (err error) {
-	if ut.ResetCode == "" {
-		err = goa.MergeErrors(err, goa.MissingAttributeError(`type`, "resetCode"))
-	}
-	if ut.UserID == "" {
-		err = goa.MergeErrors(err, goa.MissingAttributeError(`type`, "userID"))
-	}
-	if ut.NewPassword == "" {
-		err = goa.MergeErrors(err, goa.MissingAttributeError(`type`, "newPassword"))
-	}
-	if ok := goa.ValidatePattern(`^.*[\w].*$`, ut.NewPassword); !ok {
-		err = goa.MergeErrors(err, goa.InvalidPatternError(`type.newPassword`, ut.NewPassword, `^.*[\w].*$`))
-	}
-	if utf8.RuneCountInString(ut.NewPassword) < 6 {
-		err = goa.MergeErrors(err, goa.InvalidLengthError(`type.newPassword`, ut.NewPassword, utf8.RuneCountInString(ut.NewPassword), 6, true))
-	}
-	if utf8.RuneCountInString(ut.NewPassword) > 100 {
-		err = goa.MergeErrors(err, goa.InvalidLengthError(`type.newPassword`, ut.NewPassword, utf8.RuneCountInString(ut.NewPassword), 100, false))
+// Validate validates the TokenParams type instance.
+func (ut *TokenParams) Validate() (err error) {
+	if ut.Token == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`type`, "token"))
 	}
 	return
 }
@@ -1319,6 +1324,173 @@ func (ut *TwitterRegisterParams) Validate() (err error) {
 	return
 }
 
+// twoFactorPasscodeParams user type.
+type twoFactorPasscodeParams struct {
+	// The current passcode from the authenticator app
+	Passcode *string `form:"passcode,omitempty" json:"passcode,omitempty" yaml:"passcode,omitempty" xml:"passcode,omitempty"`
+}
+
+// Validate validates the twoFactorPasscodeParams type instance.
+func (ut *twoFactorPasscodeParams) Validate() (err error) {
+	if ut.Passcode == nil {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "passcode"))
+	}
+	if ut.Passcode != nil {
+		if utf8.RuneCountInString(*ut.Passcode) < 6 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError(`request.passcode`, *ut.Passcode, utf8.RuneCountInString(*ut.Passcode), 6, true))
+		}
+	}
+	if ut.Passcode != nil {
+		if utf8.RuneCountInString(*ut.Passcode) > 8 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError(`request.passcode`, *ut.Passcode, utf8.RuneCountInString(*ut.Passcode), 8, false))
+		}
+	}
+	return
+}
+
+// Publicize creates TwoFactorPasscodeParams from twoFactorPasscodeParams
+func (ut *twoFactorPasscodeParams) Publicize() *TwoFactorPasscodeParams {
+	var pub TwoFactorPasscodeParams
+	if ut.Passcode != nil {
+		pub.Passcode = *ut.Passcode
+	}
+	return &pub
+}
+
+// TwoFactorPasscodeParams user type.
+type TwoFactorPasscodeParams struct {
+	// The current passcode from the authenticator app
+	Passcode string `form:"passcode" json:"passcode" yaml:"passcode" xml:"passcode"`
+}
+
+// Validate validates the TwoFactorPasscodeParams type instance.
+func (ut *TwoFactorPasscodeParams) Validate() (err error) {
+	if ut.Passcode == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`type`, "passcode"))
+	}
+	if utf8.RuneCountInString(ut.Passcode) < 6 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError(`type.passcode`, ut.Passcode, utf8.RuneCountInString(ut.Passcode), 6, true))
+	}
+	if utf8.RuneCountInString(ut.Passcode) > 8 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError(`type.passcode`, ut.Passcode, utf8.RuneCountInString(ut.Passcode), 8, false))
+	}
+	return
+}
+
+// twoFactorVerifyParams user type.
+type twoFactorVerifyParams struct {
+	// The challenge returned from logging in
+	Challenge *uuid.UUID `form:"challenge,omitempty" json:"challenge,omitempty" yaml:"challenge,omitempty" xml:"challenge,omitempty"`
+	// The current passcode from the authenticator app, a code texted to the user, or one of the user's recovery codes
+	Passcode *string `form:"passcode,omitempty" json:"passcode,omitempty" yaml:"passcode,omitempty" xml:"passcode,omitempty"`
+}
+
+// Validate validates the twoFactorVerifyParams type instance.
+func (ut *twoFactorVerifyParams) Validate() (err error) {
+	if ut.Challenge == nil {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "challenge"))
+	}
+	if ut.Passcode == nil {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "passcode"))
+	}
+	if ut.Passcode != nil {
+		if utf8.RuneCountInString(*ut.Passcode) < 6 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError(`request.passcode`, *ut.Passcode, utf8.RuneCountInString(*ut.Passcode), 6, true))
+		}
+	}
+	if ut.Passcode != nil {
+		if utf8.RuneCountInString(*ut.Passcode) > 20 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError(`request.passcode`, *ut.Passcode, utf8.RuneCountInString(*ut.Passcode), 20, false))
+		}
+	}
+	return
+}
+
+// Publicize creates TwoFactorVerifyParams from twoFactorVerifyParams
+func (ut *twoFactorVerifyParams) Publicize() *TwoFactorVerifyParams {
+	var pub TwoFactorVerifyParams
+	if ut.Challenge != nil {
+		pub.Challenge = *ut.Challenge
+	}
+	if ut.Passcode != nil {
+		pub.Passcode = *ut.Passcode
+	}
+	return &pub
+}
+
+// TwoFactorVerifyParams user type.
+type TwoFactorVerifyParams struct {
+	// The challenge returned from logging in
+	Challenge uuid.UUID `form:"challenge" json:"challenge" yaml:"challenge" xml:"challenge"`
+	// The current passcode from the authenticator app, a code texted to the user, or one of the user's recovery codes
+	Passcode string `form:"passcode" json:"passcode" yaml:"passcode" xml:"passcode"`
+}
+
+// Validate validates the TwoFactorVerifyParams type instance.
+func (ut *TwoFactorVerifyParams) Validate() (err error) {
+
+	if ut.Passcode == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`type`, "passcode"))
+	}
+	if utf8.RuneCountInString(ut.Passcode) < 6 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError(`type.passcode`, ut.Passcode, utf8.RuneCountInString(ut.Passcode), 6, true))
+	}
+	if utf8.RuneCountInString(ut.Passcode) > 20 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError(`type.passcode`, ut.Passcode, utf8.RuneCountInString(ut.Passcode), 20, false))
+	}
+	return
+}
+
+// unlockParams user type.
+type unlockParams struct {
+	// The unlock token sent to the user's email when their account was locked
+	Token *string `form:"token,omitempty" json:"token,omitempty" yaml:"token,omitempty" xml:"token,omitempty"`
+	// The ID of the locked user
+	UserID *string `form:"userID,omitempty" json:"userID,omitempty" yaml:"userID,omitempty" xml:"userID,omitempty"`
+}
+
+// Validate validates the unlockParams type instance.
+func (ut *unlockParams) Validate() (err error) {
+	if ut.UserID == nil {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "userID"))
+	}
+	if ut.Token == nil {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "token"))
+	}
+	return
+}
+
+// Publicize creates UnlockParams from unlockParams
+func (ut *unlockParams) Publicize() *UnlockParams {
+	var pub UnlockParams
+	if ut.Token != nil {
+		pub.Token = *ut.Token
+	}
+	if ut.UserID != nil {
+		pub.UserID = *ut.UserID
+	}
+	return &pub
+}
+
+// UnlockParams user type.
+type UnlockParams struct {
+	// The unlock token sent to the user's email when their account was locked
+	Token string `form:"token" json:"token" yaml:"token" xml:"token"`
+	// The ID of the locked user
+	UserID string `form:"userID" json:"userID" yaml:"userID" xml:"userID"`
+}
+
+// Validate validates the UnlockParams type instance.
+func (ut *UnlockParams) Validate() (err error) {
+	if ut.UserID == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`type`, "userID"))
+	}
+	if ut.Token == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`type`, "token"))
+	}
+	return
+}
+
 // userParams user type.
 type userParams struct {
 	// Category/Categories that a user might select (User interests)
@@ -1519,3 +1691,113 @@ type UserPlugin struct {
 	PermissionsAllowed []string `form:"permissionsAllowed,omitempty" json:"permissionsAllowed,omitempty" yaml:"permissionsAllowed,omitempty" xml:"permissionsAllowed,omitempty"`
 	PluginID           *string  `form:"pluginID,omitempty" json:"pluginID,omitempty" yaml:"pluginID,omitempty" xml:"pluginID,omitempty"`
 }
+
+// webauthnFinishParams user type.
+type webauthnFinishParams struct {
+	// The ceremony ID returned when the ceremony was started
+	Ceremony *uuid.UUID `form:"ceremony,omitempty" json:"ceremony,omitempty" yaml:"ceremony,omitempty" xml:"ceremony,omitempty"`
+	// The PublicKeyCredential returned by the browser
+	Credential interface{} `form:"credential,omitempty" json:"credential,omitempty" yaml:"credential,omitempty" xml:"credential,omitempty"`
+	// A name for the authenticator, only used when registering
+	Name *string `form:"name,omitempty" json:"name,omitempty" yaml:"name,omitempty" xml:"name,omitempty"`
+}
+
+// Validate validates the webauthnFinishParams type instance.
+func (ut *webauthnFinishParams) Validate() (err error) {
+	if ut.Ceremony == nil {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "ceremony"))
+	}
+	if ut.Credential == nil {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "credential"))
+	}
+	if ut.Name != nil {
+		if utf8.RuneCountInString(*ut.Name) > 50 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError(`request.name`, *ut.Name, utf8.RuneCountInString(*ut.Name), 50, false))
+		}
+	}
+	return
+}
+
+// Publicize creates WebauthnFinishParams from webauthnFinishParams
+func (ut *webauthnFinishParams) Publicize() *WebauthnFinishParams {
+	var pub WebauthnFinishParams
+	if ut.Ceremony != nil {
+		pub.Ceremony = *ut.Ceremony
+	}
+	if ut.Credential != nil {
+		pub.Credential = ut.Credential
+	}
+	if ut.Name != nil {
+		pub.Name = ut.Name
+	}
+	return &pub
+}
+
+// WebauthnFinishParams user type.
+type WebauthnFinishParams struct {
+	// The ceremony ID returned when the ceremony was started
+	Ceremony uuid.UUID `form:"ceremony" json:"ceremony" yaml:"ceremony" xml:"ceremony"`
+	// The PublicKeyCredential returned by the browser
+	Credential interface{} `form:"credential" json:"credential" yaml:"credential" xml:"credential"`
+	// A name for the authenticator, only used when registering
+	Name *string `form:"name,omitempty" json:"name,omitempty" yaml:"name,omitempty" xml:"name,omitempty"`
+}
+
+// Validate validates the WebauthnFinishParams type instance.
+func (ut *WebauthnFinishParams) Validate() (err error) {
+
+	if ut.Credential == nil {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`type`, "credential"))
+	}
+	if ut.Name != nil {
+		if utf8.RuneCountInString(*ut.Name) > 50 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError(`type.name`, *ut.Name, utf8.RuneCountInString(*ut.Name), 50, false))
+		}
+	}
+	return
+}
+
+// webauthnLoginParams user type.
+type webauthnLoginParams struct {
+	// The email address of the account to login to
+	Email *string `form:"email,omitempty" json:"email,omitempty" yaml:"email,omitempty" xml:"email,omitempty"`
+}
+
+// Validate validates the webauthnLoginParams type instance.
+func (ut *webauthnLoginParams) Validate() (err error) {
+	if ut.Email == nil {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`request`, "email"))
+	}
+	if ut.Email != nil {
+		if err2 := goa.ValidateFormat(goa.FormatEmail, *ut.Email); err2 != nil {
+			err = goa.MergeErrors(err, goa.InvalidFormatError(`request.email`, *ut.Email, goa.FormatEmail, err2))
+		}
+	}
+	return
+}
+
+// Publicize creates WebauthnLoginParams from webauthnLoginParams
+func (ut *webauthnLoginParams) Publicize() *WebauthnLoginParams {
+	var pub WebauthnLoginParams
+	if ut.Email != nil {
+		pub.Email = *ut.Email
+	}
+	return &pub
+}
+
+// WebauthnLoginParams user type.
+type WebauthnLoginParams struct {
+	// The email address of the account to login to
+	Email string `form:"email" json:"email" yaml:"email" xml:"email"`
+}
+
+// Validate validates the WebauthnLoginParams type instance.
+func (ut *WebauthnLoginParams) Validate() (err error) {
+	if ut.Email == "" {
+		err = goa.MergeErrors(err, goa.MissingAttributeError(`type`, "email"))
+	}
+	if err2 := goa.ValidateFormat(goa.FormatEmail, ut.Email); err2 != nil {
+		err = goa.MergeErrors(err, goa.InvalidFormatError(`type.email`, ut.Email, goa.FormatEmail, err2))
+	}
+	return
+}
@@ -28,7 +28,7 @@ func (c *BookmarkController) AddPost(ctx *app.AddPostBookmarkContext) error {
 	bookmark := database.AppBookmarkToBookmark(ctx.Payload)
 	bookmark.Type = "post"
 
-	if err := database.AddBookmark(c.GetUserID(ctx.Request), bookmark); err != nil {
+	if err := database.AddBookmark(c.Claims(ctx).UserID(), bookmark); err != nil {
 		return ctx.InternalServerError(goa.ErrInternal(err))
 	}
 
@@ -44,7 +44,7 @@ func (c *BookmarkController) AddVideo(ctx *app.AddVideoBookmarkContext) error {
 	bookmark := database.AppBookmarkToBookmark(ctx.Payload)
 	bookmark.Type = "video"
 
-	if err := database.AddBookmark(c.GetUserID(ctx.Request), bookmark); err != nil {
+	if err := database.AddBookmark(c.Claims(ctx).UserID(), bookmark); err != nil {
 		return ctx.InternalServerError(goa.ErrInternal(err))
 	}
 
@@ -57,7 +57,7 @@ func (c *BookmarkController) AddVideo(ctx *app.AddVideoBookmarkContext) error {
 func (c *BookmarkController) GetBookmarks(ctx *app.GetBookmarksBookmarkContext) error {
 	// BookmarkController_GetBookmarks: start_implement
 
-	b, err := database.GetAllBookmarks(c.GetUserID(ctx.Request))
+	b, err := database.GetAllBookmarks(c.Claims(ctx).UserID())
 	if err != nil {
 		return ctx.InternalServerError(goa.ErrInternal(err))
 	}
@@ -73,7 +73,7 @@ func (c *BookmarkController) GetBookmarks(ctx *app.GetBookmarksBookmarkContext)
 func (c *BookmarkController) GetPostBookmarks(ctx *app.GetPostBookmarksBookmarkContext) error {
 	// BookmarkController_GetPostBookmarks: start_implement
 
-	b, err := database.GetPostBookmarks(c.GetUserID(ctx.Request))
+	b, err := database.GetPostBookmarks(c.Claims(ctx).UserID())
 	if err != nil {
 		return ctx.InternalServerError(goa.ErrInternal(err))
 	}
@@ -89,7 +89,7 @@ func (c *BookmarkController) GetPostBookmarks(ctx *app.GetPostBookmarksBookmarkC
 func (c *BookmarkController) GetVideoBookmarks(ctx *app.GetVideoBookmarksBookmarkContext) error {
 	// BookmarkController_GetVideoBookmarks: start_implement
 
-	b, err := database.GetVideoBookmarks(c.GetUserID(ctx.Request))
+	b, err := database.GetVideoBookmarks(c.Claims(ctx).UserID())
 	if err != nil {
 		return ctx.InternalServerError(goa.ErrInternal(err))
 	}
@@ -105,7 +105,7 @@ func (c *BookmarkController) GetVideoBookmarks(ctx *app.GetVideoBookmarksBookmar
 func (c *BookmarkController) RemoveFromBookmark(ctx *app.RemoveFromBookmarkBookmarkContext) error {
 	// BookmarkController_RemoveFromBookmark: start_implement
 
-	if err := database.RemoveFromBookmark(c.GetUserID(ctx.Request), *ctx.ID); err != nil {
+	if err := database.RemoveFromBookmark(c.Claims(ctx).UserID(), *ctx.ID); err != nil {
 		return ctx.BadRequest(goa.ErrBadRequest(err))
 	}
 
@@ -0,0 +1,258 @@
+package auth
+
+import (
+	"gigglesearch.org/giggle-auth/auth/app"
+	"gigglesearch.org/giggle-auth/utils/auth"
+	"github.com/goadesign/goa"
+	"net"
+	"net/url"
+)
+
+// ClientController implements the client resource.
+type ClientController struct {
+	*goa.Controller
+	auth.JWTSecurity
+}
+
+// NewClientController creates a client controller.
+func NewClientController(service *goa.Service, jwtSec auth.JWTSecurity) *ClientController {
+	return &ClientController{
+		Controller:  service.NewController("ClientController"),
+		JWTSecurity: jwtSec,
+	}
+}
+
+// List runs the list action.
+func (c *ClientController) List(ctx *app.ListClientContext) error {
+	// ClientController_List: start_implement
+
+	if !c.Claims(ctx).HasAdminAccess() {
+		return ctx.Forbidden(goa.ErrUnauthorized("user is not admin"))
+	}
+
+	clients, err := auth.GetAllClients()
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	res := app.OauthClientCollection{}
+	for i := range clients {
+		res = append(res, clientToOauthClient(&clients[i], ""))
+	}
+
+	return ctx.OK(res)
+
+	// ClientController_List: end_implement
+}
+
+// Get runs the get action.
+func (c *ClientController) Get(ctx *app.GetClientContext) error {
+	// ClientController_Get: start_implement
+
+	if !c.Claims(ctx).HasAdminAccess() {
+		return ctx.Forbidden(goa.ErrUnauthorized("user is not admin"))
+	}
+
+	client, err := auth.GetClient(ctx.ClientID)
+	if err == auth.ErrClientNotFound {
+		return ctx.NotFound(goa.ErrNotFound("Client not found"))
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	return ctx.OK(clientToOauthClient(client, ""))
+
+	// ClientController_Get: end_implement
+}
+
+// Create runs the create action.
+func (c *ClientController) Create(ctx *app.CreateClientContext) error {
+	// ClientController_Create: start_implement
+
+	if !c.Claims(ctx).HasAdminAccess() {
+		return ctx.Forbidden(goa.ErrUnauthorized("user is not admin"))
+	}
+
+	confidential := ctx.Payload.Confidential != nil && *ctx.Payload.Confidential
+	client := &auth.Client{}
+	if err := applyClientParams(client, ctx.Payload, confidential); err != nil {
+		return ctx.BadRequest(err)
+	}
+
+	secret, err := auth.CreateClient(client, confidential)
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	return ctx.Created(clientToOauthClient(client, secret))
+
+	// ClientController_Create: end_implement
+}
+
+// Update runs the update action.
+func (c *ClientController) Update(ctx *app.UpdateClientContext) error {
+	// ClientController_Update: start_implement
+
+	if !c.Claims(ctx).HasAdminAccess() {
+		return ctx.Forbidden(goa.ErrUnauthorized("user is not admin"))
+	}
+
+	client, err := auth.GetClient(ctx.ClientID)
+	if err == auth.ErrClientNotFound {
+		return ctx.NotFound(goa.ErrNotFound("Client not found"))
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	if err := applyClientParams(client, ctx.Payload, client.Confidential()); err != nil {
+		return ctx.BadRequest(err)
+	}
+
+	err = auth.UpdateClient(client)
+	if err == auth.ErrClientNotFound {
+		return ctx.NotFound(goa.ErrNotFound("Client not found"))
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	return ctx.OK(clientToOauthClient(client, ""))
+
+	// ClientController_Update: end_implement
+}
+
+// RotateSecret runs the rotate-secret action.
+func (c *ClientController) RotateSecret(ctx *app.RotateSecretClientContext) error {
+	// ClientController_RotateSecret: start_implement
+
+	if !c.Claims(ctx).HasAdminAccess() {
+		return ctx.Forbidden(goa.ErrUnauthorized("user is not admin"))
+	}
+
+	client, err := auth.GetClient(ctx.ClientID)
+	if err == auth.ErrClientNotFound {
+		return ctx.NotFound(goa.ErrNotFound("Client not found"))
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+	// Public clients can't keep a secret, so giving them one would only make it look like they are authenticated
+	if !client.Confidential() {
+		return ctx.BadRequest(goa.ErrBadRequest("Client is not confidential"))
+	}
+
+	secret, err := auth.RotateClientSecret(client.ID)
+	if err == auth.ErrClientNotFound {
+		return ctx.NotFound(goa.ErrNotFound("Client not found"))
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	return ctx.OK(clientToOauthClient(client, secret))
+
+	// ClientController_RotateSecret: end_implement
+}
+
+// Delete runs the delete action.
+func (c *ClientController) Delete(ctx *app.DeleteClientContext) error {
+	// ClientController_Delete: start_implement
+
+	if !c.Claims(ctx).HasAdminAccess() {
+		return ctx.Forbidden(goa.ErrUnauthorized("user is not admin"))
+	}
+
+	err := auth.DeleteClient(ctx.ClientID)
+	if err == auth.ErrClientNotFound {
+		return ctx.NotFound(goa.ErrNotFound("Client not found"))
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	return ctx.OK([]byte(""))
+
+	// ClientController_Delete: end_implement
+}
+
+// applyClientParams validates the payload and copies it to the client
+func applyClientParams(client *auth.Client, p *app.OauthClientParams, confidential bool) error {
+	for _, g := range p.GrantTypes {
+		if !containsString(grantTypes, g) {
+			return goa.ErrBadRequest("Unsupported grant type " + g)
+		}
+		// Service accounts are only authenticated by their secret
+		if g == auth.GrantClientCredentials && !confidential {
+			return goa.ErrBadRequest("Only confidential clients can use the client_credentials grant")
+		}
+	}
+	for _, uri := range p.RedirectURIs {
+		if !validRedirectURI(uri) {
+			return goa.ErrBadRequest("Invalid redirect URI " + uri)
+		}
+	}
+	thirdParty := p.ThirdParty != nil && *p.ThirdParty
+	if thirdParty && containsString(p.Scopes, auth.ApproveScope) {
+		return goa.ErrBadRequest("Third party clients can't be given the " + auth.ApproveScope + " scope")
+	}
+
+	client.Name = p.Name
+	client.LogoURL = optionalString(p.LogoURL)
+	client.RedirectURIs = p.RedirectURIs
+	client.GrantTypes = p.GrantTypes
+	client.Audience = optionalString(p.Audience)
+	client.Scopes = p.Scopes
+	client.ThirdParty = thirdParty
+	if client.RedirectURIs == nil {
+		client.RedirectURIs = []string{}
+	}
+	if client.GrantTypes == nil {
+		client.GrantTypes = []string{}
+	}
+	return nil
+}
+
+// validRedirectURI checks that a redirect URI is absolute and without a fragment. It has to use HTTPS unless
+// it is on the loopback interface, which is how native applications receive redirects.
+func validRedirectURI(uri string) bool {
+	u, err := url.Parse(uri)
+	if err != nil || u.Host == "" || u.Fragment != "" {
+		return false
+	}
+
+	switch u.Scheme {
+	case "https":
+		return true
+	case "http":
+		host := u.Hostname()
+		if host == "localhost" {
+			return true
+		}
+		ip := net.ParseIP(host)
+		return ip != nil && ip.IsLoopback()
+	default:
+		return false
+	}
+}
+
+func clientToOauthClient(client *auth.Client, secret string) *app.OauthClient {
+	res := &app.OauthClient{
+		ClientID:     client.ID,
+		Name:         client.Name,
+		LogoURL:      nonEmpty(client.LogoURL),
+		RedirectURIs: client.RedirectURIs,
+		GrantTypes:   client.GrantTypes,
+		Audience:     client.TokenAudience(),
+		Scopes:       client.TokenScopes(),
+		Confidential: client.Confidential(),
+		ThirdParty:   client.ThirdParty,
+		TimeCreated:  client.TimeCreated,
+		ClientSecret: nonEmpty(secret),
+	}
+	if res.RedirectURIs == nil {
+		res.RedirectURIs = []string{}
+	}
+	if res.GrantTypes == nil {
+		res.GrantTypes = []string{}
+	}
+	if res.Scopes == nil {
+		res.Scopes = []string{}
+	}
+	return res
+}
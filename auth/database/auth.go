@@ -22,18 +22,27 @@ type ResetPassword struct {
 	UserID string `bson:"user_id"`
 }
 
+// PasswordLogin is the only place a user's password hash is stored, the hashes are never serialized
 type PasswordLogin struct {
 	ID bson.ObjectId `bson:"_id,omitempty"`
 
 	Email string `bson:"email"`
 
-	Password string `bson:"password"`
+	Password string `json:"-" bson:"password"`
 
-	Recovery string `bson:"recovery"`
+	// Hashes of the account's previous passwords, newest first
+	History []string `json:"-" bson:"history"`
+
+	// Hashes of the unused recovery codes for the account
+	Recovery []string `json:"-" bson:"recovery"`
 
 	UserID string `bson:"user_id"`
 }
 
+func CreatePasswordLogin(ctx context.Context, newPasswordLogin *PasswordLogin) error {
+	return models.PasswordLoginCollection.Insert(newPasswordLogin)
+}
+
 func GetPasswordLogin(ctx context.Context, Email string) (*PasswordLogin, error) {
 	var t PasswordLogin
 
@@ -56,6 +65,40 @@ func UpdatePasswordLogin(ctx context.Context, updatedPasswordLogin *PasswordLogi
 	return nil
 }
 
+// UpdatePasswordLoginHash replaces the user's password hash with one for the same password, it is only
+// replaced if the password has not been changed since Old was read
+func UpdatePasswordLoginHash(ctx context.Context, UserID, Old, New string) error {
+	if err := models.PasswordLoginCollection.Update(bson.M{"user_id": UserID, "password": Old}, bson.M{"$set": bson.M{"password": New}}); err == mgo.ErrNotFound {
+		return ErrPasswordLoginNotFound
+	} else if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func UpdatePasswordLoginRecovery(ctx context.Context, UserID string, Recovery []string) error {
+	if err := models.PasswordLoginCollection.Update(bson.M{"user_id": UserID}, bson.M{"$set": bson.M{"recovery": Recovery}}); err == mgo.ErrNotFound {
+		return ErrPasswordLoginNotFound
+	} else if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UsePasswordLoginRecovery removes a recovery code from the user's account, ErrPasswordLoginNotFound is returned if
+// the user does not have that code. The code is removed atomically so it can only be used once.
+func UsePasswordLoginRecovery(ctx context.Context, UserID, Recovery string) error {
+	if err := models.PasswordLoginCollection.Update(bson.M{"user_id": UserID, "recovery": Recovery}, bson.M{"$pull": bson.M{"recovery": Recovery}}); err == mgo.ErrNotFound {
+		return ErrPasswordLoginNotFound
+	} else if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func DeletePasswordLogin(ctx context.Context, Email string) error {
 	if err := models.PasswordLoginCollection.Remove(bson.M{"email": Email}); err == mgo.ErrNotFound {
 		return ErrPasswordLoginNotFound
@@ -66,10 +109,22 @@ func DeletePasswordLogin(ctx context.Context, Email string) error {
 	return nil
 }
 
+// DeletePasswordLoginsUser removes the user's password login along with their recovery codes
+func DeletePasswordLoginsUser(ctx context.Context, UserID string) (int, error) {
+	info, err := models.PasswordLoginCollection.RemoveAll(bson.M{"user_id": UserID})
+	if err != nil {
+		return 0, err
+	}
+
+	return info.Removed, nil
+}
+
+// QueryPasswordLoginFromID finds the user's password login. Accounts without a password can still have an entry
+// holding their recovery codes, those are not found since the user can't login with a password.
 func QueryPasswordLoginFromID(ctx context.Context, UserID string) (string, error) {
 	var pl PasswordLogin
 
-	if err := models.UsersCollection.Find(bson.M{"user_id": UserID}).One(&pl); err == mgo.ErrNotFound {
+	if err := models.PasswordLoginCollection.Find(bson.M{"user_id": UserID, "password": bson.M{"$ne": ""}}).One(&pl); err == mgo.ErrNotFound {
 		return "", ErrPasswordLoginNotFound
 	} else if err != nil {
 		return "", err
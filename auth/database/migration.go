@@ -0,0 +1,95 @@
+package database
+
+import (
+	"context"
+	"gigglesearch.org/giggle-auth/auth/models"
+	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
+	"time"
+)
+
+// Migration records a one-time change to the data that has already been made
+type Migration struct {
+	ID string `bson:"id"`
+
+	TimeApplied time.Time `bson:"time_applied"`
+}
+
+func IsMigrationApplied(ctx context.Context, ID string) (bool, error) {
+	if err := models.MigrationCollection.Find(bson.M{"id": ID}).One(&Migration{}); err == mgo.ErrNotFound {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func SetMigrationApplied(ctx context.Context, ID string) error {
+	_, err := models.MigrationCollection.Upsert(bson.M{"id": ID}, &Migration{ID: ID, TimeApplied: time.Now()})
+	return err
+}
+
+// StripUserPasswords removes the copy of the password hash that used to be stored on user documents,
+// the password-login collection is the only place it is kept. Returns how many users were changed.
+func StripUserPasswords(ctx context.Context) (int, error) {
+	info, err := models.UsersCollection.UpdateAll(bson.M{"password": bson.M{"$exists": true}}, bson.M{"$unset": bson.M{"password": ""}})
+	if err != nil {
+		return 0, err
+	}
+
+	return info.Updated, nil
+}
+
+// ImportSocialAccounts copies the accounts of a provider from the collection it used to have into the
+// social-account collection, idField is the field the accounts were identified by. Accounts that were already
+// copied are skipped. Returns how many accounts were copied.
+func ImportSocialAccounts(ctx context.Context, provider, collection, idField string) (int, error) {
+	var accounts []bson.M
+	if err := models.SocialAccountCollection.Database.C(collection).Find(nil).All(&accounts); err != nil {
+		return 0, err
+	}
+
+	n := 0
+	for _, a := range accounts {
+		providerID, _ := a[idField].(string)
+		userID, _ := a["user_id"].(string)
+		if providerID == "" || userID == "" {
+			continue
+		}
+
+		info, err := models.SocialAccountCollection.Upsert(bson.M{"provider": provider, "provider_id": providerID}, bson.M{
+			"$setOnInsert": &SocialAccount{
+				Provider:   provider,
+				ProviderID: providerID,
+				UserID:     userID,
+			},
+		})
+		if err != nil {
+			return n, err
+		}
+		if info.UpsertedId != nil {
+			n++
+		}
+	}
+	return n, nil
+}
+
+// MarkLegacySocialAccounts copies the ID of the provider's accounts to the legacy ID, which can be used to find
+// them until Expires, for when the provider's accounts are identified by another claim. Only IDs that are emails
+// are copied, which the new claims never are. Returns how many accounts were changed.
+func MarkLegacySocialAccounts(ctx context.Context, provider string, Expires time.Time) (int, error) {
+	var accounts []SocialAccount
+	if err := models.SocialAccountCollection.Find(bson.M{"provider": provider, "provider_id": bson.M{"$regex": "@"}, "legacy_id": bson.M{"$exists": false}}).All(&accounts); err != nil {
+		return 0, err
+	}
+
+	n := 0
+	for _, a := range accounts {
+		if err := models.SocialAccountCollection.UpdateId(a.ID, bson.M{"$set": bson.M{"legacy_id": a.ProviderID, "legacy_expires": Expires}}); err != nil {
+			return n, err
+		}
+		n++
+	}
+	return n, nil
+}
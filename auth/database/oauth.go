@@ -0,0 +1,104 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"gigglesearch.org/giggle-auth/auth/models"
+	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
+	"github.com/gofrs/uuid"
+	"time"
+)
+
+var ErrAuthorizationRequestNotFound = errors.New("No AuthorizationRequest found in the database")
+
+// AuthorizationRequest is an OpenID Connect authorization request, from when the client sends the user to
+// authorize until the client redeems the code it was given
+type AuthorizationRequest struct {
+	ClientID string `bson:"client_id"`
+	// Hash of the authorization code, set when the user approves the request
+	Code string `bson:"code"`
+	// PKCE S256 challenge, the code can only be redeemed with its verifier
+	CodeChallenge string `bson:"code_challenge"`
+
+	ID uuid.UUID `bson:"id"`
+
+	Nonce string `bson:"nonce"`
+
+	RedirectURI string `bson:"redirect_uri"`
+	// Scopes the client will be granted
+	Scopes []string `bson:"scopes"`
+
+	State string `bson:"state"`
+	// Time the user approved the request
+	TimeApproved time.Time `bson:"time_approved"`
+
+	TimeExpire time.Time `bson:"time_expire"`
+	// ID of the user that approved the request
+	UserID string `bson:"user_id"`
+}
+
+func CreateAuthorizationRequest(ctx context.Context, newRequest *AuthorizationRequest) (ID uuid.UUID, err error) {
+	uid, err := uuid.NewV4()
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	newRequest.ID = uid
+
+	if err := models.AuthorizationRequestCollection.Insert(newRequest); err != nil {
+		return uuid.Nil, err
+	}
+
+	return uid, nil
+}
+
+func GetAuthorizationRequest(ctx context.Context, ID uuid.UUID) (*AuthorizationRequest, error) {
+	var ar AuthorizationRequest
+
+	if err := models.AuthorizationRequestCollection.Find(bson.M{"id": ID}).One(&ar); err == mgo.ErrNotFound {
+		return nil, ErrAuthorizationRequestNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &ar, nil
+}
+
+// ApproveAuthorizationRequest stores the code issued for a request, a request can only be approved once
+func ApproveAuthorizationRequest(ctx context.Context, ID uuid.UUID, UserID, Code string, Expire time.Time) error {
+	err := models.AuthorizationRequestCollection.Update(bson.M{"id": ID, "code": ""}, bson.M{
+		"$set": bson.M{
+			"user_id":       UserID,
+			"code":          Code,
+			"time_approved": time.Now(),
+			"time_expire":   Expire,
+		},
+	})
+	if err == mgo.ErrNotFound {
+		return ErrAuthorizationRequestNotFound
+	} else if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RedeemAuthorizationCode removes and returns the request a code was issued for, so the code can only be
+// redeemed once
+func RedeemAuthorizationCode(ctx context.Context, Code string) (*AuthorizationRequest, error) {
+	var ar AuthorizationRequest
+
+	if Code == "" {
+		return nil, ErrAuthorizationRequestNotFound
+	}
+
+	_, err := models.AuthorizationRequestCollection.Find(bson.M{"code": Code}).Apply(mgo.Change{Remove: true}, &ar)
+	if err == mgo.ErrNotFound {
+		return nil, ErrAuthorizationRequestNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &ar, nil
+}
@@ -0,0 +1,85 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"gigglesearch.org/giggle-auth/auth/models"
+	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
+	"time"
+)
+
+var ErrPhoneVerificationNotFound = errors.New("No PhoneVerification found in the database")
+
+// What a PhoneVerification code can be used for
+const (
+	PhoneCodeVerify    = "verify"
+	PhoneCodeLogin     = "login"
+	PhoneCodeTwoFactor = "two-factor"
+)
+
+// PhoneVerification is a one-time code texted to a user, only the hash of the code is stored
+type PhoneVerification struct {
+	Attempts int `bson:"attempts"`
+
+	Code string `bson:"code"`
+
+	Phone string `bson:"phone"`
+
+	Purpose string `bson:"purpose"`
+
+	TimeExpires time.Time `bson:"time_expires"`
+
+	UserID string `bson:"user_id"`
+}
+
+func CreatePhoneVerification(ctx context.Context, newPhoneVerification *PhoneVerification) error {
+	return models.PhoneVerificationCollection.Insert(newPhoneVerification)
+}
+
+func GetPhoneVerification(ctx context.Context, UserID, Purpose string) (*PhoneVerification, error) {
+	var pv PhoneVerification
+
+	if err := models.PhoneVerificationCollection.Find(bson.M{"user_id": UserID, "purpose": Purpose}).One(&pv); err == mgo.ErrNotFound {
+		return nil, ErrPhoneVerificationNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &pv, nil
+}
+
+// IncrementPhoneVerificationAttempts counts an attempt at the code, ErrPhoneVerificationNotFound is returned if it
+// already had Max attempts. The attempt is counted atomically so parallel attempts can't go past Max.
+func IncrementPhoneVerificationAttempts(ctx context.Context, UserID, Purpose string, Max int) error {
+	if err := models.PhoneVerificationCollection.Update(bson.M{"user_id": UserID, "purpose": Purpose, "attempts": bson.M{"$lt": Max}}, bson.M{"$inc": bson.M{"attempts": 1}}); err == mgo.ErrNotFound {
+		return ErrPhoneVerificationNotFound
+	} else if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UsePhoneVerification removes the code if it matches, returning ErrPhoneVerificationNotFound if it did
+// not. Only one request can remove the code so it can only be used once.
+func UsePhoneVerification(ctx context.Context, UserID, Purpose, Code string) error {
+	if err := models.PhoneVerificationCollection.Remove(bson.M{"user_id": UserID, "purpose": Purpose, "code": Code}); err == mgo.ErrNotFound {
+		return ErrPhoneVerificationNotFound
+	} else if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func DeletePhoneVerification(ctx context.Context, UserID, Purpose string) error {
+	_, err := models.PhoneVerificationCollection.RemoveAll(bson.M{"user_id": UserID, "purpose": Purpose})
+	return err
+}
+
+// DeletePhoneVerificationsByUserID removes every code the user has been sent, for when their number changes
+func DeletePhoneVerificationsByUserID(ctx context.Context, UserID string) error {
+	_, err := models.PhoneVerificationCollection.RemoveAll(bson.M{"user_id": UserID})
+	return err
+}
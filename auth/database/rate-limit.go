@@ -0,0 +1,68 @@
+package database
+
+import (
+	"context"
+	"gigglesearch.org/giggle-auth/auth/models"
+	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
+	"time"
+)
+
+// RateLimit counts how many times something keyed by Key has happened since the window started
+type RateLimit struct {
+	Count int `bson:"count"`
+
+	Key string `bson:"key"`
+
+	TimeExpire time.Time `bson:"time_expire"`
+}
+
+// IncrementRateLimit adds one to the count for Key and returns the new count. If the previous
+// window has expired a new one is started that lasts for Window.
+func IncrementRateLimit(ctx context.Context, Key string, Window time.Duration) (int, error) {
+	now := time.Now()
+
+	if _, err := models.RateLimitCollection.RemoveAll(bson.M{"key": Key, "time_expire": bson.M{"$lte": now}}); err != nil {
+		return 0, err
+	}
+
+	var rl RateLimit
+	change := mgo.Change{
+		Update:    bson.M{"$inc": bson.M{"count": 1}, "$setOnInsert": bson.M{"time_expire": now.Add(Window)}},
+		Upsert:    true,
+		ReturnNew: true,
+	}
+	_, err := models.RateLimitCollection.Find(bson.M{"key": Key}).Apply(change, &rl)
+	if mgo.IsDup(err) {
+		// Another request started the window at the same time, it is there to be incremented now
+		_, err = models.RateLimitCollection.Find(bson.M{"key": Key}).Apply(change, &rl)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return rl.Count, nil
+}
+
+// DecrementRateLimit takes one back from the count for Key, for attempts that turned out not to need counting
+func DecrementRateLimit(ctx context.Context, Key string) error {
+	err := models.RateLimitCollection.Update(bson.M{"key": Key, "count": bson.M{"$gt": 0}, "time_expire": bson.M{"$gt": time.Now()}}, bson.M{"$inc": bson.M{"count": -1}})
+	if err != nil && err != mgo.ErrNotFound {
+		return err
+	}
+
+	return nil
+}
+
+// GetRateLimit returns the current window for Key, if there is no current window an empty one is returned
+func GetRateLimit(ctx context.Context, Key string) (*RateLimit, error) {
+	var rl RateLimit
+
+	if err := models.RateLimitCollection.Find(bson.M{"key": Key, "time_expire": bson.M{"$gt": time.Now()}}).One(&rl); err == mgo.ErrNotFound {
+		return &RateLimit{Key: Key}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &rl, nil
+}
@@ -13,6 +13,8 @@ import (
 var ErrSessionNotFound = errors.New("No Session found in the database")
 
 type Session struct {
+	// Audience of the auth tokens issued to the session, empty for sessions created before tokens had one
+	Audience string `bson:"audience,omitempty"`
 	// The browser and browser version connected with this session
 	Browser string `bson:"browser"`
 	// The latitude and longitude of the last known location of the session
@@ -31,8 +33,14 @@ type Session struct {
 	LastUsed time.Time `bson:"last_used"`
 	// A human-readable string describing the last known location of the session
 	Location string `bson:"location"`
+	// OpenID Connect scopes the user approved for a third party client, they choose the claims userinfo returns
+	OIDCScopes []string `bson:"oidc_scopes,omitempty"`
 	// The OS of the system where this session was used
 	Os string `bson:"os"`
+	// ID of the session token that can be used to refresh the session, every refresh replaces it
+	RefreshTokenID string `bson:"refresh_token_id"`
+	// Scopes of the auth tokens issued to the session, from the client the user logged in through
+	Scopes []string `bson:"scopes,omitempty"`
 	// ID of the user this session is for
 	UserID string `bson:"user_id"`
 }
@@ -83,6 +91,25 @@ func UpdateSession(ctx context.Context, updatedSession *Session) error {
 	return nil
 }
 
+// RotateSession updates a session as long as its refresh token is still OldTokenID, so a session token
+// can only be used once even by concurrent requests
+func RotateSession(ctx context.Context, updatedSession *Session, OldTokenID string) error {
+	var old interface{} = OldTokenID
+	if OldTokenID == "" {
+		// Sessions created before tokens were rotated don't have the field
+		old = bson.M{"$in": []interface{}{"", nil}}
+	}
+
+	err := models.SessionsCollection.Update(bson.M{"_id": updatedSession.ID, "refresh_token_id": old}, updatedSession)
+	if err == mgo.ErrNotFound {
+		return ErrSessionNotFound
+	} else if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func DeleteSession(ctx context.Context, ID string) error {
 	if err := models.SessionsCollection.Remove(bson.M{"_id": bson.ObjectIdHex(ID)}); err == mgo.ErrNotFound {
 		return ErrSessionNotFound
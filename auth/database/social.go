@@ -0,0 +1,236 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"gigglesearch.org/giggle-auth/auth/models"
+	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
+	"github.com/gofrs/uuid"
+	"time"
+)
+
+var ErrSocialConnectionNotFound = errors.New("No SocialConnection found in the database")
+var ErrSocialAccountNotFound = errors.New("No SocialAccount found in the database")
+var ErrSocialAccountExists = errors.New("SocialAccount already exists in the database")
+var ErrSocialRegisterNotFound = errors.New("No SocialRegister found in the database")
+
+// SocialRegister is a registration that was approved by a provider, waiting for the user to fill in the
+// register page
+type SocialRegister struct {
+	ID uuid.UUID `bson:"id"`
+	// Name of the provider the user approved the registration with
+	Provider string `bson:"provider"`
+	// ID of the user's account with the provider
+	ProviderID string `bson:"provider_id"`
+	// Email from the account with the provider, the user's email is only verified when they keep it
+	Email string `bson:"email"`
+
+	TimeCreated time.Time `bson:"time_created"`
+}
+
+// SocialAccount links an account with a provider to a user, so the user can login with it
+type SocialAccount struct {
+	ID bson.ObjectId `bson:"_id,omitempty"`
+
+	Provider string `bson:"provider"`
+
+	ProviderID string `bson:"provider_id"`
+	// ID the account was attached with before the provider's accounts were identified by another claim, it is
+	// removed once the account is found by it
+	LegacyID string `bson:"legacy_id,omitempty"`
+	// After this the account is no longer found by its legacy ID, as the email it holds may belong to someone else
+	// by then
+	LegacyExpires time.Time `bson:"legacy_expires,omitempty"`
+
+	UserID string `bson:"user_id"`
+}
+
+// SocialConnection is a login, registration or attachment the user was sent to a provider for, the state
+// identifies it when the provider sends the user back
+type SocialConnection struct {
+	ID bson.ObjectId `bson:"_id,omitempty"`
+
+	MergeToken uuid.UUID `bson:"merge_token"`
+	// User that started an attachment, the attachment can only be completed by them
+	UserID string `bson:"user_id,omitempty"`
+	// Hash of the binding the front-end keeps in the browser that started the connection
+	Binding string `bson:"binding"`
+	// PKCE code verifier sent with the code
+	CodeVerifier string `bson:"code_verifier"`
+
+	Provider string `bson:"provider"`
+
+	Purpose int `bson:"purpose"`
+
+	State uuid.UUID `bson:"state"`
+	// Nonce the provider puts in the ID token, for providers that return one
+	Nonce string `bson:"nonce,omitempty"`
+	// JSON of the user the provider posted with the authorization response, Apple only sends the user's name
+	// this way and only the first time they sign in
+	User string `bson:"user,omitempty"`
+
+	TimeCreated time.Time `bson:"time_created"`
+	// Connections are removed by a TTL index once they expire
+	TimeExpires time.Time `bson:"time_expires"`
+}
+
+func CreateSocialConnection(ctx context.Context, newSocialConnection *SocialConnection) (State uuid.UUID, err error) {
+	uid, err := uuid.NewV4()
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	newSocialConnection.State = uid
+
+	if err := models.SocialConnectionCollection.Insert(newSocialConnection); err != nil {
+		return uuid.Nil, err
+	}
+
+	return uid, nil
+}
+
+func GetSocialConnection(ctx context.Context, Provider string, State uuid.UUID) (*SocialConnection, error) {
+	var sc SocialConnection
+
+	if err := models.SocialConnectionCollection.Find(bson.M{"provider": Provider, "state": State}).One(&sc); err == mgo.ErrNotFound {
+		return nil, ErrSocialConnectionNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &sc, nil
+}
+
+// SetSocialConnectionUser keeps the user the provider posted with the connection until the code is received
+func SetSocialConnectionUser(ctx context.Context, Provider string, State uuid.UUID, User string) error {
+	if err := models.SocialConnectionCollection.Update(bson.M{"provider": Provider, "state": State}, bson.M{"$set": bson.M{"user": User}}); err == mgo.ErrNotFound {
+		return ErrSocialConnectionNotFound
+	} else if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func DeleteSocialConnection(ctx context.Context, Provider string, State uuid.UUID) error {
+	return models.SocialConnectionCollection.Remove(bson.M{"provider": Provider, "state": State})
+}
+
+// CreateSocialAccount stores the account, ErrSocialAccountExists is returned if the provider's account is already
+// attached to a user
+func CreateSocialAccount(ctx context.Context, newSocialAccount *SocialAccount) (err error) {
+	if err := models.SocialAccountCollection.Insert(newSocialAccount); mgo.IsDup(err) {
+		return ErrSocialAccountExists
+	} else if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func GetSocialAccount(ctx context.Context, Provider, ProviderID string) (*SocialAccount, error) {
+	var sa SocialAccount
+
+	if err := models.SocialAccountCollection.Find(bson.M{"provider": Provider, "provider_id": ProviderID}).One(&sa); err == mgo.ErrNotFound {
+		return nil, ErrSocialAccountNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &sa, nil
+}
+
+// GetLegacySocialAccount finds an account by the ID it was attached with before the provider's accounts were
+// identified by another claim, until its legacy ID expires
+func GetLegacySocialAccount(ctx context.Context, Provider, LegacyID string) (*SocialAccount, error) {
+	var sa SocialAccount
+
+	if err := models.SocialAccountCollection.Find(bson.M{"provider": Provider, "legacy_id": LegacyID, "legacy_expires": bson.M{"$gt": time.Now()}}).One(&sa); err == mgo.ErrNotFound {
+		return nil, ErrSocialAccountNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &sa, nil
+}
+
+// UpdateSocialAccountID switches a legacy account to the ID the provider's accounts are identified by now
+func UpdateSocialAccountID(ctx context.Context, ID bson.ObjectId, ProviderID string) error {
+	if err := models.SocialAccountCollection.UpdateId(ID, bson.M{"$set": bson.M{"provider_id": ProviderID}, "$unset": bson.M{"legacy_id": "", "legacy_expires": ""}}); err == mgo.ErrNotFound {
+		return ErrSocialAccountNotFound
+	} else if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func DeleteSocialAccount(ctx context.Context, Provider, ProviderID string) error {
+	return models.SocialAccountCollection.Remove(bson.M{"provider": Provider, "provider_id": ProviderID})
+}
+
+// QuerySocialAccountUser returns the ID of the user's account with the provider
+func QuerySocialAccountUser(ctx context.Context, Provider, UserID string) (string, error) {
+	var sa SocialAccount
+
+	if err := models.SocialAccountCollection.Find(bson.M{"provider": Provider, "user_id": UserID}).One(&sa); err == mgo.ErrNotFound {
+		return "", ErrSocialAccountNotFound
+	} else if err != nil {
+		return "", err
+	}
+
+	return sa.ProviderID, nil
+}
+
+// QuerySocialAccountsUser returns every account with a provider that is attached to the user
+func QuerySocialAccountsUser(ctx context.Context, UserID string) ([]SocialAccount, error) {
+	var accounts []SocialAccount
+
+	if err := models.SocialAccountCollection.Find(bson.M{"user_id": UserID}).All(&accounts); err != nil {
+		return nil, err
+	}
+
+	return accounts, nil
+}
+
+// DeleteSocialAccountsUser detaches every account with a provider from the user, returning how many there were
+func DeleteSocialAccountsUser(ctx context.Context, UserID string) (int, error) {
+	info, err := models.SocialAccountCollection.RemoveAll(bson.M{"user_id": UserID})
+	if err != nil {
+		return 0, err
+	}
+
+	return info.Removed, nil
+}
+
+func CreateSocialRegister(ctx context.Context, newSocialRegister *SocialRegister) (ID uuid.UUID, err error) {
+	uid, err := uuid.NewV4()
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	newSocialRegister.ID = uid
+
+	if err := models.SocialRegisterCollection.Insert(newSocialRegister); err != nil {
+		return uuid.Nil, err
+	}
+
+	return uid, nil
+}
+
+func GetSocialRegister(ctx context.Context, Provider string, ID uuid.UUID) (*SocialRegister, error) {
+	var sr SocialRegister
+
+	if err := models.SocialRegisterCollection.Find(bson.M{"provider": Provider, "id": ID}).One(&sr); err == mgo.ErrNotFound {
+		return nil, ErrSocialRegisterNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &sr, nil
+}
+
+func DeleteSocialRegister(ctx context.Context, ID uuid.UUID) error {
+	return models.SocialRegisterCollection.Remove(bson.M{"id": ID})
+}
@@ -56,7 +56,13 @@ func GetLoginToken(ctx context.Context, Token uuid.UUID) (*LoginToken, error) {
 }
 
 func DeleteLoginToken(ctx context.Context, Token uuid.UUID) error {
-	return models.LoginTokenCollection.Remove(bson.M{"token": Token})
+	if err := models.LoginTokenCollection.Remove(bson.M{"token": Token}); err == mgo.ErrNotFound {
+		return ErrLoginTokenNotFound
+	} else if err != nil {
+		return err
+	}
+
+	return nil
 }
 
 func DeleteLoginTokenMulti(ctx context.Context, Tokens []uuid.UUID) error {
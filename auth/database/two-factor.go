@@ -0,0 +1,85 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"gigglesearch.org/giggle-auth/auth/models"
+	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
+	"github.com/gofrs/uuid"
+	"time"
+)
+
+var ErrTwoFactorChallengeNotFound = errors.New("No Two Factor Challenge found in the database")
+
+// How the user logged in before being given a TwoFactorChallenge
+const (
+	FirstFactorPassword  = "password"
+	FirstFactorEmailCode = "email-code"
+	FirstFactorPhoneCode = "phone-code"
+	FirstFactorLoginLink = "login-link"
+	FirstFactorSocial    = "social"
+)
+
+type TwoFactorChallenge struct {
+	// Number of incorrect passcodes that have been tried against this challenge
+	Attempts int `bson:"attempts"`
+	// How the user logged in, a factor can't be used a second time to verify the challenge
+	FirstFactor string `bson:"first_factor"`
+	// Merge token that was passed to the login that created this challenge
+	MergeToken *uuid.UUID `bson:"merge_token,omitempty"`
+
+	TimeExpire time.Time `bson:"time_expire"`
+
+	Token uuid.UUID `bson:"token"`
+
+	UserID string `bson:"user_id"`
+}
+
+func CreateTwoFactorChallenge(ctx context.Context, newChallenge *TwoFactorChallenge) (Token uuid.UUID, err error) {
+	uid, err := uuid.NewV4()
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	newChallenge.Token = uid
+
+	if err := models.TwoFactorChallengeCollection.Insert(newChallenge); err != nil {
+		return uuid.Nil, err
+	}
+
+	return uid, nil
+}
+
+func GetTwoFactorChallenge(ctx context.Context, Token uuid.UUID) (*TwoFactorChallenge, error) {
+	var tc TwoFactorChallenge
+
+	if err := models.TwoFactorChallengeCollection.Find(bson.M{"token": Token}).One(&tc); err == mgo.ErrNotFound {
+		return nil, ErrTwoFactorChallengeNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &tc, nil
+}
+
+func DeleteTwoFactorChallenge(ctx context.Context, Token uuid.UUID) error {
+	return models.TwoFactorChallengeCollection.Remove(bson.M{"token": Token})
+}
+
+// IncrementTwoFactorChallengeAttempts counts an attempt at the challenge, ErrTwoFactorChallengeNotFound is returned
+// if it already had Max attempts. The attempt is counted atomically so parallel attempts can't go past Max.
+func IncrementTwoFactorChallengeAttempts(ctx context.Context, Token uuid.UUID, Max int) error {
+	if err := models.TwoFactorChallengeCollection.Update(bson.M{"token": Token, "attempts": bson.M{"$lt": Max}}, bson.M{"$inc": bson.M{"attempts": 1}}); err == mgo.ErrNotFound {
+		return ErrTwoFactorChallengeNotFound
+	} else if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func DeleteTwoFactorChallengeByUserID(ctx context.Context, UserID string) error {
+	_, err := models.TwoFactorChallengeCollection.RemoveAll(bson.M{"user_id": UserID})
+	return err
+}
@@ -14,25 +14,36 @@ import (
 var ErrUserNotFound = errors.New("No User found in the database")
 var ErrEmailVerificationNotFound = errors.New("No EmailVerification found in the database")
 
+// EmailVerification is either a link or a one-time code sent to an email address. Links are
+// found by ID, codes have an empty ID and store the hash of the code instead.
 type EmailVerification struct {
+	Attempts int `bson:"attempts"`
+
+	Code string `bson:"code"`
+
 	Email string `bson:"email"`
 
 	ID string `bson:"id"`
 
+	// Login is set for codes that login the user rather than verify their email
+	Login bool `bson:"login"`
+
 	TimeExpires time.Time `bson:"time_expires"`
 
 	UserID string `bson:"user_id"`
 }
 
+// CreateUser stores the user with a new ID, unless the ID was already set
 func CreateUser(ctx context.Context, newUser *models.User) (ID string, err error) {
-	var userID = bson.NewObjectId()
-	newUser.ID = userID
+	if newUser.ID == "" {
+		newUser.ID = bson.NewObjectId()
+	}
 
 	if err := models.UsersCollection.Insert(newUser); err != nil {
 		return "", err
 	}
 
-	return userID.Hex(), nil
+	return newUser.ID.Hex(), nil
 }
 
 func GetUser(ctx context.Context, ID string) (*models.User, error) {
@@ -92,6 +103,77 @@ func UpdateUser(ctx context.Context, updatedUser *models.User) error {
 	return nil
 }
 
+// StartUserLoginAttempt counts a login attempt as a failure before it is checked and returns the updated user. It
+// is only counted if the user has had fewer than MaxFailures failures, has waited Delay(n) since their last one
+// where n is how many they have had, and isn't locked unless IgnoreLock is set. ErrUserNotFound is returned
+// otherwise. The attempt is counted atomically so parallel attempts can't get past the wait or the lock.
+func StartUserLoginAttempt(ctx context.Context, ID string, MaxFailures int, Delay func(int) time.Duration, IgnoreLock bool) (*models.User, error) {
+	var t models.User
+	now := time.Now()
+
+	// Users from before failed logins were counted don't have the field
+	waited := []bson.M{{"failed_logins": bson.M{"$in": []interface{}{0, nil}}}}
+	for n := 1; n < MaxFailures; n++ {
+		w := bson.M{"failed_logins": n}
+		if d := Delay(n); d > 0 {
+			w["last_failed_login"] = bson.M{"$lte": now.Add(-d)}
+		}
+		waited = append(waited, w)
+	}
+	query := bson.M{"_id": bson.ObjectIdHex(ID), "$or": waited}
+	if !IgnoreLock {
+		query["locked_until"] = bson.M{"$not": bson.M{"$gt": now}}
+	}
+
+	change := mgo.Change{
+		Update:    bson.M{"$inc": bson.M{"failed_logins": 1}, "$set": bson.M{"last_failed_login": now}},
+		ReturnNew: true,
+	}
+	if _, err := models.UsersCollection.Find(query).Apply(change, &t); err == mgo.ErrNotFound {
+		return nil, ErrUserNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &t, nil
+}
+
+// UseUserTwoFactorStep records that a passcode for the TOTP time step Step was accepted, ErrUserNotFound is
+// returned if a passcode for it or a later step already was. It is updated atomically so a passcode can only be
+// used once.
+func UseUserTwoFactorStep(ctx context.Context, ID string, Step int64) error {
+	if err := models.UsersCollection.Update(bson.M{"_id": bson.ObjectIdHex(ID), "two_factor_step": bson.M{"$not": bson.M{"$gte": Step}}}, bson.M{"$set": bson.M{"two_factor_step": Step}}); err == mgo.ErrNotFound {
+		return ErrUserNotFound
+	} else if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// LockUser stops the user from logging in with a password until Until, UnlockToken is the hash of
+// the token that can be used to unlock the account early
+func LockUser(ctx context.Context, ID string, Until time.Time, UnlockToken string) error {
+	if err := models.UsersCollection.UpdateId(bson.ObjectIdHex(ID), bson.M{"$set": bson.M{"failed_logins": 0, "locked_until": Until, "unlock_token": UnlockToken}}); err == mgo.ErrNotFound {
+		return ErrUserNotFound
+	} else if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UnlockUser clears the user's failed logins and any lock on their account
+func UnlockUser(ctx context.Context, ID string) error {
+	if err := models.UsersCollection.UpdateId(bson.ObjectIdHex(ID), bson.M{"$set": bson.M{"failed_logins": 0, "locked_until": time.Time{}, "unlock_token": ""}}); err == mgo.ErrNotFound {
+		return ErrUserNotFound
+	} else if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func CreateEmailVerification(ctx context.Context, newEmailVerification *EmailVerification) error {
 	if err := models.EmailVerificationCollection.Insert(newEmailVerification); err != nil {
 		return err
@@ -121,7 +203,7 @@ func DeleteEmailVerification(ctx context.Context, ID string) error {
 func QueryEmailVerificationByUserID(ctx context.Context, UserID string) (string, error) {
 	var ev EmailVerification
 
-	if err := models.EmailVerificationCollection.Find(bson.M{"user_id": UserID}).One(&ev); err == mgo.ErrNotFound {
+	if err := models.EmailVerificationCollection.Find(bson.M{"user_id": UserID, "id": bson.M{"$ne": ""}}).One(&ev); err == mgo.ErrNotFound {
 		return "", ErrEmailVerificationNotFound
 	} else if err != nil {
 		return "", err
@@ -130,6 +212,48 @@ func QueryEmailVerificationByUserID(ctx context.Context, UserID string) (string,
 	return ev.ID, nil
 }
 
+// GetEmailVerificationCode gets the one-time code the user was last sent
+func GetEmailVerificationCode(ctx context.Context, UserID string, Login bool) (*EmailVerification, error) {
+	var ev EmailVerification
+
+	if err := models.EmailVerificationCollection.Find(bson.M{"user_id": UserID, "id": "", "login": Login}).One(&ev); err == mgo.ErrNotFound {
+		return nil, ErrEmailVerificationNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &ev, nil
+}
+
+// IncrementEmailVerificationCodeAttempts counts an attempt at the code, ErrEmailVerificationNotFound is returned if
+// it already had Max attempts. The attempt is counted atomically so parallel attempts can't go past Max.
+func IncrementEmailVerificationCodeAttempts(ctx context.Context, UserID string, Login bool, Max int) error {
+	if err := models.EmailVerificationCollection.Update(bson.M{"user_id": UserID, "id": "", "login": Login, "attempts": bson.M{"$lt": Max}}, bson.M{"$inc": bson.M{"attempts": 1}}); err == mgo.ErrNotFound {
+		return ErrEmailVerificationNotFound
+	} else if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UseEmailVerificationCode removes the code if it matches, returning ErrEmailVerificationNotFound if it
+// did not. Only one request can remove the code so it can only be used once.
+func UseEmailVerificationCode(ctx context.Context, UserID string, Login bool, Code string) error {
+	if err := models.EmailVerificationCollection.Remove(bson.M{"user_id": UserID, "id": "", "login": Login, "code": Code}); err == mgo.ErrNotFound {
+		return ErrEmailVerificationNotFound
+	} else if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func DeleteEmailVerificationCode(ctx context.Context, UserID string, Login bool) error {
+	_, err := models.EmailVerificationCollection.RemoveAll(bson.M{"user_id": UserID, "id": "", "login": Login})
+	return err
+}
+
 func QueryUserEmail(ctx context.Context, Email string) (*models.User, error) {
 	var user models.User
 
@@ -142,6 +266,19 @@ func QueryUserEmail(ctx context.Context, Email string) (*models.User, error) {
 	return &user, nil
 }
 
+// QueryUserPhone finds the user that has verified Phone
+func QueryUserPhone(ctx context.Context, Phone string) (*models.User, error) {
+	var user models.User
+
+	if err := models.UsersCollection.Find(bson.M{"phone": Phone, "verified_phone": true}).One(&user); err == mgo.ErrNotFound {
+		return nil, ErrUserNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &user, nil
+}
+
 func QueryPasswordLoginFromIDWithBody(ctx context.Context, UserID string) (*PasswordLogin, error) {
 	var pl PasswordLogin
 
@@ -154,12 +291,13 @@ func QueryPasswordLoginFromIDWithBody(ctx context.Context, UserID string) (*Pass
 	return &pl, nil
 }
 
-func UserFromFacebookRegisterParams(gen *app.FacebookRegisterParams) *models.User {
+// UserFromSocialRegisterParams creates the user, the email is verified when it is the one the provider gave
+func UserFromSocialRegisterParams(gen *app.SocialRegisterParams, verifiedEmail bool) *models.User {
 	s := &models.User{
 		Email:         gen.Email,
 		FirstName:     gen.FirstName,
 		LastName:      gen.LastName,
-		VerifiedEmail: true,
+		VerifiedEmail: verifiedEmail,
 		Category:      []string{},
 	}
 	return s
@@ -176,50 +314,6 @@ func UserFromTwitterRegisterParams(gen *app.TwitterRegisterParams) *models.User
 	return s
 }
 
-func UserFromGoogleRegisterParams(gen *app.GoogleRegisterParams) *models.User {
-	s := &models.User{
-		Email:         gen.Email,
-		FirstName:     gen.FirstName,
-		LastName:      gen.LastName,
-		VerifiedEmail: true,
-		Category:      []string{},
-	}
-	return s
-}
-
-func UserFromAmazonRegisterParams(gen *app.AmazonRegisterParams) *models.User {
-	s := &models.User{
-		Email:         gen.Email,
-		FirstName:     gen.FirstName,
-		LastName:      gen.LastName,
-		VerifiedEmail: true,
-		Category:      []string{},
-	}
-	return s
-}
-
-func UserFromMicrosoftRegisterParams(gen *app.MicrosoftRegisterParams) *models.User {
-	s := &models.User{
-		Email:         gen.Email,
-		FirstName:     gen.FirstName,
-		LastName:      gen.LastName,
-		VerifiedEmail: true,
-		Category:      []string{},
-	}
-	return s
-}
-
-func UserFromLinkedinRegisterParams(gen *app.LinkedinRegisterParams) *models.User {
-	s := &models.User{
-		Email:         gen.Email,
-		FirstName:     gen.FirstName,
-		LastName:      gen.LastName,
-		VerifiedEmail: true,
-		Category:      []string{},
-	}
-	return s
-}
-
 func UserFromUserParamsMerge(from *app.UserParams, to *models.User) *models.User {
 	if from.Email != nil {
 		to.Email = *from.Email
@@ -267,9 +361,14 @@ func UserToUserAdmin(gen *models.User) *app.UserAdmin {
 		IsAdmin:        gen.IsAdmin,
 		LastName:       gen.LastName,
 		VerifiedEmail:  gen.VerifiedEmail,
+		VerifiedPhone:  &gen.VerifiedPhone,
 		Category:       gen.Category,
 		IsPluginAuthor: gen.IsPluginAuthor,
 		IsEventAuthor:  &gen.IsEventAuthor,
+		FailedLogins:   &gen.FailedLogins,
+	}
+	if time.Now().Before(gen.LockedUntil) {
+		s.LockedUntil = &gen.LockedUntil
 	}
 	return s
 }
@@ -296,6 +395,7 @@ func UserToUserOwner(gen *models.User) *app.UserOwner {
 		ID:             gen.ID.Hex(),
 		LastName:       gen.LastName,
 		VerifiedEmail:  gen.VerifiedEmail,
+		VerifiedPhone:  &gen.VerifiedPhone,
 		Category:       gen.Category,
 		IsPluginAuthor: gen.IsPluginAuthor,
 		IsEventAuthor:  &gen.IsEventAuthor,
@@ -0,0 +1,177 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"gigglesearch.org/giggle-auth/auth/app"
+	"gigglesearch.org/giggle-auth/auth/models"
+	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
+	"github.com/gofrs/uuid"
+	"time"
+)
+
+var ErrWebauthnCredentialNotFound = errors.New("No WebauthnCredential found in the database")
+var ErrWebauthnSessionNotFound = errors.New("No WebauthnSession found in the database")
+
+type WebauthnCredential struct {
+	AAGUID []byte `bson:"aaguid"`
+
+	AttestationType string `bson:"attestation_type"`
+	// The credential ID generated by the authenticator
+	CredentialID []byte `bson:"credential_id"`
+
+	ID bson.ObjectId `bson:"_id,omitempty"`
+
+	LastUsed *time.Time `bson:"last_used,omitempty"`
+	// Name the user gave the authenticator
+	Name string `bson:"name"`
+
+	PublicKey []byte `bson:"public_key"`
+	// Signature counter, used to detect cloned authenticators
+	SignCount uint32 `bson:"sign_count"`
+
+	TimeCreated time.Time `bson:"time_created"`
+
+	UserID string `bson:"user_id"`
+}
+
+// WebauthnSession holds the challenge of a registration or login ceremony between its start and finish
+type WebauthnSession struct {
+	AllowedCredentialIDs [][]byte `bson:"allowed_credential_ids"`
+
+	Challenge string `bson:"challenge"`
+
+	ID uuid.UUID `bson:"id"`
+	// Whether the ceremony is a registration, otherwise it is a login
+	Register bool `bson:"register"`
+
+	TimeExpire time.Time `bson:"time_expire"`
+
+	UserID string `bson:"user_id"`
+
+	UserVerification string `bson:"user_verification"`
+}
+
+func CreateWebauthnCredential(ctx context.Context, newCredential *WebauthnCredential) (ID string, err error) {
+	newCredential.ID = bson.NewObjectId()
+
+	if err := models.WebauthnCredentialCollection.Insert(newCredential); err != nil {
+		return "", err
+	}
+
+	return newCredential.ID.Hex(), nil
+}
+
+func GetWebauthnCredential(ctx context.Context, ID string) (*WebauthnCredential, error) {
+	var wc WebauthnCredential
+
+	if !bson.IsObjectIdHex(ID) {
+		return nil, ErrWebauthnCredentialNotFound
+	}
+
+	if err := models.WebauthnCredentialCollection.FindId(bson.ObjectIdHex(ID)).One(&wc); err == mgo.ErrNotFound {
+		return nil, ErrWebauthnCredentialNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &wc, nil
+}
+
+func UpdateWebauthnCredential(ctx context.Context, updatedCredential *WebauthnCredential) error {
+	if err := models.WebauthnCredentialCollection.UpdateId(updatedCredential.ID, updatedCredential); err == mgo.ErrNotFound {
+		return ErrWebauthnCredentialNotFound
+	} else if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func DeleteWebauthnCredential(ctx context.Context, ID string) error {
+	if !bson.IsObjectIdHex(ID) {
+		return ErrWebauthnCredentialNotFound
+	}
+
+	if err := models.WebauthnCredentialCollection.RemoveId(bson.ObjectIdHex(ID)); err == mgo.ErrNotFound {
+		return ErrWebauthnCredentialNotFound
+	} else if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteWebauthnCredentialsUser removes every security key and passkey of the user
+func DeleteWebauthnCredentialsUser(ctx context.Context, UserID string) (int, error) {
+	info, err := models.WebauthnCredentialCollection.RemoveAll(bson.M{"user_id": UserID})
+	if err != nil {
+		return 0, err
+	}
+
+	return info.Removed, nil
+}
+
+func QueryWebauthnCredentials(ctx context.Context, UserID string) ([]*WebauthnCredential, error) {
+	var creds []*WebauthnCredential
+
+	if err := models.WebauthnCredentialCollection.Find(bson.M{"user_id": UserID}).All(&creds); err != nil {
+		return nil, err
+	}
+
+	return creds, nil
+}
+
+func QueryWebauthnCredentialUser(ctx context.Context, UserID string) (string, error) {
+	var wc WebauthnCredential
+
+	if err := models.WebauthnCredentialCollection.Find(bson.M{"user_id": UserID}).One(&wc); err == mgo.ErrNotFound {
+		return "", ErrWebauthnCredentialNotFound
+	} else if err != nil {
+		return "", err
+	}
+
+	return wc.ID.Hex(), nil
+}
+
+func CreateWebauthnSession(ctx context.Context, newSession *WebauthnSession) (ID uuid.UUID, err error) {
+	uid, err := uuid.NewV4()
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	newSession.ID = uid
+
+	if err := models.WebauthnSessionCollection.Insert(newSession); err != nil {
+		return uuid.Nil, err
+	}
+
+	return uid, nil
+}
+
+func GetWebauthnSession(ctx context.Context, ID uuid.UUID) (*WebauthnSession, error) {
+	var ws WebauthnSession
+
+	if err := models.WebauthnSessionCollection.Find(bson.M{"id": ID}).One(&ws); err == mgo.ErrNotFound {
+		return nil, ErrWebauthnSessionNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &ws, nil
+}
+
+func DeleteWebauthnSession(ctx context.Context, ID uuid.UUID) error {
+	return models.WebauthnSessionCollection.Remove(bson.M{"id": ID})
+}
+
+func WebauthnCredentialToWebauthnCredential(gen *WebauthnCredential) *app.WebauthnCredential {
+	s := &app.WebauthnCredential{
+		ID:          gen.ID.Hex(),
+		LastUsed:    gen.LastUsed,
+		Name:        gen.Name,
+		TimeCreated: gen.TimeCreated,
+	}
+	return s
+}
@@ -14,6 +14,13 @@ var _ = API("user", func() {
 		Methods("GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS") // Allow all origins to retrieve the Swagger JSON (CORS)
 	})
 	BasePath("/api/v1/user")
+	Consumes("application/json")
+	Consumes("application/xml")
+	Consumes("application/gob", "application/x-gob")
+	// Introspection and revocation requests are form encoded
+	Consumes("application/x-www-form-urlencoded", func() {
+		Package("github.com/goadesign/goa/encoding/form")
+	})
 	Security(APIKeySecurity("key", func() {
 		Description("API Key for users API")
 		Header("API-Key")
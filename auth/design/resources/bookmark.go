@@ -13,7 +13,9 @@ var _ = Resource("bookmark", func() {
 	Action("addVideo", func() {
 		Description("add a video to bookmarks")
 		Routing(PUT("/video"))
-		Security(JWTSec)
+		Security(JWTSec, func() {
+			Scope("profile")
+		})
 
 		Payload(BookmarkParams)
 
@@ -27,7 +29,9 @@ var _ = Resource("bookmark", func() {
 	Action("addPost", func() {
 		Description("add a post to bookmarks")
 		Routing(PUT("/post"))
-		Security(JWTSec)
+		Security(JWTSec, func() {
+			Scope("profile")
+		})
 
 		Payload(BookmarkParams)
 
@@ -41,7 +45,9 @@ var _ = Resource("bookmark", func() {
 	Action("removeFromBookmark", func() {
 		Description("remove a post or video from bookmarks")
 		Routing(DELETE(""))
-		Security(JWTSec)
+		Security(JWTSec, func() {
+			Scope("profile")
+		})
 
 		Params(func() {
 			Param("id", String, "ID of the resource to be deleted")
@@ -57,7 +63,9 @@ var _ = Resource("bookmark", func() {
 	Action("getBookmarks", func() {
 		Description("get all bookmarks")
 		Routing(GET(""))
-		Security(JWTSec)
+		Security(JWTSec, func() {
+			Scope("profile")
+		})
 
 		Response(OK, ArrayOf(BookmarkMedia))
 		Response(Unauthorized, ErrorMedia)
@@ -69,7 +77,9 @@ var _ = Resource("bookmark", func() {
 	Action("getPostBookmarks", func() {
 		Description("get all post bookmarks")
 		Routing(GET("/post"))
-		Security(JWTSec)
+		Security(JWTSec, func() {
+			Scope("profile")
+		})
 
 		Response(OK, ArrayOf(BookmarkMedia))
 		Response(Unauthorized, ErrorMedia)
@@ -81,7 +91,9 @@ var _ = Resource("bookmark", func() {
 	Action("getVideoBookmarks", func() {
 		Description("get all video bookmarks")
 		Routing(GET("/video"))
-		Security(JWTSec)
+		Security(JWTSec, func() {
+			Scope("profile")
+		})
 
 		Response(OK, ArrayOf(BookmarkMedia))
 		Response(Unauthorized, ErrorMedia)
@@ -0,0 +1,89 @@
+package resources
+
+import (
+	. "gigglesearch.org/giggle-auth/auth/design/types"
+	. "github.com/goadesign/goa/design"
+	. "github.com/goadesign/goa/design/apidsl"
+)
+
+var _ = Resource("client", func() {
+	BasePath("/clients")
+	Security(JWTSec, func() {
+		Scope("admin")
+	})
+
+	Action("list", func() {
+		Description("Lists the registered clients")
+		Routing(GET(""))
+
+		Response(OK, CollectionOf(OauthClientMedia))
+		Response(Forbidden, ErrorMedia)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("get", func() {
+		Description("Gets a registered client")
+		Routing(GET("/:clientID"))
+		Params(func() {
+			Param("clientID", String, "ID of the client")
+		})
+
+		Response(OK, OauthClientMedia)
+		Response(Forbidden, ErrorMedia)
+		Response(NotFound, ErrorMedia)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("create", func() {
+		Description("Registers a client, the secret of a confidential client is only returned by this request")
+		Routing(POST(""))
+		Payload(OauthClientParams)
+
+		Response(Created, OauthClientMedia)
+		Response(BadRequest, ErrorMedia)
+		Response(Forbidden, ErrorMedia)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("update", func() {
+		Description("Updates a registered client, whether it is confidential can't be changed")
+		Routing(PUT("/:clientID"))
+		Params(func() {
+			Param("clientID", String, "ID of the client")
+		})
+		Payload(OauthClientParams)
+
+		Response(OK, OauthClientMedia)
+		Response(BadRequest, ErrorMedia)
+		Response(Forbidden, ErrorMedia)
+		Response(NotFound, ErrorMedia)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("rotate-secret", func() {
+		Description("Replaces the secret of a confidential client, the old secret stops working straight away")
+		Routing(POST("/:clientID/secret"))
+		Params(func() {
+			Param("clientID", String, "ID of the client")
+		})
+
+		Response(OK, OauthClientMedia)
+		Response(BadRequest, ErrorMedia)
+		Response(Forbidden, ErrorMedia)
+		Response(NotFound, ErrorMedia)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("delete", func() {
+		Description("Removes a registered client, its API key stops working")
+		Routing(DELETE("/:clientID"))
+		Params(func() {
+			Param("clientID", String, "ID of the client")
+		})
+
+		Response(OK, "OK")
+		Response(Forbidden, ErrorMedia)
+		Response(NotFound, ErrorMedia)
+		Response(InternalServerError, ErrorMedia)
+	})
+})
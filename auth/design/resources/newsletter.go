@@ -12,7 +12,9 @@ var _ = Resource("newsletter", func() {
 	Action("get-subscribers", func() {
 		Description("Get All Subscribers")
 		Routing(GET("/all"))
-		Security(JWTSec)
+		Security(JWTSec, func() {
+			Scope("admin")
+		})
 
 		Response(OK, CollectionOf(NewsLetterSubscriber))
 		Response(NotFound, ErrorMedia)
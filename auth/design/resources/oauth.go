@@ -0,0 +1,86 @@
+package resources
+
+import (
+	. "gigglesearch.org/giggle-auth/auth/design/types"
+	. "github.com/goadesign/goa/design"
+	. "github.com/goadesign/goa/design/apidsl"
+)
+
+var _ = Resource("oauth", func() {
+	BasePath("/oauth")
+
+	Action("authorize", func() {
+		Description("OpenID Connect authorization endpoint, sends the browser to the login app with the request ID")
+		Routing(GET("/authorize"))
+		NoSecurity()
+		Params(func() {
+			Param("client_id", String)
+			Param("redirect_uri", String)
+			Param("response_type", String, "Only code is supported")
+			Param("scope", String, "Space separated scopes, must include openid")
+			Param("state", String)
+			Param("nonce", String)
+			Param("code_challenge", String, "PKCE challenge, required")
+			Param("code_challenge_method", String, "Only S256 is supported")
+			Required("client_id", "redirect_uri")
+		})
+
+		Response(Found, func() {
+			Headers(func() {
+				Header("Location")
+				Required("Location")
+			})
+		})
+		Response(BadRequest, ErrorMedia)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("get-request", func() {
+		Description("Get the application and scopes of an authorization request, for the login app to show the user")
+		Routing(GET("/requests/:requestID"))
+		Params(func() {
+			Param("requestID", UUID)
+		})
+
+		Response(OK, OauthRequestMedia)
+		Response(NotFound, ErrorMedia)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("approve-request", func() {
+		Description("Approve an authorization request as the logged in user, the browser is then sent to the returned URL")
+		Routing(POST("/requests/:requestID"))
+		Params(func() {
+			Param("requestID", UUID)
+		})
+		Security(JWTSec, func() {
+			Scope("approve")
+		})
+
+		Response(OK, OauthRedirectMedia)
+		Response(NotFound, ErrorMedia)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("token", func() {
+		Description("OpenID Connect token endpoint")
+		Routing(POST("/token"))
+		NoSecurity()
+		Payload(OauthTokenParams)
+
+		Response(OK, OauthTokenMedia)
+		Response(BadRequest, OauthErrorMedia)
+		Response(Unauthorized, OauthErrorMedia)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("userinfo", func() {
+		Description("OpenID Connect userinfo endpoint, authenticated with an access token issued by the token endpoint")
+		Routing(GET("/userinfo"))
+		NoSecurity()
+
+		Response(OK, UserInfoMedia)
+		Response(Unauthorized, ErrorMedia)
+		Response(InternalServerError, ErrorMedia)
+	})
+})
@@ -39,15 +39,24 @@ var _ = Resource("password-auth", func() {
 				Required("Authorization", "X-Session")
 			})
 		})
+		Response(Accepted, TwoFactorChallengeMedia)
 		Response(Unauthorized, ErrorMedia)
 		Response(BadRequest, ErrorMedia)
+		Response(Forbidden, ErrorMedia)
+		Response(TooManyRequests, ErrorMedia, func() {
+			Headers(func() {
+				Header("Retry-After", String, "Seconds to wait before trying again")
+			})
+		})
 		Response(InternalServerError, ErrorMedia)
 	})
 
 	Action("remove", func() {
 		Description("Removes using a password as a login method")
 		Routing(POST("/remove-password"))
-		Security(JWTSec)
+		Security(JWTSec, func() {
+			Scope("account")
+		})
 
 		Response(OK, "OK")
 		Response(NotFound, ErrorMedia)
@@ -58,11 +67,19 @@ var _ = Resource("password-auth", func() {
 	Action("change-password", func() {
 		Description("Changes the user's current password to a new one, also adds a password to the account if there is none")
 		Routing(POST("/change-password"))
-		Security(JWTSec)
+		Security(JWTSec, func() {
+			Scope("account")
+		})
 		Payload(ChangePasswordParams)
 
 		Response(OK, "OK")
 		Response(BadRequest, ErrorMedia)
+		Response(Forbidden, ErrorMedia)
+		Response(TooManyRequests, ErrorMedia, func() {
+			Headers(func() {
+				Header("Retry-After", String, "Seconds to wait before trying again")
+			})
+		})
 		Response(InternalServerError, ErrorMedia)
 	})
 
@@ -73,10 +90,22 @@ var _ = Resource("password-auth", func() {
 			Param("email", String, "Email of the account to send a password reset", func() {
 				Format("email")
 			})
-			Param("redirect-url", String, "URL to redirect to from the user's email link")
+			Param("redirect-url", String, "URL to redirect to from the user's email link, it has to be registered to the client")
 			Required("email", "redirect-url")
 		})
 		Response(OK, "OK")
+		Response(BadRequest, ErrorMedia)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("regenerate-recovery-codes", func() {
+		Description("Replaces the user's recovery codes with a new set, any old codes stop working")
+		Routing(POST("/recovery-codes"))
+		Security(JWTSec, func() {
+			Scope("account")
+		})
+
+		Response(OK, RecoveryCodesMedia)
 		Response(InternalServerError, ErrorMedia)
 	})
 
@@ -85,6 +114,21 @@ var _ = Resource("password-auth", func() {
 		Routing(POST("/finalize-reset"))
 		Payload(ResetPasswordParams)
 		Response(OK, "OK")
+		Response(BadRequest, ErrorMedia)
+		Response(Forbidden, ErrorMedia)
+		Response(TooManyRequests, ErrorMedia, func() {
+			Headers(func() {
+				Header("Retry-After", String, "Seconds to wait before trying again")
+			})
+		})
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("unlock", func() {
+		Description("Unlocks an account that was locked after too many failed logins, using the token from the unlock email")
+		Routing(POST("/unlock"))
+		Payload(UnlockParams)
+		Response(OK, "OK")
 		Response(Forbidden, ErrorMedia)
 		Response(InternalServerError, ErrorMedia)
 	})
@@ -7,4 +7,9 @@ import (
 var JWTSec = JWTSecurity("jwt", func() {
 	Header("Authorization")
 	TokenURL(`/api/auth/login`)
+
+	Scope("profile", "Read and change the user's profile and bookmarks")
+	Scope("account", "Change how the user logs in and deactivate their account")
+	Scope("admin", "Use the admin actions, the user must also be an admin")
+	Scope("approve", "Approve OpenID Connect authorization requests, only our own applications are given it")
 })
@@ -66,13 +66,32 @@ var _ = Resource("session", func() {
 		Response(InternalServerError, ErrorMedia)
 	})
 
+	Action("redeem-phone-login-code", func() {
+		Description("Login using a one-time code sent by send-phone-login-code")
+		Security("key")
+		Routing(POST("/phone-login-code/redeem"))
+		Payload(PhoneLoginParams)
+		Response(OK, UserMedia, func() {
+			Headers(func() {
+				Header("Authorization")
+				Header("X-Session")
+				Required("Authorization", "X-Session")
+			})
+		})
+		Response(Accepted, TwoFactorChallengeMedia)
+		Response(Unauthorized, ErrorMedia)
+		Response(Forbidden, ErrorMedia)
+		Response(InternalServerError, ErrorMedia)
+	})
+
 	Action("redeemToken", func() {
 		Description("Redeems a login token for credentials")
 		NoSecurity()
 		Routing(POST("/token"))
 		Payload(func() {
 			Attribute("token", UUID, "The token to redeem")
-			Required("token")
+			Attribute("signature", String, "The signature of the token, sent along with it in the login link")
+			Required("token", "signature")
 		})
 
 		Response(Created, func() {
@@ -82,27 +101,100 @@ var _ = Resource("session", func() {
 				Required("Authorization", "X-Session")
 			})
 		})
+		Response(Accepted, TwoFactorChallengeMedia)
 		Response(Forbidden, ErrorMedia)
 		Response(InternalServerError, ErrorMedia)
 	})
 
+	Action("redeem-login-code", func() {
+		Description("Login using a one-time code sent by send-login-code")
+		Security("key")
+		Routing(POST("/login-code/redeem"))
+		Payload(LoginCodeParams)
+		Response(OK, UserMedia, func() {
+			Headers(func() {
+				Header("Authorization")
+				Header("X-Session")
+				Required("Authorization", "X-Session")
+			})
+		})
+		Response(Accepted, TwoFactorChallengeMedia)
+		Response(Unauthorized, ErrorMedia)
+		Response(Forbidden, ErrorMedia)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("send-login-code", func() {
+		Description("Emails a 6 digit login code to the user, responds the same way even if the email is not on any user account")
+		Security("key")
+		Routing(POST("/login-code"))
+		Params(func() {
+			Param("email", String, "Email of the account to send a login code to", func() {
+				Format("email")
+			})
+			Required("email")
+		})
+
+		Response(OK, "OK")
+		Response(TooManyRequests, ErrorMedia)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("send-login-link", func() {
+		Description("Emails a single use login link to the user, responds the same way even if the email is not on any user account")
+		Security("key")
+		Routing(POST("/login-link"))
+		Params(func() {
+			Param("email", String, "Email of the account to send a login link to", func() {
+				Format("email")
+			})
+			Required("email")
+		})
+
+		Response(OK, "OK")
+		Response(TooManyRequests, ErrorMedia)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("send-phone-login-code", func() {
+		Description("Texts a 6 digit login code to the user with this verified phone number, responds the same way even if no user has the number")
+		Security("key")
+		Routing(POST("/phone-login-code"))
+		Payload(func() {
+			Attribute("phone", String, "Phone number in E.164 format", func() {
+				Pattern(phonePattern)
+			})
+			Required("phone")
+		})
+
+		Response(OK, "OK")
+		Response(TooManyRequests, ErrorMedia)
+		Response(InternalServerError, ErrorMedia)
+	})
+
 	Action("clean-sessions", func() {
 		Description("Deletes all the sessions that have expired")
-		Security(JWTSec)
+		Security(JWTSec, func() {
+			Scope("admin")
+		})
 		Routing(GET("/clean/sessions"))
 		Response(OK, "OK")
 		Response(Forbidden, ErrorMedia)
 	})
 	Action("clean-login-token", func() {
 		Description("Cleans old login tokens from the database")
-		Security(JWTSec)
+		Security(JWTSec, func() {
+			Scope("admin")
+		})
 		Routing(GET("/clean/token/login"))
 		Response(OK, "OK")
 		Response(Forbidden, ErrorMedia)
 	})
 	Action("clean-merge-token", func() {
 		Description("Cleans old account merge tokens from the database")
-		Security(JWTSec)
+		Security(JWTSec, func() {
+			Scope("admin")
+		})
 		Routing(GET("/clean/token/merge"))
 		Response(OK, "OK")
 		Response(Forbidden, ErrorMedia)
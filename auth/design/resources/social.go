@@ -0,0 +1,113 @@
+package resources
+
+import (
+	. "gigglesearch.org/giggle-auth/auth/design/types"
+	. "github.com/goadesign/goa/design"
+	. "github.com/goadesign/goa/design/apidsl"
+)
+
+var _ = Resource("social", func() {
+	BasePath("/auth/:provider")
+	Params(func() {
+		Param("provider", String, "Name of the provider, such as google or facebook")
+		Required("provider")
+	})
+
+	Action("login", func() {
+		Description("Gets the URL the front-end should redirect the browser to in order to be authenticated with the provider, to be logged in, and the binding to send to receive")
+		Routing(GET("/login"))
+		Params(func() {
+			Param("token", UUID, "A merge token for merging into an account")
+		})
+
+		Response(OK, SocialConnectionMedia)
+		Response(NotFound, ErrorMedia)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("register-url", func() {
+		Description("Gets the URL the front-end should redirect the browser to in order to be authenticated with the provider, and then register, and the binding to send to receive")
+		Routing(GET("/register-start"))
+
+		Response(OK, SocialConnectionMedia)
+		Response(NotFound, ErrorMedia)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("register", func() {
+		Description("Registers a new account with the system, with the provider as the login system")
+		Routing(POST("/register"))
+		Payload(SocialRegisterParams)
+
+		Response(OK, UserMedia, func() {
+			Headers(func() {
+				Header("Authorization")
+				Header("X-Session")
+				Required("Authorization", "X-Session")
+			})
+		})
+		Response(NotFound, ErrorMedia)
+		Response(BadRequest, ErrorMedia)
+		Response(Forbidden, ErrorMedia)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("attach-to-account", func() {
+		Description("Attaches an account with the provider to an existing user account, returns the URL the browser should be redirected to and the binding to send to receive")
+		Routing(POST("/attach"))
+		Security(JWTSec, func() {
+			Scope("account")
+		})
+
+		Response(OK, SocialConnectionMedia)
+		Response(NotFound, ErrorMedia)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("detach-from-account", func() {
+		Description("Detaches the account with the provider from an existing user account.")
+		Routing(POST("/detach"))
+		Security(JWTSec, func() {
+			Scope("account")
+		})
+
+		Response(OK, "OK")
+		Response(NotFound, ErrorMedia)
+		Response(Forbidden, ErrorMedia)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("receive", func() {
+		Description("The endpoint that the provider redirects the browser to after the user has authenticated")
+		Routing(GET("/receive"))
+		Params(func() {
+			Param("code", String)
+			Param("state", UUID)
+			Param("binding", String, "The binding the connection was created with")
+			Required("code", "state", "binding")
+		})
+
+		Response(OK, Any)
+		Response(Unauthorized, ErrorMedia)
+		Response(BadRequest, ErrorMedia)
+		Response(NotFound, ErrorMedia)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("receive-form", func() {
+		Description("The endpoint that providers using the form_post response mode post the browser to, such as Apple. The browser is sent on to the front-end with the code and state, which it gives to receive.")
+		Routing(POST("/receive"))
+		NoSecurity()
+		Payload(SocialReceiveFormParams)
+
+		Response(SeeOther, func() {
+			Headers(func() {
+				Header("Location")
+				Required("Location")
+			})
+		})
+		Response(BadRequest, ErrorMedia)
+		Response(NotFound, ErrorMedia)
+		Response(InternalServerError, ErrorMedia)
+	})
+})
@@ -0,0 +1,30 @@
+package resources
+
+import (
+	. "gigglesearch.org/giggle-auth/auth/design/types"
+	. "github.com/goadesign/goa/design"
+	. "github.com/goadesign/goa/design/apidsl"
+)
+
+var _ = Resource("token", func() {
+	Action("introspect", func() {
+		Description("Get whether a token is active and who it was issued to (RFC 7662)")
+		Routing(POST("/introspect"))
+		Payload(TokenParams)
+
+		Response(OK, TokenIntrospectionMedia)
+		Response(BadRequest, ErrorMedia)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("revoke", func() {
+		Description("Revoke a token, revoking a session token ends its session (RFC 7009)")
+		Routing(POST("/revoke"))
+		Payload(TokenParams)
+
+		Response(OK)
+		Response(BadRequest, ErrorMedia)
+		Response(Forbidden, ErrorMedia)
+		Response(InternalServerError, ErrorMedia)
+	})
+})
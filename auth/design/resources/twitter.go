@@ -49,7 +49,9 @@ var _ = Resource("twitter", func() {
 	Action("attach-to-account", func() {
 		Description("Attaches a Twitter account to an existing user account, returns the URL the browser should be redirected to")
 		Routing(POST("/attach"))
-		Security(JWTSec)
+		Security(JWTSec, func() {
+			Scope("account")
+		})
 
 		Response(OK, "text/plain")
 		Response(InternalServerError, ErrorMedia)
@@ -58,7 +60,9 @@ var _ = Resource("twitter", func() {
 	Action("detach-from-account", func() {
 		Description("Detaches a Twitter account from an existing user account.")
 		Routing(POST("/detach"))
-		Security(JWTSec)
+		Security(JWTSec, func() {
+			Scope("account")
+		})
 
 		Response(OK, "OK")
 		Response(NotFound, ErrorMedia)
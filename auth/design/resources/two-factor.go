@@ -0,0 +1,95 @@
+package resources
+
+import (
+	. "gigglesearch.org/giggle-auth/auth/design/types"
+	. "github.com/goadesign/goa/design"
+	. "github.com/goadesign/goa/design/apidsl"
+)
+
+var _ = Resource("two-factor", func() {
+	BasePath("/auth/two-factor")
+	Security(JWTSec, func() {
+		Scope("account")
+	})
+
+	Action("enroll", func() {
+		Description("Creates a new TOTP secret for the current user, two factor is not enabled until the secret is confirmed")
+		Routing(POST("/enroll"))
+
+		Response(OK, TwoFactorEnrollMedia)
+		Response(BadRequest, ErrorMedia)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("confirm", func() {
+		Description("Enables two factor authentication after checking a passcode generated from the enrolled secret")
+		Routing(POST("/confirm"))
+		Payload(TwoFactorPasscodeParams)
+
+		Response(OK, "OK")
+		Response(BadRequest, ErrorMedia)
+		Response(Unauthorized, ErrorMedia)
+		Response(Forbidden, ErrorMedia)
+		Response(TooManyRequests, ErrorMedia, func() {
+			Headers(func() {
+				Header("Retry-After", String, "Seconds to wait before trying again")
+			})
+		})
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("disable", func() {
+		Description("Disables two factor authentication, requires a current passcode")
+		Routing(POST("/disable"))
+		Payload(TwoFactorPasscodeParams)
+
+		Response(OK, "OK")
+		Response(BadRequest, ErrorMedia)
+		Response(Unauthorized, ErrorMedia)
+		Response(Forbidden, ErrorMedia)
+		Response(TooManyRequests, ErrorMedia, func() {
+			Headers(func() {
+				Header("Retry-After", String, "Seconds to wait before trying again")
+			})
+		})
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("send-phone-code", func() {
+		Description("Texts a code to the verified phone number of the user a two factor challenge is for, the code can be used instead of a passcode in verify. Not available when the user logged in with a code texted to the same phone")
+		Routing(POST("/phone-code"))
+		NoSecurity()
+		Params(func() {
+			Param("challenge", UUID, "The challenge returned from logging in")
+			Required("challenge")
+		})
+
+		Response(OK, "OK")
+		Response(NotFound, ErrorMedia)
+		Response(TooManyRequests, ErrorMedia)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("verify", func() {
+		Description("Finishes a login that returned a two factor challenge, using a passcode from the authenticator app, a code texted by send-phone-code, or one of the user's recovery codes")
+		Routing(POST("/verify"))
+		NoSecurity()
+		Payload(TwoFactorVerifyParams)
+
+		Response(OK, UserMedia, func() {
+			Headers(func() {
+				Header("Authorization")
+				Header("X-Session")
+				Required("Authorization", "X-Session")
+			})
+		})
+		Response(Unauthorized, ErrorMedia)
+		Response(Forbidden, ErrorMedia)
+		Response(TooManyRequests, ErrorMedia, func() {
+			Headers(func() {
+				Header("Retry-After", String, "Seconds to wait before trying again")
+			})
+		})
+		Response(InternalServerError, ErrorMedia)
+	})
+})
@@ -78,7 +78,9 @@ var _ = Resource("user", func() {
 		Description("Update a user")
 		Routing(PATCH(""))
 		Payload(UpdateUserParams)
-		Security(JWTSec)
+		Security(JWTSec, func() {
+			Scope("profile")
+		})
 		Response(OK, "OK")
 		Response(BadRequest, ErrorMedia)
 		Response(Forbidden, ErrorMedia)
@@ -92,7 +94,9 @@ var _ = Resource("user", func() {
 			Param("id", String, "id of the user to be deactivated when admin is deactivating a user")
 			Param("admin", Boolean, "whether admin is requesting this deactivation")
 		})
-		Security(JWTSec)
+		Security(JWTSec, func() {
+			Scope("account")
+		})
 		Response(OK, "OK")
 		Response(Forbidden, ErrorMedia)
 		Response(InternalServerError, ErrorMedia)
@@ -121,8 +125,61 @@ var _ = Resource("user", func() {
 		Response(InternalServerError, ErrorMedia)
 	})
 
+	Action("send-verify-code", func() {
+		Description("Emails a 6 digit code for verifying the current user's email, for clients that cannot open the link in a verify email")
+		Security(JWTSec, func() {
+			Scope("profile")
+		})
+		Routing(POST("/verify-code"))
+		Response(OK, "OK")
+		Response(NotFound, ErrorMedia)
+		Response(TooManyRequests, ErrorMedia)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("verify-email-code", func() {
+		Description("Verifies the current user's email using a code sent by send-verify-code")
+		Security(JWTSec, func() {
+			Scope("profile")
+		})
+		Routing(POST("/verify-code/confirm"))
+		Payload(EmailCodeParams)
+		Response(OK, "OK")
+		Response(BadRequest, ErrorMedia)
+		Response(Forbidden, ErrorMedia)
+		Response(NotFound, ErrorMedia)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("send-phone-code", func() {
+		Description("Texts a 6 digit code to the current user's phone number so they can verify it")
+		Security(JWTSec, func() {
+			Scope("profile")
+		})
+		Routing(POST("/phone/code"))
+		Response(OK, "OK")
+		Response(NotFound, ErrorMedia)
+		Response(TooManyRequests, ErrorMedia)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("verify-phone", func() {
+		Description("Verifies the current user's phone number using a code sent by send-phone-code")
+		Security(JWTSec, func() {
+			Scope("profile")
+		})
+		Routing(POST("/phone/verify"))
+		Payload(PhoneCodeParams)
+		Response(OK, "OK")
+		Response(BadRequest, ErrorMedia)
+		Response(Forbidden, ErrorMedia)
+		Response(InternalServerError, ErrorMedia)
+	})
+
 	Action("get-all-users", func() {
-		Security(JWTSec)
+		Security(JWTSec, func() {
+			Scope("admin")
+		})
 		Description("Get all users")
 		Routing(GET("/all"))
 
@@ -133,8 +190,28 @@ var _ = Resource("user", func() {
 		Response(InternalServerError, ErrorMedia)
 	})
 
+	Action("unlock", func() {
+		Security(JWTSec, func() {
+			Scope("admin")
+		})
+		Description("Clear the failed logins and lockout of a user from admin dashboard")
+		Routing(POST("/unlock"))
+
+		Params(func() {
+			Param("uid", String, "id of the user to unlock")
+			Required("uid")
+		})
+
+		Response(OK, "OK")
+		Response(Forbidden, ErrorMedia)
+		Response(NotFound, ErrorMedia)
+		Response(InternalServerError, ErrorMedia)
+	})
+
 	Action("update-admin", func() {
-		Security(JWTSec)
+		Security(JWTSec, func() {
+			Scope("admin")
+		})
 		Description("Update a user from admin dashboard")
 		Routing(PATCH("/update-user"))
 
@@ -0,0 +1,89 @@
+package resources
+
+import (
+	. "gigglesearch.org/giggle-auth/auth/design/types"
+	. "github.com/goadesign/goa/design"
+	. "github.com/goadesign/goa/design/apidsl"
+)
+
+var _ = Resource("webauthn", func() {
+	BasePath("/auth/webauthn")
+
+	Action("register-start", func() {
+		Description("Starts registering a new passkey or security key for the current user")
+		Routing(POST("/register/start"))
+		Security(JWTSec, func() {
+			Scope("account")
+		})
+
+		Response(OK, WebauthnOptionsMedia)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("register-finish", func() {
+		Description("Finishes registering a passkey with the credential created by the browser")
+		Routing(POST("/register/finish"))
+		Security(JWTSec, func() {
+			Scope("account")
+		})
+		Payload(WebauthnFinishParams)
+
+		Response(OK, WebauthnCredentialMedia)
+		Response(BadRequest, ErrorMedia)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("login-start", func() {
+		Description("Starts logging in with one of the account's passkeys, the response is the same whether or not the account exists")
+		Routing(POST("/login/start"))
+		Payload(WebauthnLoginParams)
+
+		Response(OK, WebauthnOptionsMedia)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("login-finish", func() {
+		Description("Finishes logging in with the assertion signed by the browser")
+		Routing(POST("/login/finish"))
+		Payload(WebauthnFinishParams)
+
+		Response(OK, UserMedia, func() {
+			Headers(func() {
+				Header("Authorization")
+				Header("X-Session")
+				Required("Authorization", "X-Session")
+			})
+		})
+		Response(BadRequest, ErrorMedia)
+		Response(Unauthorized, ErrorMedia)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("list", func() {
+		Description("Lists the passkeys registered to the current user")
+		Routing(GET(""))
+		Security(JWTSec, func() {
+			Scope("account")
+		})
+
+		Response(OK, CollectionOf(WebauthnCredentialMedia))
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("remove", func() {
+		Description("Removes a passkey from the current user")
+		Routing(DELETE("/:id"))
+		Security(JWTSec, func() {
+			Scope("account")
+		})
+		Params(func() {
+			Param("id", String, "ID of the credential to remove")
+			Required("id")
+		})
+
+		Response(OK, "OK")
+		Response(NotFound, ErrorMedia)
+		Response(Forbidden, ErrorMedia)
+		Response(InternalServerError, ErrorMedia)
+	})
+})
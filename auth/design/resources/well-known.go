@@ -0,0 +1,26 @@
+package resources
+
+import (
+	. "gigglesearch.org/giggle-auth/auth/design/types"
+	. "github.com/goadesign/goa/design"
+	. "github.com/goadesign/goa/design/apidsl"
+)
+
+var _ = Resource("well-known", func() {
+	Action("key-set", func() {
+		Description("Get the public keys tokens are signed with, so other services can verify them")
+		Routing(GET("//.well-known/jwks.json"))
+		NoSecurity()
+
+		Response(OK, JSONWebKeySetMedia)
+		Response(InternalServerError, ErrorMedia)
+	})
+
+	Action("openid-configuration", func() {
+		Description("Get the OpenID Connect discovery document")
+		Routing(GET("//.well-known/openid-configuration"))
+		NoSecurity()
+
+		Response(OK, OpenidConfigurationMedia)
+	})
+})
@@ -0,0 +1,58 @@
+package types
+
+import (
+	. "github.com/goadesign/goa/design"
+	. "github.com/goadesign/goa/design/apidsl"
+)
+
+var OauthClientParams = Type("oauth-client-params", func() {
+	Attribute("name", String, "Name of the application, shown to users when it asks them to login", func() {
+		MinLength(1)
+	})
+	Attribute("logoURL", String, "URL of the logo of the application", func() {
+		Format("uri")
+	})
+	Attribute("redirectURIs", ArrayOf(String), "URLs users can be sent back to after they login or from links in emails")
+	Attribute("grantTypes", ArrayOf(String), "Grant types the application can use: authorization_code, refresh_token and client_credentials for service accounts")
+	Attribute("audience", String, "Audience of the tokens issued to the application, the default audience when it is not set")
+	Attribute("scopes", ArrayOf(String), "Scopes of the tokens issued to the application, the default scopes when it is not set. Service accounts only get these scopes.")
+	Attribute("confidential", Boolean, "Whether the application can keep a secret, only used when it is registered")
+	Attribute("thirdParty", Boolean, "Whether the application is run by someone else. Its tokens have its client ID as the audience unless it is set, and only the scopes registered to it.")
+
+	Required("name")
+})
+
+var OauthClientMedia = MediaType("oauth-client", func() {
+	Description("An application registered to login users")
+	ContentType("application/json")
+
+	Attributes(func() {
+		Attribute("clientID", String, "ID of the client, also its API key")
+		Attribute("clientSecret", String, "Secret of a confidential client, only returned when it is created or replaced")
+		Attribute("name", String, "Name of the application")
+		Attribute("logoURL", String, "URL of the logo of the application")
+		Attribute("redirectURIs", ArrayOf(String), "URLs users can be sent back to after they login or from links in emails")
+		Attribute("grantTypes", ArrayOf(String), "Grant types the application can use")
+		Attribute("audience", String, "Audience of the tokens issued to the application")
+		Attribute("scopes", ArrayOf(String), "Scopes of the tokens issued to the application")
+		Attribute("confidential", Boolean, "Whether the client has a secret")
+		Attribute("thirdParty", Boolean, "Whether the application is run by someone else")
+		Attribute("timeCreated", DateTime, "Time the client was registered")
+
+		Required("clientID", "name", "redirectURIs", "grantTypes", "audience", "scopes", "confidential", "thirdParty", "timeCreated")
+	})
+
+	View("default", func() {
+		Attribute("clientID")
+		Attribute("clientSecret")
+		Attribute("name")
+		Attribute("logoURL")
+		Attribute("redirectURIs")
+		Attribute("grantTypes")
+		Attribute("audience")
+		Attribute("scopes")
+		Attribute("confidential")
+		Attribute("thirdParty")
+		Attribute("timeCreated")
+	})
+})
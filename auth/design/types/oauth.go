@@ -0,0 +1,168 @@
+package types
+
+import (
+	. "github.com/goadesign/goa/design"
+	. "github.com/goadesign/goa/design/apidsl"
+)
+
+var OauthRequestMedia = MediaType("oauth-request", func() {
+	Description("An OpenID Connect authorization request waiting for the user to approve it")
+	ContentType("application/json")
+
+	Attributes(func() {
+		Attribute("clientName", String, "Name of the application asking the user to login")
+		Attribute("clientLogo", String, "URL of the logo of the application")
+		Attribute("scopes", ArrayOf(String), "Scopes the application will be granted")
+
+		Required("clientName", "scopes")
+	})
+
+	View("default", func() {
+		Attribute("clientName")
+		Attribute("clientLogo")
+		Attribute("scopes")
+	})
+})
+
+var OauthRedirectMedia = MediaType("oauth-redirect", func() {
+	Description("Where to send the browser to finish an OpenID Connect authorization request")
+	ContentType("application/json")
+
+	Attributes(func() {
+		Attribute("redirectURL", String, "Redirect URI of the application, with the authorization code and state")
+
+		Required("redirectURL")
+	})
+
+	View("default", func() {
+		Attribute("redirectURL")
+	})
+})
+
+var OauthTokenParams = Type("oauth-token-params", func() {
+	Attribute("grant_type", String, "authorization_code, refresh_token or client_credentials")
+	Attribute("client_id", String, "ID of the client, unless it is sent with HTTP Basic authentication")
+	Attribute("client_secret", String, "Secret of a confidential client, unless it is sent with HTTP Basic authentication")
+	Attribute("code", String, "Authorization code, for the authorization_code grant")
+	Attribute("redirect_uri", String, "Redirect URI the code was sent to, for the authorization_code grant")
+	Attribute("code_verifier", String, "PKCE verifier of the code challenge, for the authorization_code grant")
+	Attribute("refresh_token", String, "Refresh token, for the refresh_token grant")
+	Attribute("scope", String, "Space separated scopes, for the client_credentials grant, all the scopes registered to the client when it is left out")
+
+	Required("grant_type")
+})
+
+var OauthTokenMedia = MediaType("oauth-token", func() {
+	Description("Tokens issued by the OpenID Connect token endpoint")
+	ContentType("application/json")
+
+	Attributes(func() {
+		Attribute("access_token", String, "Auth token, sent in the Authorization header")
+		Attribute("token_type", String, "Always Bearer")
+		Attribute("expires_in", Integer, "Seconds until the access token expires")
+		Attribute("refresh_token", String, "Session token, which can be exchanged for new tokens once, not issued to service accounts")
+		Attribute("id_token", String, "ID token, only issued for the authorization_code grant")
+		Attribute("scope", String, "Space separated scopes of the access token, left out when they are unchanged by a refresh")
+
+		Required("access_token", "token_type", "expires_in")
+	})
+
+	View("default", func() {
+		Attribute("access_token")
+		Attribute("token_type")
+		Attribute("expires_in")
+		Attribute("refresh_token")
+		Attribute("id_token")
+		Attribute("scope")
+	})
+})
+
+var OauthErrorMedia = MediaType("oauth-error", func() {
+	Description("An OAuth 2.0 error response (RFC 6749)")
+	ContentType("application/json")
+
+	Attributes(func() {
+		Attribute("error", String, "Error code")
+		Attribute("error_description", String, "Description of the error")
+
+		Required("error")
+	})
+
+	View("default", func() {
+		Attribute("error")
+		Attribute("error_description")
+	})
+})
+
+var UserInfoMedia = MediaType("user-info", func() {
+	Description("OpenID Connect standard claims about a user, for the scopes of the access token")
+	ContentType("application/json")
+
+	Attributes(func() {
+		Attribute("sub", String, "ID of the user")
+		Attribute("name", String, "Full name")
+		Attribute("given_name", String, "First name")
+		Attribute("family_name", String, "Last name")
+		Attribute("gender", String)
+		Attribute("picture", String, "URL of the profile image")
+		Attribute("email", String)
+		Attribute("email_verified", Boolean)
+		Attribute("phone_number", String)
+		Attribute("phone_number_verified", Boolean)
+
+		Required("sub")
+	})
+
+	View("default", func() {
+		Attribute("sub")
+		Attribute("name")
+		Attribute("given_name")
+		Attribute("family_name")
+		Attribute("gender")
+		Attribute("picture")
+		Attribute("email")
+		Attribute("email_verified")
+		Attribute("phone_number")
+		Attribute("phone_number_verified")
+	})
+})
+
+var OpenidConfigurationMedia = MediaType("openid-configuration", func() {
+	Description("OpenID Connect discovery document")
+	ContentType("application/json")
+
+	Attributes(func() {
+		Attribute("issuer", String)
+		Attribute("authorization_endpoint", String)
+		Attribute("token_endpoint", String)
+		Attribute("userinfo_endpoint", String)
+		Attribute("jwks_uri", String)
+		Attribute("response_types_supported", ArrayOf(String))
+		Attribute("subject_types_supported", ArrayOf(String))
+		Attribute("id_token_signing_alg_values_supported", ArrayOf(String))
+		Attribute("scopes_supported", ArrayOf(String))
+		Attribute("token_endpoint_auth_methods_supported", ArrayOf(String))
+		Attribute("grant_types_supported", ArrayOf(String))
+		Attribute("code_challenge_methods_supported", ArrayOf(String))
+		Attribute("claims_supported", ArrayOf(String))
+
+		Required("issuer", "authorization_endpoint", "token_endpoint", "userinfo_endpoint", "jwks_uri",
+			"response_types_supported", "subject_types_supported", "id_token_signing_alg_values_supported")
+	})
+
+	View("default", func() {
+		Attribute("issuer")
+		Attribute("authorization_endpoint")
+		Attribute("token_endpoint")
+		Attribute("userinfo_endpoint")
+		Attribute("jwks_uri")
+		Attribute("response_types_supported")
+		Attribute("subject_types_supported")
+		Attribute("id_token_signing_alg_values_supported")
+		Attribute("scopes_supported")
+		Attribute("token_endpoint_auth_methods_supported")
+		Attribute("grant_types_supported")
+		Attribute("code_challenge_methods_supported")
+		Attribute("claims_supported")
+	})
+})
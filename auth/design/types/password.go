@@ -72,3 +72,21 @@ var ResetPasswordParams = Type("reset-password-params", func() {
 	})
 	Required("resetCode", "userID", "newPassword")
 })
+
+var UnlockParams = Type("unlock-params", func() {
+	Attribute("userID", String, "The ID of the locked user")
+	Attribute("token", String, "The unlock token sent to the user's email when their account was locked")
+	Required("userID", "token")
+})
+
+var RecoveryCodesMedia = MediaType("recovery-codes", func() {
+	Description("A new set of recovery codes, these are only shown once")
+	ContentType("application/json")
+	Attributes(func() {
+		Attribute("codes", ArrayOf(String), "Single use codes that can be used in place of a two factor passcode if the authenticator is lost")
+		Required("codes")
+	})
+	View("default", func() {
+		Attribute("codes")
+	})
+})
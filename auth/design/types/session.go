@@ -53,3 +53,23 @@ var AllSessionsMedia = MediaType("all-sessions", func() {
 		Attribute("otherSessions")
 	})
 })
+
+var LoginCodeParams = Type("login-code-params", func() {
+	Attribute("email", String, "The email address of the account to login to", func() {
+		Format("email")
+	})
+	Attribute("code", String, "The 6 digit code that was emailed to the user", func() {
+		Pattern(codePattern)
+	})
+	Required("email", "code")
+})
+
+var PhoneLoginParams = Type("phone-login-params", func() {
+	Attribute("phone", String, "The verified phone number of the account to login to, in E.164 format", func() {
+		Pattern(phonePattern)
+	})
+	Attribute("code", String, "The 6 digit code that was texted to the user", func() {
+		Pattern(codePattern)
+	})
+	Required("phone", "code")
+})
@@ -0,0 +1,68 @@
+package types
+
+import (
+	. "github.com/goadesign/goa/design"
+	. "github.com/goadesign/goa/design/apidsl"
+)
+
+var SocialRegisterMedia = MediaType("social-register-media", func() {
+	Description("Information used to pre-populate the register page")
+	ContentType("application/json")
+	Attributes(func() {
+		Attribute("email", String, "An email extracted from the account with the provider")
+		Attribute("firstName", String, "The given name for the user, pulled from the account with the provider", func() {
+			Example("Jeff")
+		})
+		Attribute("lastName", String, "The family name for the user, pulled from the account with the provider", func() {
+			Example("Newmann")
+		})
+		Attribute("oauthKey", UUID, "A key used to connect the register request with the specific account")
+		Required("email", "firstName", "lastName", "oauthKey")
+	})
+	View("default", func() {
+		Attribute("email")
+		Attribute("firstName")
+		Attribute("lastName")
+		Attribute("oauthKey")
+	})
+})
+
+var SocialConnectionMedia = MediaType("social-connection-media", func() {
+	Description("A connection with a provider the browser is sent to")
+	ContentType("application/json")
+	Attributes(func() {
+		Attribute("url", String, "URL of the provider the front-end should redirect the browser to")
+		Attribute("binding", String, "Secret the connection is bound to, the front-end keeps it in the browser and sends it to receive with the code")
+		Required("url", "binding")
+	})
+	View("default", func() {
+		Attribute("url")
+		Attribute("binding")
+	})
+})
+
+var SocialRegisterParams = Type("social-register-params", func() {
+	Attribute("email", String, "The email that will be connected to the account", func() {
+		Format("email")
+	})
+	Attribute("firstName", String, "The given name for the user", func() {
+		MinLength(minNameLength)
+		MaxLength(maxNameLength)
+	})
+	Attribute("lastName", String, "The family name for the user", func() {
+		MinLength(minNameLength)
+		MaxLength(maxNameLength)
+	})
+	Attribute("oauthKey", UUID, "The key given when the register was approved")
+	Attribute("gRecaptchaResponse", String, "The recaptcha response code")
+	Required("email", "firstName", "lastName", "oauthKey", "gRecaptchaResponse")
+})
+
+var SocialReceiveFormParams = Type("social-receive-form-params", func() {
+	Attribute("code", String, "Authorization code, missing when the user didn't authorize the application")
+	Attribute("state", UUID, "The state the connection was created with")
+	Attribute("error", String, "Error code, when the user didn't authorize the application")
+	Attribute("user", String, "JSON of the user's name and email, Apple only sends it the first time the user signs in")
+	Attribute("id_token", String, "ID token, it is ignored as the one returned with the access token is used")
+	Required("state")
+})
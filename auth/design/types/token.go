@@ -0,0 +1,52 @@
+package types
+
+import (
+	. "github.com/goadesign/goa/design"
+	. "github.com/goadesign/goa/design/apidsl"
+)
+
+var TokenParams = Type("token-params", func() {
+	Attribute("token", String, "An auth token or session token")
+	Attribute("token_type_hint", String, "access_token or refresh_token, the other type is tried when it is wrong")
+
+	Required("token")
+})
+
+var TokenIntrospectionMedia = MediaType("token-introspection", func() {
+	Description("The state of a token, every other attribute is left out when it is not active")
+	ContentType("application/json")
+
+	Attributes(func() {
+		Attribute("active", Boolean, "Whether the token is valid and its session has not ended")
+		Attribute("token_type", String, "access_token for auth tokens and refresh_token for session tokens")
+		Attribute("sub", String, "ID of the user")
+		Attribute("session_id", String, "ID of the session the token was issued to")
+		Attribute("aud", String, "Audience of the client the token was issued to")
+		Attribute("scope", String, "Space separated scopes of the token")
+		Attribute("iss", String, "Issuer of the token")
+		Attribute("jti", String, "ID of the token")
+		Attribute("exp", Integer, "Time the token expires, in seconds since the epoch")
+		Attribute("iat", Integer, "Time the token was issued, in seconds since the epoch")
+		Attribute("admin", Boolean, "Whether the user is an admin")
+		Attribute("plugin_author", Boolean, "Whether the user is a plugin author")
+		Attribute("event_author", Boolean, "Whether the user is an event author")
+
+		Required("active")
+	})
+
+	View("default", func() {
+		Attribute("active")
+		Attribute("token_type")
+		Attribute("sub")
+		Attribute("session_id")
+		Attribute("aud")
+		Attribute("scope")
+		Attribute("iss")
+		Attribute("jti")
+		Attribute("exp")
+		Attribute("iat")
+		Attribute("admin")
+		Attribute("plugin_author")
+		Attribute("event_author")
+	})
+})
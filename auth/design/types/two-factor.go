@@ -0,0 +1,51 @@
+package types
+
+import (
+	. "github.com/goadesign/goa/design"
+	. "github.com/goadesign/goa/design/apidsl"
+)
+
+var TwoFactorChallengeMedia = MediaType("two-factor-challenge", func() {
+	Description("Returned instead of a session when the account has two factor authentication enabled")
+	ContentType("application/json")
+	Attributes(func() {
+		Attribute("challenge", UUID, "Token to send back with the passcode to finish logging in")
+		Attribute("expiresAt", DateTime, "Time after which the challenge can no longer be used")
+		Required("challenge", "expiresAt")
+	})
+	View("default", func() {
+		Attribute("challenge")
+		Attribute("expiresAt")
+	})
+})
+
+var TwoFactorEnrollMedia = MediaType("two-factor-enroll", func() {
+	Description("The secret for setting up an authenticator app")
+	ContentType("application/json")
+	Attributes(func() {
+		Attribute("secret", String, "Base32 encoded TOTP secret")
+		Attribute("uri", String, "otpauth URI of the secret, suitable for showing as a QR code")
+		Required("secret", "uri")
+	})
+	View("default", func() {
+		Attribute("secret")
+		Attribute("uri")
+	})
+})
+
+var TwoFactorPasscodeParams = Type("two-factor-passcode-params", func() {
+	Attribute("passcode", String, "The current passcode from the authenticator app", func() {
+		MinLength(6)
+		MaxLength(8)
+	})
+	Required("passcode")
+})
+
+var TwoFactorVerifyParams = Type("two-factor-verify-params", func() {
+	Attribute("challenge", UUID, "The challenge returned from logging in")
+	Attribute("passcode", String, "The current passcode from the authenticator app, a code texted to the user, or one of the user's recovery codes", func() {
+		MinLength(6)
+		MaxLength(20)
+	})
+	Required("challenge", "passcode")
+})
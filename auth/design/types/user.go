@@ -8,6 +8,8 @@ import (
 const (
 	minNameLength = 2
 	maxNameLength = 50
+	codePattern   = `^[0-9]{6}$`
+	phonePattern  = `^\+[1-9][0-9]{6,14}$`
 )
 
 var UserMedia = MediaType("user", func() {
@@ -31,10 +33,13 @@ var UserMedia = MediaType("user", func() {
 		Attribute("bookmarks", ArrayOf(BookmarkMedia))
 		Attribute("changingEmail", String, "When the user attempts to change their email, this is what they will change it to after they verify that it belongs to them")
 		Attribute("verifiedEmail", Boolean, "Whether the user has verified their email")
+		Attribute("verifiedPhone", Boolean, "Whether the user has verified their phone number")
 		Attribute("isAdmin", Boolean, "Whether the user is an administrator on the site")
 		Attribute("isPluginAuthor", Boolean, "Whether the user is a plugin author on the site")
 		Attribute("isEventAuthor", Boolean, "Whether the user is a event author on the site")
 		Attribute("getNewsletter", Boolean, "True if the user wants to receive the newsletter")
+		Attribute("failedLogins", Integer, "Number of incorrect passwords entered since the last successful login")
+		Attribute("lockedUntil", DateTime, "When password login is locked until, not set if the account is not locked")
 		Attribute("plugins", ArrayOf(UserPluginMedia), "IDs of all plugins that the user has installed")
 
 		Required("id", "email", "phone", "verifiedEmail", "isAdmin", "isPluginAuthor", "firstName", "lastName", "getNewsletter", "profileImage", "gender")
@@ -58,6 +63,7 @@ var UserMedia = MediaType("user", func() {
 		Attribute("phone")
 		Attribute("changingEmail")
 		Attribute("verifiedEmail")
+		Attribute("verifiedPhone")
 		Attribute("getNewsletter")
 		Attribute("profileImage")
 		Attribute("isPluginAuthor")
@@ -76,10 +82,13 @@ var UserMedia = MediaType("user", func() {
 		Attribute("gender")
 		Attribute("changingEmail")
 		Attribute("verifiedEmail")
+		Attribute("verifiedPhone")
 		Attribute("isAdmin")
 		Attribute("isPluginAuthor")
 		Attribute("isEventAuthor")
 		Attribute("getNewsletter")
+		Attribute("failedLogins")
+		Attribute("lockedUntil")
 		Attribute("plugins")
 	})
 })
@@ -126,8 +135,12 @@ var AuthMedia = MediaType("auth-status", func() {
 		Attribute("twitter", Boolean, "True if user has twitter Oauth signin")
 		Attribute("linkedin", Boolean, "True if user has linkedin Oauth signin")
 		Attribute("microsoft", Boolean, "True if user has microsoft Oauth signin")
+		Attribute("amazon", Boolean, "True if user has amazon Oauth signin")
+		Attribute("social", ArrayOf(String), "Names of every OAuth provider the user can signin with")
 		Attribute("standard", Boolean, "True if user has password signin")
-		Required("google", "facebook", "twitter", "linkedin", "microsoft", "standard")
+		Attribute("twoFactor", Boolean, "True if user has two factor authentication enabled")
+		Attribute("webauthn", Boolean, "True if user has a passkey registered")
+		Required("google", "facebook", "twitter", "linkedin", "microsoft", "amazon", "social", "standard", "twoFactor", "webauthn")
 	})
 	View("default", func() {
 		Attribute("google")
@@ -135,7 +148,11 @@ var AuthMedia = MediaType("auth-status", func() {
 		Attribute("twitter")
 		Attribute("linkedin")
 		Attribute("microsoft")
+		Attribute("amazon")
+		Attribute("social")
 		Attribute("standard")
+		Attribute("twoFactor")
+		Attribute("webauthn")
 	})
 })
 
@@ -162,3 +179,17 @@ var UserPlugin = Type("user-plugin", func() {
 	Attribute("pluginID", String)
 	Attribute("permissionsAllowed", ArrayOf(String))
 })
+
+var EmailCodeParams = Type("email-code-params", func() {
+	Attribute("code", String, "The 6 digit code that was emailed to the user", func() {
+		Pattern(codePattern)
+	})
+	Required("code")
+})
+
+var PhoneCodeParams = Type("phone-code-params", func() {
+	Attribute("code", String, "The 6 digit code that was texted to the user", func() {
+		Pattern(codePattern)
+	})
+	Required("code")
+})
@@ -0,0 +1,56 @@
+package types
+
+import (
+	. "github.com/goadesign/goa/design"
+	. "github.com/goadesign/goa/design/apidsl"
+)
+
+var WebauthnOptionsMedia = MediaType("webauthn-options", func() {
+	Description("Options to pass to navigator.credentials.create() or navigator.credentials.get() in the browser")
+	ContentType("application/json")
+	Attributes(func() {
+		Attribute("ceremony", UUID, "ID of the ceremony, must be sent back when finishing it")
+		Attribute("options", Any, "The PublicKeyCredentialCreationOptions or PublicKeyCredentialRequestOptions")
+		Required("ceremony", "options")
+	})
+	View("default", func() {
+		Attribute("ceremony")
+		Attribute("options")
+	})
+})
+
+var WebauthnCredentialMedia = MediaType("webauthn-credential", func() {
+	Description("A passkey or security key registered to the user")
+	ContentType("application/json")
+	Attributes(func() {
+		Attribute("id", String, "Unique unchanging credential ID", func() {
+			Metadata("struct:field:type", "string")
+		})
+		Attribute("name", String, "Name the user gave the authenticator")
+		Attribute("timeCreated", DateTime, "Time that the authenticator was registered")
+		Attribute("lastUsed", DateTime, "Time that the authenticator was last used to login")
+		Required("id", "name", "timeCreated")
+	})
+	View("default", func() {
+		Attribute("id")
+		Attribute("name")
+		Attribute("timeCreated")
+		Attribute("lastUsed")
+	})
+})
+
+var WebauthnFinishParams = Type("webauthn-finish-params", func() {
+	Attribute("ceremony", UUID, "The ceremony ID returned when the ceremony was started")
+	Attribute("credential", Any, "The PublicKeyCredential returned by the browser")
+	Attribute("name", String, "A name for the authenticator, only used when registering", func() {
+		MaxLength(maxNameLength)
+	})
+	Required("ceremony", "credential")
+})
+
+var WebauthnLoginParams = Type("webauthn-login-params", func() {
+	Attribute("email", String, "The email address of the account to login to", func() {
+		Format("email")
+	})
+	Required("email")
+})
@@ -0,0 +1,52 @@
+package types
+
+import (
+	. "github.com/goadesign/goa/design"
+	. "github.com/goadesign/goa/design/apidsl"
+)
+
+var JSONWebKeyMedia = MediaType("json-web-key", func() {
+	Description("A public key that tokens are signed with, in the JWK format")
+	ContentType("application/json")
+
+	Attributes(func() {
+		Attribute("kty", String, "Key type")
+		Attribute("use", String, "Intended use of the key")
+		Attribute("kid", String, "Key ID, matches the kid header of the tokens signed with the key")
+		Attribute("alg", String, "Algorithm the key signs with")
+		Attribute("n", String, "Modulus of an RSA key")
+		Attribute("e", String, "Exponent of an RSA key")
+		Attribute("crv", String, "Curve of an EC or OKP key")
+		Attribute("x", String, "X coordinate of an EC key, or the public key of an OKP key")
+		Attribute("y", String, "Y coordinate of an EC key")
+
+		Required("kty", "use", "kid", "alg")
+	})
+
+	View("default", func() {
+		Attribute("kty")
+		Attribute("use")
+		Attribute("kid")
+		Attribute("alg")
+		Attribute("n")
+		Attribute("e")
+		Attribute("crv")
+		Attribute("x")
+		Attribute("y")
+	})
+})
+
+var JSONWebKeySetMedia = MediaType("json-web-key-set", func() {
+	Description("The public keys that tokens may be verified with")
+	ContentType("application/json")
+
+	Attributes(func() {
+		Attribute("keys", ArrayOf(JSONWebKeyMedia), "Keys that are signing or have signed tokens that are still valid")
+
+		Required("keys")
+	})
+
+	View("default", func() {
+		Attribute("keys")
+	})
+})
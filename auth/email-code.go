@@ -0,0 +1,115 @@
+package auth
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"gigglesearch.org/giggle-auth/auth/database"
+	"gigglesearch.org/giggle-auth/auth/models"
+	"gigglesearch.org/giggle-auth/auth/utils/crypto"
+	"gigglesearch.org/giggle-auth/utils/email"
+	"strings"
+	"time"
+)
+
+const (
+	emailCodeDigits      = 6
+	emailCodeExpiration  = 10 * time.Minute
+	emailCodeMaxAttempts = 5
+	emailCodeSendLimit   = 5
+	emailCodeSendWindow  = time.Hour
+)
+
+var ErrCodeInvalid = errors.New("Invalid or expired code")
+var ErrCodeLocked = errors.New("Too many incorrect attempts, wait for the code to expire and request a new one")
+
+// emailCodeRateLimited counts a code being sent to addr and reports whether too many have been sent recently
+func emailCodeRateLimited(ctx context.Context, addr string) (bool, error) {
+	count, err := database.IncrementRateLimit(ctx, "email-code:"+strings.ToLower(addr), emailCodeSendWindow)
+	if err != nil {
+		return false, err
+	}
+
+	return count > emailCodeSendLimit, nil
+}
+
+// sendEmailCode replaces any code the user has outstanding with a new one and emails it to addr. A code
+// that has been locked by too many incorrect attempts is kept until it expires.
+func sendEmailCode(ctx context.Context, u *models.User, addr string, login bool) error {
+	uID := u.ID.Hex()
+
+	old, err := database.GetEmailVerificationCode(ctx, uID, login)
+	if err == nil && old.Attempts >= emailCodeMaxAttempts && time.Now().Before(old.TimeExpires) {
+		return ErrCodeLocked
+	} else if err != nil && err != database.ErrEmailVerificationNotFound {
+		return err
+	}
+
+	if err := database.DeleteEmailVerificationCode(ctx, uID, login); err != nil {
+		return err
+	}
+
+	code, err := crypto.GenerateNumericCode(emailCodeDigits)
+	if err != nil {
+		return err
+	}
+
+	err = database.CreateEmailVerification(ctx, &database.EmailVerification{
+		Code:        crypto.HashCode(code),
+		Email:       addr,
+		Login:       login,
+		TimeExpires: time.Now().Add(emailCodeExpiration),
+		UserID:      uID,
+	})
+	if err != nil {
+		return err
+	}
+
+	subject := "Your verification code"
+	purpose := "verify your email"
+	if login {
+		subject = "Your login code"
+		purpose = "login to your account"
+	}
+	textContent := "Your code to " + purpose + " is " + code + ". It expires in 10 minutes."
+	htmlContent := "Your code to " + purpose + " is <b>" + code + "</b>. It expires in 10 minutes."
+
+	return email.SendMail(subject, u.FirstName+" "+u.LastName, addr, textContent, htmlContent)
+}
+
+// checkEmailCode checks code against the one the user was last sent. The code is removed once it has
+// been used and stops working after emailCodeMaxAttempts attempts.
+func checkEmailCode(ctx context.Context, userID, code string, login bool) (*database.EmailVerification, error) {
+	ev, err := database.GetEmailVerificationCode(ctx, userID, login)
+	if err == database.ErrEmailVerificationNotFound {
+		return nil, ErrCodeInvalid
+	} else if err != nil {
+		return nil, err
+	}
+
+	if time.Now().After(ev.TimeExpires) {
+		return nil, ErrCodeInvalid
+	}
+
+	// The attempt is counted before the code is compared, so guesses sent in parallel can't go past the limit
+	err = database.IncrementEmailVerificationCodeAttempts(ctx, userID, login, emailCodeMaxAttempts)
+	if err == database.ErrEmailVerificationNotFound {
+		return nil, ErrCodeLocked
+	} else if err != nil {
+		return nil, err
+	}
+
+	hash := crypto.HashCode(code)
+	if subtle.ConstantTimeCompare([]byte(hash), []byte(ev.Code)) != 1 {
+		return nil, ErrCodeInvalid
+	}
+
+	err = database.UseEmailVerificationCode(ctx, userID, login, hash)
+	if err == database.ErrEmailVerificationNotFound {
+		return nil, ErrCodeInvalid
+	} else if err != nil {
+		return nil, err
+	}
+
+	return ev, nil
+}
@@ -0,0 +1,170 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"gigglesearch.org/giggle-auth/utils/auth"
+	"gigglesearch.org/giggle-auth/utils/log"
+	jwtgo "github.com/dgrijalva/jwt-go"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// A token signed by an unknown key fetches the provider's keys again at most this often
+const idTokenKeysReloadInterval = time.Minute
+
+// idTokenKeysClient fetches the keys of providers, a provider that doesn't answer fails the sign in instead of
+// holding it up
+var idTokenKeysClient = &http.Client{Timeout: 10 * time.Second}
+
+var errUnknownIDTokenKey = errors.New("ID token was not signed by a known key")
+
+// idTokenVerifier verifies the OpenID Connect ID tokens of a provider with the keys it publishes. The keys are
+// cached until a token arrives signed by one that isn't known yet, which is how providers rotate them.
+type idTokenVerifier struct {
+	// Issuers of the tokens, {tenantid} stands for the tid claim of providers with an issuer for each tenant
+	issuers []string
+	jwksURL string
+	// emailVerified checks whether the provider verified the email of the user, the email is removed from the
+	// profile when it hasn't. It checks the email_verified claim when it isn't set.
+	emailVerified func(profile userProfile) bool
+
+	mu         sync.Mutex
+	keys       map[string]interface{}
+	lastReload time.Time
+}
+
+// verify checks the signature, issuer, audience, expiry and nonce of the ID token, and returns its claims as the
+// profile
+func (v *idTokenVerifier) verify(ctx context.Context, idToken, audience, nonce string) (userProfile, error) {
+	parser := &jwtgo.Parser{
+		// Providers sign with their RSA or EC keys, which also keeps out none and HMAC keyed with a public key
+		ValidMethods:  []string{jwtgo.SigningMethodRS256.Alg(), jwtgo.SigningMethodES256.Alg()},
+		UseJSONNumber: true,
+	}
+	claims := jwtgo.MapClaims{}
+	_, err := parser.ParseWithClaims(idToken, claims, func(t *jwtgo.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		return v.key(ctx, kid)
+	})
+	if err != nil {
+		log.Warning(ctx, "Invalid ID token from %s: %v", v.jwksURL, err)
+		return nil, errProviderData
+	}
+
+	profile := userProfile(claims)
+	if !v.issuedBy(profile) || !profile.hasAudience(audience) || profile["exp"] == nil {
+		log.Warning(ctx, "ID token from %s was not issued to us, iss=%s", v.jwksURL, profile.claim("iss"))
+		return nil, errProviderData
+	}
+	// The nonce ties the token to the connection, so a token issued for another one can't be replayed
+	if profile.claim("nonce") != nonce {
+		log.Warning(ctx, "ID token from %s has the wrong nonce, iss=%s", v.jwksURL, profile.claim("iss"))
+		return nil, errProviderData
+	}
+
+	emailVerified := userProfile.emailVerified
+	if v.emailVerified != nil {
+		emailVerified = v.emailVerified
+	}
+	// An email the provider hasn't verified may not belong to the user, so it can't be trusted to find accounts
+	if !emailVerified(profile) {
+		delete(profile, "email")
+	}
+	return profile, nil
+}
+
+// key returns the public key with the kid, fetching the provider's keys again when it isn't known
+func (v *idTokenVerifier) key(ctx context.Context, kid string) (interface{}, error) {
+	v.mu.Lock()
+	key, ok := v.keys[kid]
+	reload := !ok && time.Since(v.lastReload) >= idTokenKeysReloadInterval
+	if reload {
+		v.lastReload = time.Now()
+	}
+	v.mu.Unlock()
+
+	if ok {
+		return key, nil
+	}
+	// Tokens that arrive while the keys are being fetched are rejected rather than waiting on the provider
+	if !reload {
+		return nil, errUnknownIDTokenKey
+	}
+
+	keys, err := v.fetchKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	v.mu.Lock()
+	v.keys = keys
+	v.mu.Unlock()
+
+	if key, ok := keys[kid]; ok {
+		return key, nil
+	}
+	return nil, errUnknownIDTokenKey
+}
+
+// fetchKeys gets the keys the provider publishes at its JWKS URL
+func (v *idTokenVerifier) fetchKeys(ctx context.Context) (map[string]interface{}, error) {
+	var set struct {
+		Keys []auth.JSONWebKey `json:"keys"`
+	}
+	if err := getProviderJSON(idTokenKeysClient, v.jwksURL, &set); err != nil {
+		return nil, err
+	}
+
+	keys := map[string]interface{}{}
+	for _, jwk := range set.Keys {
+		if jwk.Use == "enc" {
+			continue
+		}
+		key, err := jwk.PublicKey()
+		if err != nil {
+			log.Warning(ctx, "Unable to parse key %s of %s: %v", jwk.Kid, v.jwksURL, err)
+			continue
+		}
+		keys[jwk.Kid] = key
+	}
+	return keys, nil
+}
+
+// issuedBy checks the iss claim against the issuers of the provider
+func (v *idTokenVerifier) issuedBy(profile userProfile) bool {
+	iss := profile.claim("iss")
+	for _, issuer := range v.issuers {
+		if strings.Replace(issuer, "{tenantid}", profile.claim("tid"), 1) == iss {
+			return true
+		}
+	}
+	return false
+}
+
+// emailVerified checks the email_verified claim, which Apple sends as a string
+func (p userProfile) emailVerified() bool {
+	switch v := p["email_verified"].(type) {
+	case bool:
+		return v
+	case string:
+		return v == "true"
+	}
+	return false
+}
+
+// hasAudience checks the aud claim, which is either a single audience or an array of them
+func (p userProfile) hasAudience(audience string) bool {
+	switch aud := p["aud"].(type) {
+	case string:
+		return aud == audience
+	case []interface{}:
+		for _, a := range aud {
+			if a == audience {
+				return true
+			}
+		}
+	}
+	return false
+}
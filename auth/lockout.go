@@ -0,0 +1,148 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"gigglesearch.org/giggle-auth/auth/database"
+	"gigglesearch.org/giggle-auth/auth/models"
+	"gigglesearch.org/giggle-auth/auth/utils/crypto"
+	"gigglesearch.org/giggle-auth/utils/email"
+	"gigglesearch.org/giggle-auth/utils/log"
+	"gigglesearch.org/giggle-auth/utils/secrets"
+	"github.com/gofrs/uuid"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const (
+	loginDelayAfter = 3
+	loginMaxDelay   = 30 * time.Second
+	loginLockAfter  = 10
+	loginLockTime   = 30 * time.Minute
+	loginIPLimit    = 50
+	loginIPWindow   = time.Hour
+	unlockPath      = "/login/unlock"
+)
+
+var ErrAccountLocked = errors.New("Account is locked after too many failed logins, check your email to unlock it")
+
+// loginDelay is how long to wait after the last failed login before another password is checked
+func loginDelay(failures int) time.Duration {
+	if failures < loginDelayAfter {
+		return 0
+	}
+
+	n := uint(failures - loginDelayAfter)
+	if n > 5 {
+		return loginMaxDelay
+	}
+
+	delay := time.Second << n
+	if delay > loginMaxDelay {
+		return loginMaxDelay
+	}
+
+	return delay
+}
+
+// startLoginAttempt counts an attempt from ip against u before the password or code is checked, so guesses sent
+// in parallel can't get past the delay, the lock or the IP limit. It returns u with the attempt counted as a
+// failure, or how long the caller has to wait, along with ErrAccountLocked if u is locked. The lock is ignored
+// when ignoreLock is set. u is nil if no account was found.
+func startLoginAttempt(ctx context.Context, u *models.User, ip string, ignoreLock bool) (*models.User, time.Duration, error) {
+	count, err := database.IncrementRateLimit(ctx, "login-ip:"+ip, loginIPWindow)
+	if err != nil {
+		return nil, 0, err
+	}
+	if count > loginIPLimit {
+		rl, err := database.GetRateLimit(ctx, "login-ip:"+ip)
+		if err != nil {
+			return nil, 0, err
+		}
+		return nil, atLeastSecond(time.Until(rl.TimeExpire)), nil
+	}
+
+	if u == nil {
+		return nil, 0, nil
+	}
+
+	updated, err := database.StartUserLoginAttempt(ctx, u.ID.Hex(), loginLockAfter, loginDelay, ignoreLock)
+	if err != database.ErrUserNotFound {
+		return updated, 0, err
+	}
+
+	// The attempt was refused, the user has to be read again to tell whether they are locked or have to wait
+	current, err := database.GetUser(ctx, u.ID.Hex())
+	if err != nil {
+		return nil, 0, err
+	}
+	if wait := time.Until(current.LockedUntil); wait > 0 && !ignoreLock {
+		return nil, wait, ErrAccountLocked
+	}
+
+	return nil, atLeastSecond(time.Until(current.LastFailedLogin.Add(loginDelay(current.FailedLogins)))), nil
+}
+
+// atLeastSecond keeps a wait that ran out while it was worked out from telling the caller to go ahead
+func atLeastSecond(wait time.Duration) time.Duration {
+	if wait < time.Second {
+		return time.Second
+	}
+
+	return wait
+}
+
+// recordLoginFailure is called once the attempt counted by startLoginAttempt turns out to be wrong. Once u has
+// had loginLockAfter failures in a row the account is locked and the user is emailed a link to unlock it.
+func recordLoginFailure(ctx context.Context, u *models.User) error {
+	if u == nil || u.FailedLogins < loginLockAfter {
+		return nil
+	}
+
+	return lockAccount(ctx, u)
+}
+
+// lockAccount locks u for loginLockTime and emails them a link to unlock it early
+func lockAccount(ctx context.Context, u *models.User) error {
+	uID := u.ID.Hex()
+
+	token, err := uuid.NewV4()
+	if err != nil {
+		return err
+	}
+
+	err = database.LockUser(ctx, uID, time.Now().Add(loginLockTime), crypto.HashCode(token.String()))
+	if err != nil {
+		return err
+	}
+
+	link := secrets.URL + unlockPath + "?uid=" + url.QueryEscape(uID) + "&token=" + url.QueryEscape(token.String())
+	textContent := "Your account was locked for 30 minutes because of too many failed logins. Go to " + link + " to unlock it now. If this was not you, consider changing your password."
+	htmlContent := "Your account was locked for 30 minutes because of too many failed logins. Click <a href=\"" + link + "\">here</a> to unlock it now.<br>If this was not you, consider changing your password."
+
+	if err := email.SendMail("Your account has been locked", u.FirstName+" "+u.LastName, u.Email, textContent, htmlContent); err != nil {
+		log.Warning(ctx, "Unable to send unlock email, userID=%s, err=%v", uID, err)
+	}
+
+	return nil
+}
+
+// clearLoginFailures resets the user's failed logins once they have proven who they are, and takes the attempt
+// back from the IP limit
+func clearLoginFailures(ctx context.Context, u *models.User, ip string) error {
+	if err := database.DecrementRateLimit(ctx, "login-ip:"+ip); err != nil {
+		return err
+	}
+
+	if u.FailedLogins == 0 && u.LockedUntil.IsZero() && u.UnlockToken == "" {
+		return nil
+	}
+
+	return database.UnlockUser(ctx, u.ID.Hex())
+}
+
+// retryAfter formats wait for a Retry-After header
+func retryAfter(wait time.Duration) string {
+	return strconv.Itoa(int((wait + time.Second - 1) / time.Second))
+}
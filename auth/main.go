@@ -6,9 +6,13 @@ import (
 	"context"
 	"gigglesearch.org/giggle-auth/auth/app"
 	"gigglesearch.org/giggle-auth/auth/models"
+	"gigglesearch.org/giggle-auth/auth/utils/crypto"
 	"gigglesearch.org/giggle-auth/utils/auth"
 	"gigglesearch.org/giggle-auth/utils/database"
 	"gigglesearch.org/giggle-auth/utils/log"
+	"gigglesearch.org/giggle-auth/utils/password"
+	"gigglesearch.org/giggle-auth/utils/secrets"
+	"gigglesearch.org/giggle-auth/utils/sms"
 	"github.com/goadesign/goa"
 	"github.com/goadesign/goa/middleware"
 	log2 "log"
@@ -20,6 +24,9 @@ var errMustBeAbleToLogin = goa.NewErrorClass("cannot-remove-last-login-option",
 var errDisposable = goa.NewErrorClass("disposable-email", http.StatusForbidden)
 var errBadReset = goa.NewErrorClass("bad-reset", http.StatusForbidden)
 var errTokenMismatch = goa.NewErrorClass("token-mismatch", http.StatusForbidden)
+var errCodeLocked = goa.NewErrorClass("code-locked", http.StatusForbidden)
+var errAccountLocked = goa.NewErrorClass("account-locked", http.StatusForbidden)
+var errPasswordPolicy = goa.NewErrorClass("password-policy", http.StatusBadRequest)
 
 func main() {
 	// Create service
@@ -31,12 +38,22 @@ func main() {
 	logger := log.NewLogger(nil)
 	service.WithLogger(logger)
 
+	if err := runMigrations(goa.WithLogger(context.Background(), logger)); err != nil {
+		panic(err)
+	}
+
 	jwtSec, err := auth.NewJWTSecurity()
 	if err != nil {
 		log.Critical(context.WithValue(context.Background(), "%v", err), "%v", err)
 	}
 
-	jwtMiddle, err := auth.NewJWTMiddleware(app.NewJWTSecurity())
+	// Load the rotated signing keys before serving so tokens are signed with the current key
+	if err := jwtSec.KeyRing().Load(goa.WithLogger(context.Background(), logger)); err != nil {
+		panic(err)
+	}
+	go jwtSec.KeyRing().RunRotation(goa.WithLogger(context.Background(), logger))
+
+	jwtMiddle, err := auth.NewJWTMiddleware(app.NewJWTSecurity(), jwtSec.KeyRing())
 	if err != nil {
 		panic(err)
 	}
@@ -46,6 +63,37 @@ func main() {
 		panic(err)
 	}
 
+	// Text messages are only logged in development
+	if secrets.TwilioAccountSID != "" {
+		sms.Sender = sms.NewTwilioSender(secrets.TwilioAccountSID, secrets.TwilioAuthToken, secrets.TwilioFromNumber)
+	} else {
+		sms.Sender = sms.NewLogSender(secrets.SMSLogFile)
+	}
+
+	switch secrets.PasswordHashAlgorithm {
+	case "argon2id":
+		crypto.CurrentHasher = &crypto.Argon2idHasher{Memory: secrets.Argon2Memory, Time: secrets.Argon2Time, Threads: secrets.Argon2Threads}
+	case "scrypt":
+		crypto.CurrentHasher = &crypto.ScryptHasher{LogN: secrets.ScryptLogN, R: 8, P: 1}
+	case "bcrypt":
+		crypto.CurrentHasher = &crypto.BcryptHasher{Cost: secrets.BcryptCost}
+	default:
+		panic("unknown password hash algorithm " + secrets.PasswordHashAlgorithm)
+	}
+
+	password.Default = &password.Policy{
+		MinLength:  secrets.PasswordMinLength,
+		MinClasses: secrets.PasswordMinClasses,
+		History:    secrets.PasswordHistory,
+		Verify: func(pass, hash string) (bool, error) {
+			ok, _, err := crypto.VerifyPassword(pass, hash)
+			return ok, err
+		},
+	}
+	if secrets.BreachedPasswordDir != "" {
+		password.Default.Breached = password.RangeDir(secrets.BreachedPasswordDir)
+	}
+
 	// Mount middleware
 	service.Use(log.LogContextMiddleware)
 	service.Use(log.LogInternalError)
@@ -60,26 +108,13 @@ func main() {
 	// Mount "session" controller
 	c4 := NewSessionController(service, jwtSec)
 	app.MountSessionController(service, c4)
-	// Mount "facebook" controller
-	c := NewFacebookController(service, jwtSec, c4)
-	app.MountFacebookController(service, c)
-	// Mount "google" controller
-	c2 := NewGoogleController(service, jwtSec, c4)
-	app.MountGoogleController(service, c2)
-	// Mount "password-auth" controller
-
-	l := NewLinkedinController(service, jwtSec, c4)
-	app.MountLinkedinController(service, l)
-
-	m := NewMicrosoftController(service, jwtSec, c4)
-	app.MountMicrosoftController(service, m)
+	// Mount "social" controller
+	so := NewSocialController(service, jwtSec, c4)
+	app.MountSocialController(service, so)
 
 	t := NewTwitterController(service, jwtSec, c4)
 	app.MountTwitterController(service, t)
 
-	a := NewAmazonController(service, jwtSec, c4)
-	app.MountAmazonController(service, a)
-
 	n := NewNewsletterController(service, jwtSec)
 	app.MountNewsletterController(service, n)
 
@@ -92,6 +127,24 @@ func main() {
 	c5 := NewUserController(service, jwtSec)
 	app.MountUserController(service, c5)
 
+	tf := NewTwoFactorController(service, jwtSec, c4)
+	app.MountTwoFactorController(service, tf)
+
+	w := NewWebauthnController(service, jwtSec, c4)
+	app.MountWebauthnController(service, w)
+
+	wk := NewWellKnownController(service, jwtSec)
+	app.MountWellKnownController(service, wk)
+
+	tk := NewTokenController(service, jwtSec)
+	app.MountTokenController(service, tk)
+
+	o := NewOauthController(service, jwtSec, c4)
+	app.MountOauthController(service, o)
+
+	cl := NewClientController(service, jwtSec)
+	app.MountClientController(service, cl)
+
 	log2.Fatal(service.ListenAndServe("http://localhost:4000"))
 }
 
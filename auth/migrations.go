@@ -0,0 +1,111 @@
+package auth
+
+import (
+	"context"
+	"gigglesearch.org/giggle-auth/auth/database"
+	"gigglesearch.org/giggle-auth/utils/auth"
+	"gigglesearch.org/giggle-auth/utils/log"
+	"time"
+)
+
+// How long accounts marked by markLegacySocialAccounts can still be found by their email
+const legacySocialAccountExpire = 180 * 24 * time.Hour
+
+// migrations are run in order on startup, each one only runs once. Migrations have to be safe to run
+// again in case two instances start at the same time.
+var migrations = []struct {
+	id  string
+	run func(ctx context.Context) error
+}{
+	{"strip-user-passwords", stripUserPasswords},
+	{"import-api-keys", importAPIKeys},
+	{"social-accounts", importSocialAccounts},
+	{"social-account-subjects", markLegacySocialAccounts},
+}
+
+func runMigrations(ctx context.Context) error {
+	for _, m := range migrations {
+		applied, err := database.IsMigrationApplied(ctx, m.id)
+		if err != nil {
+			return err
+		}
+		if applied {
+			continue
+		}
+
+		log.Info(ctx, "Running migration %s", m.id)
+		if err := m.run(ctx); err != nil {
+			return err
+		}
+
+		if err := database.SetMigrationApplied(ctx, m.id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func stripUserPasswords(ctx context.Context) error {
+	n, err := database.StripUserPasswords(ctx)
+	if err != nil {
+		return err
+	}
+
+	log.Info(ctx, "Removed password hashes from %d users", n)
+	return nil
+}
+
+func importAPIKeys(ctx context.Context) error {
+	n, err := auth.ImportAPIKeys()
+	if err != nil {
+		return err
+	}
+
+	log.Info(ctx, "Registered %d API keys as clients", n)
+	return nil
+}
+
+// importSocialAccounts copies the accounts of the OAuth 2.0 providers from the collections each of them used to
+// have into the social-account collection. Registrations and connections in progress are not copied, they only
+// last an hour.
+func importSocialAccounts(ctx context.Context) error {
+	legacy := []struct {
+		provider   string
+		collection string
+		idField    string
+	}{
+		{"google", "google-account", "google_email"},
+		{"facebook", "facebook-account", "id"},
+		{"linkedin", "linkedin-account", "linkedin_email"},
+		{"microsoft", "microsoft-account", "microsoft_email"},
+		{"amazon", "amazon-account", "amazon_email"},
+	}
+
+	for _, l := range legacy {
+		n, err := database.ImportSocialAccounts(ctx, l.provider, l.collection, l.idField)
+		if err != nil {
+			return err
+		}
+
+		log.Info(ctx, "Copied %d %s accounts", n, l.provider)
+	}
+	return nil
+}
+
+// markLegacySocialAccounts marks the Google and Microsoft accounts that were attached by the user's email, which
+// can change and be reused by someone else, as legacy. The backfill is lazy: the subject of the user's ID token
+// is only sent to us when they sign in, so each account is switched to it then. Accounts that aren't signed in
+// with before legacySocialAccountExpire stop matching by email, and have to be attached again.
+func markLegacySocialAccounts(ctx context.Context) error {
+	expires := time.Now().Add(legacySocialAccountExpire)
+	for _, provider := range []string{"google", "microsoft"} {
+		n, err := database.MarkLegacySocialAccounts(ctx, provider, expires)
+		if err != nil {
+			return err
+		}
+
+		log.Info(ctx, "Marked %d %s accounts as legacy", n, provider)
+	}
+	return nil
+}
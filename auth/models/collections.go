@@ -3,62 +3,61 @@ package models
 import (
 	"gigglesearch.org/giggle-auth/utils/database"
 	"github.com/globalsign/mgo"
+	"time"
 )
 
 var PasswordLoginCollection *mgo.Collection
 var UsersCollection *mgo.Collection
 var ResetPasswordCollection *mgo.Collection
-var FacebookAccountCollection *mgo.Collection
-var FacebookRegisterCollection *mgo.Collection
-var FacebookConnectionCollection *mgo.Collection
+var SocialAccountCollection *mgo.Collection
+var SocialRegisterCollection *mgo.Collection
+var SocialConnectionCollection *mgo.Collection
 var TwitterAccountCollection *mgo.Collection
 var TwitterRegisterCollection *mgo.Collection
 var TwitterConnectionCollection *mgo.Collection
 var TwitterTokenCollection *mgo.Collection
-var GoogleAccountCollection *mgo.Collection
-var GoogleRegisterCollection *mgo.Collection
-var GoogleConnectionCollection *mgo.Collection
-var AmazonAccountCollection *mgo.Collection
-var AmazonRegisterCollection *mgo.Collection
-var AmazonConnectionCollection *mgo.Collection
-var LinkedinAccountCollection *mgo.Collection
-var LinkedinRegisterCollection *mgo.Collection
-var LinkedinConnectionCollection *mgo.Collection
-var MicrosoftAccountCollection *mgo.Collection
-var MicrosoftRegisterCollection *mgo.Collection
-var MicrosoftConnectionCollection *mgo.Collection
 var NewsletterSubscribersCollection *mgo.Collection
 var SessionsCollection *mgo.Collection
 var LoginTokenCollection *mgo.Collection
 var MergeTokenCollection *mgo.Collection
 var EmailVerificationCollection *mgo.Collection
+var TwoFactorChallengeCollection *mgo.Collection
+var WebauthnCredentialCollection *mgo.Collection
+var WebauthnSessionCollection *mgo.Collection
+var RateLimitCollection *mgo.Collection
+var PhoneVerificationCollection *mgo.Collection
+var MigrationCollection *mgo.Collection
+var AuthorizationRequestCollection *mgo.Collection
 
 func InitCollections() {
 	PasswordLoginCollection = database.GetCollection("password-login")
 	UsersCollection = database.GetCollection("users")
 	ResetPasswordCollection = database.GetCollection("reset-password")
-	FacebookAccountCollection = database.GetCollection("facebook-account")
-	FacebookConnectionCollection = database.GetCollection("facebook-connection")
-	FacebookRegisterCollection = database.GetCollection("facebook-register")
+	SocialAccountCollection = database.GetCollection("social-account")
+	// A provider's account can only be attached to one user, even when two requests attach it at once
+	_ = SocialAccountCollection.EnsureIndex(mgo.Index{Key: []string{"provider", "provider_id"}, Unique: true})
+	SocialConnectionCollection = database.GetCollection("social-connection")
+	// Connections the user never came back from are removed once they expire
+	_ = SocialConnectionCollection.EnsureIndex(mgo.Index{Key: []string{"time_expires"}, ExpireAfter: time.Second})
+	SocialRegisterCollection = database.GetCollection("social-register")
 	TwitterAccountCollection = database.GetCollection("twitter-account")
 	TwitterConnectionCollection = database.GetCollection("twitter-connection")
 	TwitterRegisterCollection = database.GetCollection("twitter-register")
 	TwitterTokenCollection = database.GetCollection("twitter-token")
-	GoogleAccountCollection = database.GetCollection("google-account")
-	GoogleConnectionCollection = database.GetCollection("google-connection")
-	GoogleRegisterCollection = database.GetCollection("google-register")
-	AmazonAccountCollection = database.GetCollection("amazon-account")
-	AmazonConnectionCollection = database.GetCollection("amazon-connection")
-	AmazonRegisterCollection = database.GetCollection("amazon-register")
-	LinkedinAccountCollection = database.GetCollection("linkedin-account")
-	LinkedinConnectionCollection = database.GetCollection("linkedin-connection")
-	LinkedinRegisterCollection = database.GetCollection("linkedin-register")
-	MicrosoftAccountCollection = database.GetCollection("microsoft-account")
-	MicrosoftConnectionCollection = database.GetCollection("microsoft-connection")
-	MicrosoftRegisterCollection = database.GetCollection("microsoft-register")
 	NewsletterSubscribersCollection = database.GetCollection("newsletter-subscribers")
 	SessionsCollection = database.GetCollection("sessions")
 	LoginTokenCollection = database.GetCollection("login-token")
 	MergeTokenCollection = database.GetCollection("merge-token")
 	EmailVerificationCollection = database.GetCollection("email-verification")
+	TwoFactorChallengeCollection = database.GetCollection("two-factor-challenge")
+	WebauthnCredentialCollection = database.GetCollection("webauthn-credential")
+	WebauthnSessionCollection = database.GetCollection("webauthn-session")
+	RateLimitCollection = database.GetCollection("rate-limit")
+	// Each key has one window, so concurrent first hits can't start two of them
+	_ = RateLimitCollection.EnsureIndex(mgo.Index{Key: []string{"key"}, Unique: true})
+	PhoneVerificationCollection = database.GetCollection("phone-verification")
+	MigrationCollection = database.GetCollection("migration")
+	AuthorizationRequestCollection = database.GetCollection("authorization-request")
+	// Requests that were never approved or whose code was never redeemed are removed once they expire
+	_ = AuthorizationRequestCollection.EnsureIndex(mgo.Index{Key: []string{"time_expire"}, ExpireAfter: time.Second})
 }
@@ -2,6 +2,7 @@ package models
 
 import (
 	"github.com/globalsign/mgo/bson"
+	"time"
 )
 
 type User struct {
@@ -14,23 +15,37 @@ type User struct {
 	Bookmarks      []Bookmark    `json:"bookmarks" bson:"bookmarks"`
 	ChangingEmail  string        `json:"changing_email" bson:"changing_email"`
 	VerifiedEmail  bool          `json:"verified_email" bson:"verified_email"`
+	VerifiedPhone  bool          `json:"verified_phone" bson:"verified_phone"`
 	IsAdmin        bool          `json:"is_admin" bson:"is_admin"`
 	IsPluginAuthor bool          `json:"is_plugin_author" bson:"is_plugin_author"`
 	IsEventAuthor  bool          `json:"is_event_author" bson:"is_event_author"`
 	GetNewsletter  bool          `json:"get_newsletter" bson:"get_newsletter"`
-	Password       string        `json:"password" bson:"password"`
 	ProfileImage   string        `json:"profile_image" bson:"profile_image"`
 	Gender         string        `json:"gender" bson:"gender"`
+	// TwoFactorSecret is the TOTP secret, encrypted with secrets.TwoFactorKey
+	TwoFactorSecret  string `json:"-" bson:"two_factor_secret"`
+	TwoFactorEnabled bool   `json:"two_factor_enabled" bson:"two_factor_enabled"`
+	// TwoFactorStep is the TOTP time step of the last passcode accepted, it and earlier steps are refused
+	TwoFactorStep int64 `json:"-" bson:"two_factor_step"`
+	// FailedLogins counts incorrect passwords since the last successful login or lockout
+	FailedLogins    int       `json:"failed_logins" bson:"failed_logins"`
+	LastFailedLogin time.Time `json:"last_failed_login" bson:"last_failed_login"`
+	LockedUntil     time.Time `json:"locked_until" bson:"locked_until"`
+	// UnlockToken is the hash of the token emailed to the user when their account was locked
+	UnlockToken string `json:"-" bson:"unlock_token"`
 }
 
+// PasswordLogin is the only place a user's password hash is stored, the hashes are never serialized
 type PasswordLogin struct {
 	ID bson.ObjectId `bson:"_id,omitempty"`
 
 	Email string `bson:"email"`
 
-	Password string `bson:"password"`
+	Password string `json:"-" bson:"password"`
 
-	Recovery string `bson:"recovery"`
+	History []string `json:"-" bson:"history"`
+
+	Recovery []string `json:"-" bson:"recovery"`
 
 	UserID string `bson:"user_id"`
 }
@@ -23,7 +23,7 @@ func NewNewsletterController(service *goa.Service, jwtSec auth.JWTSecurity) *New
 }
 
 func (c *NewsletterController) GetSubscribers(ctx *app.GetSubscribersNewsletterContext) error {
-	if c.IsAdmin(ctx.Request) {
+	if c.Claims(ctx).HasAdminAccess() {
 		subs, err := database.GetNewsletterSubscribers()
 		if err != nil {
 			return ctx.InternalServerError(goa.ErrInternal(err))
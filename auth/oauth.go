@@ -0,0 +1,524 @@
+package auth
+
+import (
+	"errors"
+	"gigglesearch.org/giggle-auth/auth/app"
+	"gigglesearch.org/giggle-auth/auth/database"
+	"gigglesearch.org/giggle-auth/auth/models"
+	"gigglesearch.org/giggle-auth/auth/utils/crypto"
+	"gigglesearch.org/giggle-auth/utils/auth"
+	"gigglesearch.org/giggle-auth/utils/secrets"
+	jwtgo "github.com/dgrijalva/jwt-go"
+	"github.com/goadesign/goa"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	authorizationRequestTime = 10 * time.Minute
+	authorizationCodeTime    = time.Minute
+	idTokenTime              = time.Hour
+	// Service accounts authenticate again instead of refreshing, so their tokens don't need to last long
+	serviceTokenTime = 5 * time.Minute
+
+	oauthBasePath = "/api/v1/user/oauth"
+)
+
+var errInvalidClient = errors.New("Client authentication failed")
+
+// Grant types supported by the token endpoint
+var grantTypes = []string{auth.GrantAuthorizationCode, auth.GrantRefreshToken, auth.GrantClientCredentials}
+
+// Scopes that only choose the claims in ID tokens and userinfo responses, every client can be granted them. They
+// never grant access to the API, even where an API scope has the same name.
+var oidcScopes = []string{"openid", "profile", "email", "phone"}
+
+// OauthController implements the oauth resource.
+type OauthController struct {
+	*goa.Controller
+	auth.JWTSecurity
+	sessionController *SessionController
+}
+
+// NewOauthController creates a oauth controller.
+func NewOauthController(service *goa.Service, jwtSec auth.JWTSecurity, sesCont *SessionController) *OauthController {
+	return &OauthController{
+		Controller:        service.NewController("OauthController"),
+		JWTSecurity:       jwtSec,
+		sessionController: sesCont,
+	}
+}
+
+// Authorize runs the authorize action.
+func (c *OauthController) Authorize(ctx *app.AuthorizeOauthContext) error {
+	// OauthController_Authorize: start_implement
+
+	client, err := auth.GetClient(ctx.ClientID)
+	if err == auth.ErrClientNotFound {
+		return ctx.BadRequest(goa.ErrBadRequest("Unknown client"))
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+	// Errors are only sent to registered redirect URIs, otherwise this would be an open redirect
+	if !client.HasRedirectURI(ctx.RedirectURI) {
+		return ctx.BadRequest(goa.ErrBadRequest("Redirect URI is not registered for the client"))
+	}
+
+	state := optionalString(ctx.State)
+	fail := func(code, description string) error {
+		ctx.ResponseData.Header().Set("Location", withQuery(ctx.RedirectURI, url.Values{
+			"error":             {code},
+			"error_description": {description},
+			"state":             {state},
+		}))
+		return ctx.Found()
+	}
+
+	if !client.HasGrantType(auth.GrantAuthorizationCode) {
+		return fail("unauthorized_client", "The client is not allowed to use the authorization code grant")
+	}
+	if optionalString(ctx.ResponseType) != "code" {
+		return fail("unsupported_response_type", "Only the code response type is supported")
+	}
+	if optionalString(ctx.CodeChallenge) == "" {
+		return fail("invalid_request", "A PKCE code challenge is required")
+	}
+	if optionalString(ctx.CodeChallengeMethod) != "S256" {
+		return fail("invalid_request", "Only the S256 code challenge method is supported")
+	}
+
+	scopes := grantScopes(client, optionalString(ctx.Scope))
+	if !containsString(scopes, "openid") {
+		return fail("invalid_scope", "The openid scope is required")
+	}
+
+	ar := &database.AuthorizationRequest{
+		ClientID:      ctx.ClientID,
+		CodeChallenge: *ctx.CodeChallenge,
+		Nonce:         optionalString(ctx.Nonce),
+		RedirectURI:   ctx.RedirectURI,
+		Scopes:        scopes,
+		State:         state,
+		TimeExpire:    time.Now().Add(authorizationRequestTime),
+	}
+	reqID, err := database.CreateAuthorizationRequest(ctx, ar)
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	ctx.ResponseData.Header().Set("Location", withQuery(secrets.OIDCLoginURL, url.Values{"request": {reqID.String()}}))
+	return ctx.Found()
+
+	// OauthController_Authorize: end_implement
+}
+
+// GetRequest runs the get-request action.
+func (c *OauthController) GetRequest(ctx *app.GetRequestOauthContext) error {
+	// OauthController_GetRequest: start_implement
+
+	ar, err := database.GetAuthorizationRequest(ctx, ctx.RequestID)
+	if err == database.ErrAuthorizationRequestNotFound || (err == nil && !requestPending(ar)) {
+		return ctx.NotFound(goa.ErrNotFound("Authorization request not found"))
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	client, err := auth.GetClient(ar.ClientID)
+	if err == auth.ErrClientNotFound {
+		return ctx.NotFound(goa.ErrNotFound("Authorization request not found"))
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	name := client.Name
+	if name == "" {
+		name = client.ID
+	}
+
+	return ctx.OK(&app.OauthRequest{
+		ClientName: name,
+		ClientLogo: nonEmpty(client.LogoURL),
+		Scopes:     ar.Scopes,
+	})
+
+	// OauthController_GetRequest: end_implement
+}
+
+// ApproveRequest runs the approve-request action.
+func (c *OauthController) ApproveRequest(ctx *app.ApproveRequestOauthContext) error {
+	// OauthController_ApproveRequest: start_implement
+
+	ar, err := database.GetAuthorizationRequest(ctx, ctx.RequestID)
+	if err == database.ErrAuthorizationRequestNotFound || (err == nil && !requestPending(ar)) {
+		return ctx.NotFound(goa.ErrNotFound("Authorization request not found"))
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	code, err := crypto.GenerateToken(32)
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	err = database.ApproveAuthorizationRequest(ctx, ar.ID, c.Claims(ctx).UserID(), crypto.HashCode(code), time.Now().Add(authorizationCodeTime))
+	if err == database.ErrAuthorizationRequestNotFound {
+		return ctx.NotFound(goa.ErrNotFound("Authorization request not found"))
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	return ctx.OK(&app.OauthRedirect{
+		RedirectURL: withQuery(ar.RedirectURI, url.Values{"code": {code}, "state": {ar.State}}),
+	})
+
+	// OauthController_ApproveRequest: end_implement
+}
+
+// Token runs the token action.
+func (c *OauthController) Token(ctx *app.TokenOauthContext) error {
+	// OauthController_Token: start_implement
+
+	ctx.ResponseData.Header().Set("Cache-Control", "no-store")
+	ctx.ResponseData.Header().Set("Pragma", "no-cache")
+
+	client, err := c.authenticateClient(ctx)
+	if err == errInvalidClient {
+		if _, _, ok := ctx.Request.BasicAuth(); ok {
+			ctx.ResponseData.Header().Set("WWW-Authenticate", `Basic realm="token"`)
+		}
+		return ctx.Unauthorized(oauthError("invalid_client", "Client authentication failed"))
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	grant := ctx.Payload.GrantType
+	if containsString(grantTypes, grant) && !client.HasGrantType(grant) {
+		return ctx.BadRequest(oauthError("unauthorized_client", "The client is not allowed to use the "+grant+" grant"))
+	}
+
+	switch grant {
+	case auth.GrantAuthorizationCode:
+		return c.redeemCode(ctx, client)
+	case auth.GrantRefreshToken:
+		return c.refreshToken(ctx, client)
+	case auth.GrantClientCredentials:
+		return c.issueServiceToken(ctx, client)
+	default:
+		return ctx.BadRequest(oauthError("unsupported_grant_type", "Only the "+strings.Join(grantTypes, ", ")+" grants are supported"))
+	}
+
+	// OauthController_Token: end_implement
+}
+
+// authenticateClient gets the client from the client_secret_basic credentials or the payload, confidential
+// clients have to send their secret
+func (c *OauthController) authenticateClient(ctx *app.TokenOauthContext) (*auth.Client, error) {
+	clientID, secret := optionalString(ctx.Payload.ClientID), optionalString(ctx.Payload.ClientSecret)
+	if user, pass, ok := ctx.Request.BasicAuth(); ok {
+		// The credentials are form encoded before they are put in the header
+		var err error
+		if clientID, err = url.QueryUnescape(user); err != nil {
+			return nil, errInvalidClient
+		}
+		if secret, err = url.QueryUnescape(pass); err != nil {
+			return nil, errInvalidClient
+		}
+	}
+	if clientID == "" {
+		return nil, errInvalidClient
+	}
+
+	client, err := auth.GetClient(clientID)
+	if err == auth.ErrClientNotFound {
+		return nil, errInvalidClient
+	} else if err != nil {
+		return nil, err
+	}
+
+	if client.Confidential() && !client.VerifySecret(secret) {
+		return nil, errInvalidClient
+	}
+	return client, nil
+}
+
+func (c *OauthController) redeemCode(ctx *app.TokenOauthContext, client *auth.Client) error {
+	p := ctx.Payload
+	if p.Code == nil || p.RedirectURI == nil || p.CodeVerifier == nil {
+		return ctx.BadRequest(oauthError("invalid_request", "The code, redirect_uri and code_verifier are required"))
+	}
+
+	ar, err := database.RedeemAuthorizationCode(ctx, crypto.HashCode(*p.Code))
+	if err == database.ErrAuthorizationRequestNotFound {
+		return ctx.BadRequest(oauthError("invalid_grant", "Invalid authorization code"))
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	if ar.TimeExpire.Before(time.Now()) || ar.ClientID != client.ID || ar.RedirectURI != *p.RedirectURI {
+		return ctx.BadRequest(oauthError("invalid_grant", "Invalid authorization code"))
+	}
+	if crypto.CodeChallenge(*p.CodeVerifier) != ar.CodeChallenge {
+		return ctx.BadRequest(oauthError("invalid_grant", "Code verifier does not match the code challenge"))
+	}
+
+	u, err := database.GetUser(ctx, ar.UserID)
+	if err == database.ErrUserNotFound {
+		return ctx.BadRequest(oauthError("invalid_grant", "Invalid authorization code"))
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	// The session is created for the client, with the API scopes the user approved. The OpenID Connect scopes
+	// are kept apart so they only choose the claims.
+	var apiScopes, claimScopes []string
+	for _, scope := range ar.Scopes {
+		if containsString(oidcScopes, scope) {
+			claimScopes = append(claimScopes, scope)
+		} else if containsString(client.TokenScopes(), scope) {
+			apiScopes = append(apiScopes, scope)
+		}
+	}
+	ctx.Context = auth.WithClient(ctx.Context, client)
+
+	sesToken, authToken, err := c.sessionController.loginUserOIDC(ctx, ctx.Request, *u, apiScopes, claimScopes)
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	idToken, err := c.SignIDToken(idTokenTime, &auth.IDTokenClaims{
+		StandardClaims: jwtgo.StandardClaims{
+			Subject:  u.ID.Hex(),
+			Audience: ar.ClientID,
+		},
+		Nonce:    ar.Nonce,
+		AuthTime: ar.TimeApproved.Unix(),
+		UserInfo: userInfo(u, ar.Scopes),
+	})
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	scope := strings.Join(ar.Scopes, " ")
+	return ctx.OK(&app.OauthToken{
+		AccessToken:  authToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    int(TokenTime.Seconds()),
+		RefreshToken: &sesToken,
+		IDToken:      &idToken,
+		Scope:        &scope,
+	})
+}
+
+func (c *OauthController) refreshToken(ctx *app.TokenOauthContext, client *auth.Client) error {
+	if ctx.Payload.RefreshToken == nil {
+		return ctx.BadRequest(oauthError("invalid_request", "The refresh_token is required"))
+	}
+
+	claims, err := c.ParseSessionClaims(*ctx.Payload.RefreshToken)
+	if err == auth.ErrInvalidToken || err == auth.ErrTokenRevoked {
+		return ctx.BadRequest(oauthError("invalid_grant", err.Error()))
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	// Sessions can only be refreshed by the client they were created for
+	ctx.Context = auth.WithClient(ctx.Context, client)
+
+	sesToken, authToken, err := c.sessionController.refreshSession(ctx, ctx.Request, claims.SessionID, claims.Id)
+	if err == ErrSessionEnded || err == ErrSessionTokenReused || err == ErrSessionClient {
+		return ctx.BadRequest(oauthError("invalid_grant", err.Error()))
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	return ctx.OK(&app.OauthToken{
+		AccessToken:  authToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    int(TokenTime.Seconds()),
+		RefreshToken: &sesToken,
+	})
+}
+
+// issueServiceToken issues a token to a service account for itself. The token has no session, so it can't be
+// refreshed, and it only gets the scopes registered to the client rather than the default scopes.
+func (c *OauthController) issueServiceToken(ctx *app.TokenOauthContext, client *auth.Client) error {
+	// Public clients can't authenticate, so anyone could get their tokens
+	if !client.Confidential() {
+		return ctx.BadRequest(oauthError("unauthorized_client", "Only confidential clients can use the client_credentials grant"))
+	}
+
+	scopes := client.Scopes
+	if ctx.Payload.Scope != nil {
+		scopes = nil
+		for _, s := range strings.Fields(*ctx.Payload.Scope) {
+			if !containsString(client.Scopes, s) {
+				return ctx.BadRequest(oauthError("invalid_scope", "The client is not allowed the "+s+" scope"))
+			}
+			if !containsString(scopes, s) {
+				scopes = append(scopes, s)
+			}
+		}
+	}
+	if len(scopes) == 0 {
+		return ctx.BadRequest(oauthError("invalid_scope", "No scopes were requested or registered to the client"))
+	}
+
+	scope := strings.Join(scopes, " ")
+	authToken, err := c.SignAuthToken(serviceTokenTime, &auth.Claims{
+		StandardClaims: jwtgo.StandardClaims{
+			Subject:  client.ID,
+			Audience: client.TokenAudience(),
+		},
+		Scope:    scope,
+		ClientID: client.ID,
+	})
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	return ctx.OK(&app.OauthToken{
+		AccessToken: authToken,
+		TokenType:   "Bearer",
+		ExpiresIn:   int(serviceTokenTime.Seconds()),
+		Scope:       &scope,
+	})
+}
+
+// Userinfo runs the userinfo action.
+func (c *OauthController) Userinfo(ctx *app.UserinfoOauthContext) error {
+	// OauthController_Userinfo: start_implement
+
+	header := ctx.Request.Header.Get("Authorization")
+	if !strings.HasPrefix(strings.ToLower(header), "bearer ") {
+		ctx.ResponseData.Header().Set("WWW-Authenticate", "Bearer")
+		return ctx.Unauthorized(goa.ErrUnauthorized("Access token is required"))
+	}
+
+	claims, err := c.ParseIssuedClaims(header[len("bearer "):])
+	if err == auth.ErrInvalidToken || err == auth.ErrTokenRevoked {
+		ctx.ResponseData.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+		return ctx.Unauthorized(goa.ErrUnauthorized(err))
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+	if claims.IsService() {
+		ctx.ResponseData.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+		return ctx.Unauthorized(goa.ErrUnauthorized("Service account tokens are not issued to a user"))
+	}
+	if !claims.HasOIDCScope("openid") {
+		ctx.ResponseData.Header().Set("WWW-Authenticate", `Bearer error="insufficient_scope", scope="openid"`)
+		return ctx.Unauthorized(goa.ErrUnauthorized("Access token does not have the openid scope"))
+	}
+
+	u, err := database.GetUser(ctx, claims.UserID())
+	if err == database.ErrUserNotFound {
+		ctx.ResponseData.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+		return ctx.Unauthorized(goa.ErrUnauthorized("User not found"))
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	info := userInfo(u, strings.Fields(claims.OIDCScope))
+	return ctx.OK(&app.UserInfo{
+		Sub:                 claims.UserID(),
+		Name:                nonEmpty(info.Name),
+		GivenName:           nonEmpty(info.GivenName),
+		FamilyName:          nonEmpty(info.FamilyName),
+		Gender:              nonEmpty(info.Gender),
+		Picture:             nonEmpty(info.Picture),
+		Email:               nonEmpty(info.Email),
+		EmailVerified:       info.EmailVerified,
+		PhoneNumber:         nonEmpty(info.PhoneNumber),
+		PhoneNumberVerified: info.PhoneNumberVerified,
+	})
+
+	// OauthController_Userinfo: end_implement
+}
+
+// requestPending is whether the request is waiting for the user to approve it
+func requestPending(ar *database.AuthorizationRequest) bool {
+	return ar.Code == "" && ar.TimeExpire.After(time.Now())
+}
+
+// userInfo gets the claims about the user that are included for the scopes
+func userInfo(u *models.User, scopes []string) auth.UserInfo {
+	var info auth.UserInfo
+	if containsString(scopes, "profile") {
+		info.Name = strings.TrimSpace(u.FirstName + " " + u.LastName)
+		info.GivenName = u.FirstName
+		info.FamilyName = u.LastName
+		info.Gender = u.Gender
+		info.Picture = u.ProfileImage
+	}
+	if containsString(scopes, "email") {
+		info.Email = u.Email
+		info.EmailVerified = &u.VerifiedEmail
+	}
+	if containsString(scopes, "phone") && u.Phone != "" {
+		info.PhoneNumber = u.Phone
+		info.PhoneNumberVerified = &u.VerifiedPhone
+	}
+	return info
+}
+
+// grantScopes returns the requested scopes the client can be granted, the approve scope never is since it would let
+// the client login users to other clients
+func grantScopes(client *auth.Client, requested string) []string {
+	allowed := append(append([]string{}, oidcScopes...), client.TokenScopes()...)
+
+	var scopes []string
+	for _, s := range strings.Fields(requested) {
+		if containsString(allowed, s) && s != auth.ApproveScope && !containsString(scopes, s) {
+			scopes = append(scopes, s)
+		}
+	}
+	return scopes
+}
+
+func oauthError(code, description string) *app.OauthError {
+	return &app.OauthError{
+		Error:            code,
+		ErrorDescription: &description,
+	}
+}
+
+// withQuery adds the parameters that are not empty to the query of the URL
+func withQuery(rawURL string, params url.Values) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+
+	q := u.Query()
+	for k, v := range params {
+		if len(v) > 0 && v[0] != "" {
+			q[k] = v
+		}
+	}
+	u.RawQuery = q.Encode()
+	return u.String()
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func optionalString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func nonEmpty(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -1,20 +1,22 @@
 package auth
 
 import (
+	"crypto/subtle"
 	"fmt"
 	"github.com/alioygur/is"
 	"gigglesearch.org/giggle-auth/auth/app"
 	"gigglesearch.org/giggle-auth/auth/database"
 	"gigglesearch.org/giggle-auth/auth/models"
+	"gigglesearch.org/giggle-auth/auth/utils/crypto"
 	"gigglesearch.org/giggle-auth/utils/auth"
 	"gigglesearch.org/giggle-auth/utils/email"
 	"gigglesearch.org/giggle-auth/utils/log"
+	"gigglesearch.org/giggle-auth/utils/password"
 	"github.com/globalsign/mgo"
 	"github.com/globalsign/mgo/bson"
 	"github.com/goadesign/goa"
 	"github.com/gofrs/uuid"
 	"github.com/simukti/emailcheck"
-	"golang.org/x/crypto/bcrypt"
 	"net"
 	"net/url"
 	"strings"
@@ -23,6 +25,8 @@ import (
 
 var errEmailExists = goa.ErrBadRequest("email already exists")
 
+const recoveryCodeCount = 10
+
 // PasswordAuthController implements the password-auth resource.
 type PasswordAuthController struct {
 	*goa.Controller
@@ -43,13 +47,30 @@ func NewPasswordAuthController(service *goa.Service, jwtSec auth.JWTSecurity, se
 func (c *PasswordAuthController) ChangePassword(ctx *app.ChangePasswordPasswordAuthContext) error {
 	// PasswordAuthController_ChangePassword: start_implement
 
-	uID := c.GetUserID(ctx.Request)
+	uID := c.Claims(ctx).UserID()
 
 	u, err := database.GetUser(ctx, uID)
 	if err != nil {
 		return ctx.InternalServerError(goa.ErrInternal(err))
 	}
 
+	ipAddr, _, err := net.SplitHostPort(ctx.RequestData.RemoteAddr)
+	if err != nil {
+		ipAddr = ctx.RequestData.RemoteAddr
+	}
+
+	attempt, wait, err := startLoginAttempt(ctx, u, ipAddr, false)
+	if err == ErrAccountLocked {
+		ctx.ResponseData.Header().Set("Retry-After", retryAfter(wait))
+		return ctx.Forbidden(errAccountLocked(err))
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+	if wait > 0 {
+		ctx.ResponseData.Header().Set("Retry-After", retryAfter(wait))
+		return ctx.TooManyRequests(goa.ErrBadRequest("Too many failed attempts, try again later"))
+	}
+
 	passl, err := database.GetPasswordLogin(ctx, strings.ToLower(u.Email))
 	if err == database.ErrPasswordLoginNotFound {
 		fmt.Println(database.ErrPasswordLoginNotFound)
@@ -58,15 +79,31 @@ func (c *PasswordAuthController) ChangePassword(ctx *app.ChangePasswordPasswordA
 		return ctx.InternalServerError(goa.ErrInternal(err))
 	}
 
-	err = bcrypt.CompareHashAndPassword([]byte(passl.Password), []byte(ctx.Payload.OldPassword))
-	if err == bcrypt.ErrMismatchedHashAndPassword {
-		fmt.Println(bcrypt.ErrMismatchedHashAndPassword)
+	if passl.Password == "" {
 		return ctx.BadRequest(goa.ErrBadRequest("incorrect old password"))
-	} else if err != nil {
+	}
+
+	ok, _, err := crypto.VerifyPassword(ctx.Payload.OldPassword, passl.Password)
+	if err != nil {
 		return ctx.InternalServerError(goa.ErrInternal(err))
 	}
+	if !ok {
+		if err := recordLoginFailure(ctx, attempt); err != nil {
+			return ctx.InternalServerError(goa.ErrInternal(err))
+		}
+		return ctx.BadRequest(goa.ErrBadRequest("incorrect old password"))
+	}
 
-	cryptPass, err := bcrypt.GenerateFromPassword([]byte(ctx.Payload.NewPassword), bcrypt.DefaultCost)
+	history := passwordHistory(passl)
+	violations, err := password.Check(ctx.Payload.NewPassword, history, u.Email, u.FirstName, u.LastName)
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+	if len(violations) > 0 {
+		return ctx.BadRequest(errPasswordPolicy("Password does not meet the password policy", "violations", violations))
+	}
+
+	cryptPass, err := crypto.HashPassword(ctx.Payload.NewPassword)
 	if err != nil {
 		return ctx.InternalServerError(goa.ErrInternal(err))
 	}
@@ -74,14 +111,20 @@ func (c *PasswordAuthController) ChangePassword(ctx *app.ChangePasswordPasswordA
 	newP := database.PasswordLogin{
 		Email:    u.Email,
 		UserID:   u.ID.Hex(),
-		Password: string(cryptPass),
+		Password: cryptPass,
+		History:  history,
+		Recovery: passl.Recovery,
 	}
 	err = database.UpdatePasswordLogin(ctx, &newP)
 	if err != nil {
 		return ctx.InternalServerError(goa.ErrInternal(err))
 	}
 
-	sesID := c.GetSessionFromAuth(ctx.Request)
+	if err := clearLoginFailures(ctx, attempt, ipAddr); err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	sesID := c.Claims(ctx).SessionID()
 	err = logoutAllSessionsBut(ctx, uID, sesID)
 	if err != nil {
 		log.Warning(ctx, "Unable to logout of other sessions when changing password, uID=%s, err=%v", uID, err)
@@ -110,18 +153,48 @@ func (c *PasswordAuthController) ConfirmReset(ctx *app.ConfirmResetPasswordAuthC
 		return ctx.Forbidden(errBadReset("Invalid reset code"))
 	}
 
-	fmt.Println(rp.ID.String(), ctx.Payload.ResetCode)
+	u, err := database.GetUser(ctx, rp.UserID)
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	ipAddr, _, err := net.SplitHostPort(ctx.RequestData.RemoteAddr)
+	if err != nil {
+		ipAddr = ctx.RequestData.RemoteAddr
+	}
+
+	// A locked account can still be reset, the lock is cleared once the reset succeeds
+	attempt, wait, err := startLoginAttempt(ctx, u, ipAddr, true)
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+	if wait > 0 {
+		ctx.ResponseData.Header().Set("Retry-After", retryAfter(wait))
+		return ctx.TooManyRequests(goa.ErrBadRequest("Too many failed attempts, try again later"))
+	}
 
-	if rp.ID.String() != ctx.Payload.ResetCode {
+	if subtle.ConstantTimeCompare([]byte(rp.ID.String()), []byte(ctx.Payload.ResetCode)) != 1 {
+		if err := recordLoginFailure(ctx, attempt); err != nil {
+			return ctx.InternalServerError(goa.ErrInternal(err))
+		}
 		return ctx.Forbidden(errBadReset("Invalid reset code"))
 	}
 
-	u, err := database.GetUser(ctx, rp.UserID)
+	passl, err := database.GetPasswordLogin(ctx, strings.ToLower(u.Email))
 	if err != nil {
 		return ctx.InternalServerError(goa.ErrInternal(err))
 	}
 
-	cryptPass, err := bcrypt.GenerateFromPassword([]byte(ctx.Payload.NewPassword), bcrypt.DefaultCost)
+	history := passwordHistory(passl)
+	violations, err := password.Check(ctx.Payload.NewPassword, history, u.Email, u.FirstName, u.LastName)
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+	if len(violations) > 0 {
+		return ctx.BadRequest(errPasswordPolicy("Password does not meet the password policy", "violations", violations))
+	}
+
+	cryptPass, err := crypto.HashPassword(ctx.Payload.NewPassword)
 	if err != nil {
 		return ctx.InternalServerError(goa.ErrInternal(err))
 	}
@@ -129,12 +202,18 @@ func (c *PasswordAuthController) ConfirmReset(ctx *app.ConfirmResetPasswordAuthC
 	err = database.UpdatePasswordLogin(ctx, &database.PasswordLogin{
 		Email:    u.Email,
 		UserID:   u.ID.Hex(),
-		Password: string(cryptPass),
+		Password: cryptPass,
+		History:  history,
+		Recovery: passl.Recovery,
 	})
 	if err != nil {
 		return ctx.InternalServerError(goa.ErrInternal(err))
 	}
 
+	if err := clearLoginFailures(ctx, attempt, ipAddr); err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
 	err = logoutAllSessionsBut(ctx, rp.UserID, "")
 	if err != nil {
 		log.Warning(ctx, "Unable to logout of all sessions when resetting password: %v", err)
@@ -149,26 +228,91 @@ func (c *PasswordAuthController) ConfirmReset(ctx *app.ConfirmResetPasswordAuthC
 func (c *PasswordAuthController) Login(ctx *app.LoginPasswordAuthContext) error {
 	// PasswordAuthController_Login: start_implement
 
+	ipAddr, _, err := net.SplitHostPort(ctx.RequestData.RemoteAddr)
+	if err != nil {
+		ipAddr = ctx.RequestData.RemoteAddr
+	}
+
 	passl, err := database.GetPasswordLogin(ctx, strings.ToLower(ctx.Payload.Email))
-	if err == database.ErrPasswordLoginNotFound {
-		fmt.Println(database.ErrPasswordLoginNotFound)
+	if err == database.ErrPasswordLoginNotFound || (err == nil && passl.Password == "") {
+		_, wait, err := startLoginAttempt(ctx, nil, ipAddr, false)
+		if err != nil {
+			return ctx.InternalServerError(goa.ErrInternal(err))
+		}
+		if wait > 0 {
+			ctx.ResponseData.Header().Set("Retry-After", retryAfter(wait))
+			return ctx.TooManyRequests(goa.ErrBadRequest("Too many failed attempts, try again later"))
+		}
+		// Takes as long as a wrong password would, so the response time doesn't tell which emails have a password
+		crypto.VerifyDummyPassword(ctx.Payload.Password)
 		return ctx.Unauthorized(goa.ErrUnauthorized("Email or password does not match"))
 	} else if err != nil {
 		return ctx.InternalServerError(goa.ErrInternal(err))
 	}
 
-	err = bcrypt.CompareHashAndPassword([]byte(passl.Password), []byte(ctx.Payload.Password))
-	if err == bcrypt.ErrMismatchedHashAndPassword {
-		fmt.Println(bcrypt.ErrMismatchedHashAndPassword)
-		return ctx.Unauthorized(goa.ErrUnauthorized("Email or password does not match"))
+	u, err := database.GetUser(ctx, passl.UserID)
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	attempt, wait, err := startLoginAttempt(ctx, u, ipAddr, false)
+	if err == ErrAccountLocked {
+		ctx.ResponseData.Header().Set("Retry-After", retryAfter(wait))
+		return ctx.Forbidden(errAccountLocked(err))
 	} else if err != nil {
 		return ctx.InternalServerError(goa.ErrInternal(err))
 	}
+	if wait > 0 {
+		ctx.ResponseData.Header().Set("Retry-After", retryAfter(wait))
+		return ctx.TooManyRequests(goa.ErrBadRequest("Too many failed attempts, try again later"))
+	}
 
-	u, err := database.GetUser(ctx, passl.UserID)
+	ok, rehash, err := crypto.VerifyPassword(ctx.Payload.Password, passl.Password)
 	if err != nil {
 		return ctx.InternalServerError(goa.ErrInternal(err))
 	}
+	if !ok {
+		if err := recordLoginFailure(ctx, attempt); err != nil {
+			return ctx.InternalServerError(goa.ErrInternal(err))
+		}
+		return ctx.Unauthorized(goa.ErrUnauthorized("Email or password does not match"))
+	}
+
+	// Upgrade the hash now that we have the password, before the two factor check since the password isn't sent
+	// again with the passcode. The login still works if this fails.
+	if rehash {
+		if hash, err := crypto.HashPassword(ctx.Payload.Password); err != nil {
+			log.Warning(ctx, "Unable to rehash password, userID=%s, err=%v", u.ID.Hex(), err)
+		} else if err := database.UpdatePasswordLoginHash(ctx, passl.UserID, passl.Password, hash); err != nil && err != database.ErrPasswordLoginNotFound {
+			log.Warning(ctx, "Unable to save rehashed password, userID=%s, err=%v", u.ID.Hex(), err)
+		}
+	}
+
+	if u.TwoFactorEnabled {
+		// The attempt stays counted as a failure until the challenge is verified
+		if ctx.Payload.TwoFactor == nil {
+			challenge, err := c.sessionController.createTwoFactorChallenge(ctx, *u, database.FirstFactorPassword, ctx.Token)
+			if err != nil {
+				return ctx.InternalServerError(goa.ErrInternal(err))
+			}
+			return ctx.Accepted(challenge)
+		}
+
+		valid, err := checkTwoFactor(ctx, u, *ctx.Payload.TwoFactor)
+		if err != nil {
+			return ctx.InternalServerError(goa.ErrInternal(err))
+		}
+		if !valid {
+			if err := recordLoginFailure(ctx, attempt); err != nil {
+				return ctx.InternalServerError(goa.ErrInternal(err))
+			}
+			return ctx.Unauthorized(goa.ErrUnauthorized("Invalid two factor passcode"))
+		}
+	}
+
+	if err := clearLoginFailures(ctx, attempt, ipAddr); err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
 
 	sesToken, authToken, err := c.sessionController.loginUser(ctx, ctx.Request, *u, ctx.Token)
 	if err != nil {
@@ -182,6 +326,45 @@ func (c *PasswordAuthController) Login(ctx *app.LoginPasswordAuthContext) error
 	// PasswordAuthController_Login: end_implement
 }
 
+// RegenerateRecoveryCodes runs the regenerate-recovery-codes action.
+func (c *PasswordAuthController) RegenerateRecoveryCodes(ctx *app.RegenerateRecoveryCodesPasswordAuthContext) error {
+	// PasswordAuthController_RegenerateRecoveryCodes: start_implement
+
+	uID := c.Claims(ctx).UserID()
+
+	u, err := database.GetUser(ctx, uID)
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	codes, err := crypto.GenerateRecoveryCodes(recoveryCodeCount)
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	hashes := make([]string, len(codes))
+	for i, code := range codes {
+		hashes[i] = crypto.HashRecoveryCode(code)
+	}
+
+	// Accounts without a password still get a password login entry to hold their codes
+	err = database.UpdatePasswordLoginRecovery(ctx, uID, hashes)
+	if err == database.ErrPasswordLoginNotFound {
+		err = database.CreatePasswordLogin(ctx, &database.PasswordLogin{
+			Email:    strings.ToLower(u.Email),
+			UserID:   uID,
+			Recovery: hashes,
+		})
+	}
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	return ctx.OK(&app.RecoveryCodes{Codes: codes})
+
+	// PasswordAuthController_RegenerateRecoveryCodes: end_implement
+}
+
 // Register runs the register action.
 func (c *PasswordAuthController) Register(ctx *app.RegisterPasswordAuthContext) error {
 	// PasswordAuthController_Register: start_implement
@@ -204,7 +387,15 @@ func (c *PasswordAuthController) Register(ctx *app.RegisterPasswordAuthContext)
 
 	payload := ctx.Payload
 
-	cryptPass, err := bcrypt.GenerateFromPassword([]byte(ctx.Payload.Password), bcrypt.DefaultCost)
+	violations, err := password.Check(payload.Password, nil, payload.Email, payload.FirstName, payload.LastName)
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+	if len(violations) > 0 {
+		return ctx.BadRequest(errPasswordPolicy("Password does not meet the password policy", "violations", violations))
+	}
+
+	cryptPass, err := crypto.HashPassword(ctx.Payload.Password)
 	if err != nil {
 		return ctx.InternalServerError(goa.ErrInternal(err))
 	}
@@ -215,7 +406,6 @@ func (c *PasswordAuthController) Register(ctx *app.RegisterPasswordAuthContext)
 		FirstName:     payload.FirstName,
 		LastName:      payload.LastName,
 		Category:      payload.Category,
-		Password:      string(cryptPass),
 		VerifiedEmail: false,
 		IsAdmin:       false,
 		GetNewsletter: false,
@@ -228,11 +418,9 @@ func (c *PasswordAuthController) Register(ctx *app.RegisterPasswordAuthContext)
 	passl := models.PasswordLogin{
 		Email:    strings.ToLower(ctx.Payload.Email),
 		UserID:   newU.ID.Hex(),
-		Password: string(cryptPass),
+		Password: cryptPass,
 	}
 
-	fmt.Println(passl)
-
 	if err := models.PasswordLoginCollection.Insert(&passl); err != nil {
 		fmt.Printf("%v", err)
 		return ctx.InternalServerError(goa.ErrInternal(err))
@@ -254,7 +442,7 @@ func (c *PasswordAuthController) Register(ctx *app.RegisterPasswordAuthContext)
 func (c *PasswordAuthController) Remove(ctx *app.RemovePasswordAuthContext) error {
 	// PasswordAuthController_Remove: start_implement
 
-	uID := c.GetUserID(ctx.Request)
+	uID := c.Claims(ctx).UserID()
 
 	if getNumLoginMethods(ctx, uID) <= 1 {
 		return ctx.Forbidden(errMustBeAbleToLogin("Cannot remove password if it is the only way to login"))
@@ -279,6 +467,12 @@ func (c *PasswordAuthController) Remove(ctx *app.RemovePasswordAuthContext) erro
 func (c *PasswordAuthController) Reset(ctx *app.ResetPasswordAuthContext) error {
 	// PasswordAuthController_Reset: start_implement
 
+	// The link can only go to the application asking for it, otherwise anyone could have reset links sent
+	// to their own site
+	if !auth.ContextClient(ctx).HasRedirectURI(ctx.RedirectURL) {
+		return ctx.BadRequest(goa.ErrBadRequest("Redirect URL is not registered for the client"))
+	}
+
 	u, err := database.QueryUserEmail(ctx, strings.ToLower(ctx.Email))
 	if err == database.ErrUserNotFound {
 		return ctx.OK([]byte(""))
@@ -332,6 +526,49 @@ func (c *PasswordAuthController) Reset(ctx *app.ResetPasswordAuthContext) error
 	// PasswordAuthController_Reset: end_implement
 }
 
+// Unlock runs the unlock action.
+func (c *PasswordAuthController) Unlock(ctx *app.UnlockPasswordAuthContext) error {
+	// PasswordAuthController_Unlock: start_implement
+
+	if !bson.IsObjectIdHex(ctx.Payload.UserID) {
+		return ctx.Forbidden(goa.ErrUnauthorized("Invalid unlock token"))
+	}
+
+	u, err := database.GetUser(ctx, ctx.Payload.UserID)
+	if err == database.ErrUserNotFound {
+		return ctx.Forbidden(goa.ErrUnauthorized("Invalid unlock token"))
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	hash := crypto.HashCode(ctx.Payload.Token)
+	if u.UnlockToken == "" || subtle.ConstantTimeCompare([]byte(hash), []byte(u.UnlockToken)) != 1 {
+		return ctx.Forbidden(goa.ErrUnauthorized("Invalid unlock token"))
+	}
+
+	if err := database.UnlockUser(ctx, u.ID.Hex()); err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	return ctx.OK([]byte(""))
+
+	// PasswordAuthController_Unlock: end_implement
+}
+
+// passwordHistory adds the account's current password to the front of its history, keeping as many as the
+// password policy checks
+func passwordHistory(passl *database.PasswordLogin) []string {
+	history := passl.History
+	if passl.Password != "" {
+		history = append([]string{passl.Password}, history...)
+	}
+	if len(history) > password.Default.History {
+		history = history[:password.Default.History]
+	}
+
+	return history
+}
+
 func CheckEmailExists(email string) bool {
 	if count, err := models.UsersCollection.Find(bson.M{"email": email}).Count(); err == mgo.ErrNotFound {
 		return false
@@ -0,0 +1,108 @@
+package auth
+
+import (
+	"context"
+	"crypto/subtle"
+	"gigglesearch.org/giggle-auth/auth/database"
+	"gigglesearch.org/giggle-auth/auth/models"
+	"gigglesearch.org/giggle-auth/auth/utils/crypto"
+	"gigglesearch.org/giggle-auth/utils/sms"
+	"regexp"
+	"time"
+)
+
+const (
+	phoneCodeDigits      = 6
+	phoneCodeExpiration  = 5 * time.Minute
+	phoneCodeMaxAttempts = 5
+	phoneCodeSendLimit   = 5
+	phoneCodeSendWindow  = time.Hour
+)
+
+// phoneRegexp matches phone numbers in E.164 format
+var phoneRegexp = regexp.MustCompile(`^\+[1-9][0-9]{6,14}$`)
+
+// phoneCodeRateLimited counts a code being texted to phone and reports whether too many have been sent recently
+func phoneCodeRateLimited(ctx context.Context, phone string) (bool, error) {
+	count, err := database.IncrementRateLimit(ctx, "phone-code:"+phone, phoneCodeSendWindow)
+	if err != nil {
+		return false, err
+	}
+
+	return count > phoneCodeSendLimit, nil
+}
+
+// sendPhoneCode replaces any code the user has outstanding for purpose with a new one and texts it to
+// their phone. A code that has been locked by too many incorrect attempts is kept until it expires.
+func sendPhoneCode(ctx context.Context, u *models.User, purpose string) error {
+	uID := u.ID.Hex()
+
+	old, err := database.GetPhoneVerification(ctx, uID, purpose)
+	if err == nil && old.Attempts >= phoneCodeMaxAttempts && time.Now().Before(old.TimeExpires) {
+		return ErrCodeLocked
+	} else if err != nil && err != database.ErrPhoneVerificationNotFound {
+		return err
+	}
+
+	if err := database.DeletePhoneVerification(ctx, uID, purpose); err != nil {
+		return err
+	}
+
+	code, err := crypto.GenerateNumericCode(phoneCodeDigits)
+	if err != nil {
+		return err
+	}
+
+	err = database.CreatePhoneVerification(ctx, &database.PhoneVerification{
+		Code:        crypto.HashCode(code),
+		Phone:       u.Phone,
+		Purpose:     purpose,
+		TimeExpires: time.Now().Add(phoneCodeExpiration),
+		UserID:      uID,
+	})
+	if err != nil {
+		return err
+	}
+
+	return sms.SendSMS(u.Phone, code+" is your Giggle code. It expires in 5 minutes, don't share it with anyone.")
+}
+
+// checkPhoneCode checks code against the one the user was last texted for purpose. The code is removed
+// once it has been used and stops working after phoneCodeMaxAttempts attempts.
+func checkPhoneCode(ctx context.Context, u *models.User, code, purpose string) error {
+	uID := u.ID.Hex()
+
+	pv, err := database.GetPhoneVerification(ctx, uID, purpose)
+	if err == database.ErrPhoneVerificationNotFound {
+		return ErrCodeInvalid
+	} else if err != nil {
+		return err
+	}
+
+	// Codes sent to a number the user has since changed are no longer valid
+	if time.Now().After(pv.TimeExpires) || pv.Phone != u.Phone {
+		return ErrCodeInvalid
+	}
+
+	// The attempt is counted before the code is compared, so guesses sent in parallel can't go past the limit
+	err = database.IncrementPhoneVerificationAttempts(ctx, uID, purpose, phoneCodeMaxAttempts)
+	if err == database.ErrPhoneVerificationNotFound {
+		return ErrCodeLocked
+	} else if err != nil {
+		return err
+	}
+
+	hash := crypto.HashCode(code)
+	if subtle.ConstantTimeCompare([]byte(hash), []byte(pv.Code)) != 1 {
+		return ErrCodeInvalid
+	}
+
+	err = database.UsePhoneVerification(ctx, uID, purpose, hash)
+	if err == database.ErrPhoneVerificationNotFound {
+		return ErrCodeInvalid
+	} else if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,203 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"gigglesearch.org/giggle-auth/auth/database"
+	"gigglesearch.org/giggle-auth/auth/utils/crypto"
+	"golang.org/x/oauth2"
+	"net/http"
+	"strings"
+)
+
+// errProviderData is returned when the provider doesn't give back a profile for the access token
+var errProviderData = errors.New("Invalid provider data")
+
+// provider is an OAuth 2.0 or OpenID Connect provider users can register, login and attach to their account
+// with. The social controller runs the same flow for every provider in providers, so adding one is only a
+// matter of configuring it there.
+type provider struct {
+	// Name of the provider in routes and in the database
+	name string
+	// Name of the provider shown to users
+	title string
+	conf  *oauth2.Config
+	// clientSecret signs the client secret for each token request, for providers whose secret is a short lived JWT
+	// instead of the one in conf. It is optional.
+	clientSecret func() (string, error)
+	// Endpoint the user's profile is requested from with the access token
+	userInfoURL string
+	// idToken verifies the ID token returned with the access token, for providers whose profile is the claims of
+	// the ID token instead of the response of userInfoURL
+	idToken *idTokenVerifier
+	// Page of the front-end the browser is sent on to with the code and state, for providers that post the
+	// authorization response (response_mode=form_post). The redirect URL of conf is then the receive endpoint of
+	// the API, which can't be requested from the front-end.
+	formPostURL string
+	// Claim of the profile that identifies the user's account with the provider, dots separate the names of
+	// nested objects
+	idClaim string
+	// Claim accounts were identified by before idClaim, it is optional. Accounts attached with it are found by it
+	// the next time the user signs in, and are then switched to idClaim. It has to be a verified claim.
+	legacyIDClaim string
+	// mapUser gets the details of the user from the profile, to pre-populate the register page
+	mapUser func(profile userProfile) providerUser
+	// extendProfile adds claims to the profile from other endpoints of the provider, for providers that don't
+	// return everything from the userinfo endpoint. It is optional.
+	extendProfile func(ctx context.Context, client *http.Client, profile userProfile) error
+}
+
+// userProfile is the profile the provider's userinfo endpoint returns, or the claims of its ID token
+type userProfile map[string]interface{}
+
+// providerUser are the details of the user from their profile with a provider
+type providerUser struct {
+	Email     string
+	FirstName string
+	LastName  string
+}
+
+func getProvider(name string) *provider {
+	for _, p := range providers {
+		if p.name == name {
+			return p
+		}
+	}
+	return nil
+}
+
+// config returns the configuration to exchange the code with, with a newly signed client secret for providers
+// that need one
+func (p *provider) config() (*oauth2.Config, error) {
+	if p.clientSecret == nil {
+		return p.conf, nil
+	}
+
+	secret, err := p.clientSecret()
+	if err != nil {
+		return nil, err
+	}
+	conf := *p.conf
+	conf.ClientSecret = secret
+	return &conf, nil
+}
+
+// authCodeURL returns the URL of the provider the user is sent to for the connection. The state identifies the
+// connection, the code challenge of its verifier is sent with PKCE (RFC 7636) and its nonce is put in the ID token.
+func (p *provider) authCodeURL(sc *database.SocialConnection) string {
+	opts := []oauth2.AuthCodeOption{
+		oauth2.SetAuthURLParam("code_challenge", crypto.CodeChallenge(sc.CodeVerifier)),
+		oauth2.SetAuthURLParam("code_challenge_method", "S256"),
+	}
+	if sc.Nonce != "" {
+		opts = append(opts, oauth2.SetAuthURLParam("nonce", sc.Nonce))
+	}
+	if p.formPostURL != "" {
+		opts = append(opts, oauth2.SetAuthURLParam("response_mode", "form_post"))
+	}
+	return p.conf.AuthCodeURL(sc.State.String(), opts...)
+}
+
+// profile gets the profile of the user the access token was issued for, the nonce is the one of the connection
+func (p *provider) profile(ctx context.Context, token *oauth2.Token, nonce string) (userProfile, error) {
+	if p.idToken != nil {
+		idToken, _ := token.Extra("id_token").(string)
+		if idToken == "" {
+			return nil, errProviderData
+		}
+		return p.idToken.verify(ctx, idToken, p.conf.ClientID, nonce)
+	}
+
+	client := p.conf.Client(ctx, token)
+
+	var profile userProfile
+	if err := getProviderJSON(client, p.userInfoURL, &profile); err != nil {
+		return nil, err
+	}
+
+	if p.extendProfile != nil {
+		if err := p.extendProfile(ctx, client, profile); err != nil {
+			return nil, err
+		}
+	}
+	return profile, nil
+}
+
+// getProviderJSON gets a JSON document from an endpoint of a provider with a client that sends the access token
+func getProviderJSON(client *http.Client, url string, v interface{}) error {
+	resp, err := client.Get(url)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return errProviderData
+	}
+
+	dec := json.NewDecoder(resp.Body)
+	// IDs can be numbers, which would lose precision as floats
+	dec.UseNumber()
+	return dec.Decode(v)
+}
+
+// addPostedUser adds the user the provider posted with the authorization response to the profile as the
+// posted_user claim, it is kept apart from the claims of the provider as it isn't verified
+func (p userProfile) addPostedUser(user string) error {
+	dec := json.NewDecoder(strings.NewReader(user))
+	dec.UseNumber()
+	var posted map[string]interface{}
+	if err := dec.Decode(&posted); err != nil {
+		return err
+	}
+
+	p["posted_user"] = posted
+	return nil
+}
+
+// claim returns a claim of the profile as a string, or an empty string when it is missing or not a string or
+// number. Dots in the name separate the names of nested objects.
+func (p userProfile) claim(name string) string {
+	var v interface{} = map[string]interface{}(p)
+	for _, key := range strings.Split(name, ".") {
+		obj, ok := v.(map[string]interface{})
+		if !ok {
+			return ""
+		}
+		v = obj[key]
+	}
+
+	switch v := v.(type) {
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	default:
+		return ""
+	}
+}
+
+// mapClaims maps the profile of providers that have a claim for each of the user's details
+func mapClaims(email, firstName, lastName string) func(userProfile) providerUser {
+	return func(p userProfile) providerUser {
+		return providerUser{
+			Email:     p.claim(email),
+			FirstName: p.claim(firstName),
+			LastName:  p.claim(lastName),
+		}
+	}
+}
+
+// mapName maps the profile of providers that only have the full name of the user, it is split at the first space
+func mapName(email, name string) func(userProfile) providerUser {
+	return func(p userProfile) providerUser {
+		u := providerUser{Email: p.claim(email)}
+		names := strings.SplitN(strings.TrimSpace(p.claim(name)), " ", 2)
+		u.FirstName = names[0]
+		if len(names) > 1 {
+			u.LastName = strings.TrimSpace(names[1])
+		}
+		return u
+	}
+}
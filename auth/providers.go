@@ -0,0 +1,237 @@
+package auth
+
+import (
+	"context"
+	"gigglesearch.org/giggle-auth/utils/secrets"
+	jwtgo "github.com/dgrijalva/jwt-go"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/amazon"
+	"golang.org/x/oauth2/github"
+	"golang.org/x/oauth2/google"
+	"golang.org/x/oauth2/linkedin"
+	"golang.org/x/oauth2/microsoft"
+	"net/http"
+	"time"
+)
+
+const (
+	appleIssuer = "https://appleid.apple.com"
+	// Tenant of personal Microsoft accounts, as opposed to work and school accounts
+	microsoftConsumersTenant = "9188040d-6c67-4c5b-b112-36a304b66dad"
+	// Apple accepts client secrets that are valid for up to six months, they are cheap to sign so each one is
+	// only used for a single request
+	appleClientSecretExpire = 5 * time.Minute
+)
+
+// providers are the OAuth providers users can sign in with, served under /auth/<name>. Accounts are
+// identified by the ID claim, so changing it needs the old one as the legacy ID claim and a migration that
+// marks the attached accounts as legacy.
+var providers = []*provider{
+	{
+		name:  "google",
+		title: "Google",
+		conf: &oauth2.Config{
+			ClientID:     secrets.GoogleID,
+			ClientSecret: secrets.GoogleSecret,
+			Scopes:       []string{"openid", "email", "profile"},
+			Endpoint:     google.Endpoint,
+			RedirectURL:  "https://localhost:4000/social",
+		},
+		idToken: &idTokenVerifier{
+			issuers: []string{"https://accounts.google.com", "accounts.google.com"},
+			jwksURL: "https://www.googleapis.com/oauth2/v3/certs",
+		},
+		idClaim:       "sub",
+		legacyIDClaim: "email",
+		mapUser:       mapClaims("email", "given_name", "family_name"),
+	},
+	{
+		name:  "facebook",
+		title: "Facebook",
+		conf: &oauth2.Config{
+			ClientID:     secrets.FacebookID,
+			ClientSecret: secrets.FacebookSecret,
+			Scopes:       []string{"public_profile", "email"},
+			Endpoint: oauth2.Endpoint{
+				AuthURL:  "https://www.facebook.com/v2.12/dialog/oauth",
+				TokenURL: "https://graph.facebook.com/v2.12/oauth/access_token",
+			},
+			RedirectURL: "https://localhost:4000/fb_social",
+		},
+		userInfoURL: "https://graph.facebook.com/v2.12/me?fields=id,first_name,last_name,email",
+		idClaim:     "id",
+		mapUser:     mapClaims("email", "first_name", "last_name"),
+	},
+	{
+		name:  "linkedin",
+		title: "LinkedIn",
+		conf: &oauth2.Config{
+			ClientID:     secrets.LinkedInID,
+			ClientSecret: secrets.LinkedInSecret,
+			Scopes:       []string{"r_emailaddress", "r_basicprofile"},
+			Endpoint:     linkedin.Endpoint,
+			RedirectURL:  "https://localhost:4000/ln_social",
+		},
+		userInfoURL: "https://api.linkedin.com/v1/people/~:(id,first-name,email-address,last-name)?format=json",
+		idClaim:     "emailAddress",
+		mapUser:     mapClaims("emailAddress", "firstName", "lastName"),
+	},
+	{
+		name:  "microsoft",
+		title: "Microsoft",
+		conf: &oauth2.Config{
+			ClientID:     secrets.MicrosoftID,
+			ClientSecret: secrets.MicrosoftSecret,
+			Scopes:       []string{"openid", "email", "profile"},
+			Endpoint:     microsoft.AzureADEndpoint("common"),
+			RedirectURL:  "https://localhost:4000/ms_social",
+		},
+		idToken: &idTokenVerifier{
+			issuers:       []string{"https://login.microsoftonline.com/{tenantid}/v2.0"},
+			jwksURL:       "https://login.microsoftonline.com/common/discovery/v2.0/keys",
+			emailVerified: microsoftEmailVerified,
+		},
+		idClaim:       "sub",
+		legacyIDClaim: "email",
+		mapUser:       mapName("email", "name"),
+	},
+	{
+		name:  "amazon",
+		title: "Amazon",
+		conf: &oauth2.Config{
+			ClientID:     secrets.AmazonID,
+			ClientSecret: secrets.AmazonSecret,
+			Scopes:       []string{"profile"},
+			Endpoint:     amazon.Endpoint,
+			RedirectURL:  "https://localhost:4000/am_social",
+		},
+		userInfoURL: "https://api.amazon.com/user/profile",
+		idClaim:     "email",
+		mapUser:     mapName("email", "name"),
+	},
+	{
+		name:  "github",
+		title: "GitHub",
+		conf: &oauth2.Config{
+			ClientID:     secrets.GitHubID,
+			ClientSecret: secrets.GitHubSecret,
+			Scopes:       []string{"read:user", "user:email"},
+			Endpoint:     github.Endpoint,
+			RedirectURL:  "https://localhost:4000/gh_social",
+		},
+		userInfoURL:   "https://api.github.com/user",
+		idClaim:       "id",
+		mapUser:       mapLogin(mapName("email", "name")),
+		extendProfile: githubPrimaryEmail,
+	},
+	{
+		name:  "gitlab",
+		title: "GitLab",
+		conf: &oauth2.Config{
+			ClientID:     secrets.GitLabID,
+			ClientSecret: secrets.GitLabSecret,
+			Scopes:       []string{"read_user"},
+			Endpoint: oauth2.Endpoint{
+				AuthURL:  secrets.GitLabURL + "/oauth/authorize",
+				TokenURL: secrets.GitLabURL + "/oauth/token",
+			},
+			RedirectURL: "https://localhost:4000/gl_social",
+		},
+		userInfoURL:   secrets.GitLabURL + "/api/v4/user",
+		idClaim:       "id",
+		mapUser:       mapLogin(mapName("email", "name")),
+		extendProfile: gitlabConfirmedEmail,
+	},
+	{
+		name:  "apple",
+		title: "Apple",
+		conf: &oauth2.Config{
+			ClientID: secrets.AppleID,
+			Scopes:   []string{"name", "email"},
+			Endpoint: oauth2.Endpoint{
+				AuthURL:   appleIssuer + "/auth/authorize",
+				TokenURL:  appleIssuer + "/auth/token",
+				AuthStyle: oauth2.AuthStyleInParams,
+			},
+			RedirectURL: secrets.URL + "/api/v1/user/auth/apple/receive",
+		},
+		clientSecret: appleClientSecret,
+		idToken:      &idTokenVerifier{issuers: []string{appleIssuer}, jwksURL: appleIssuer + "/auth/keys"},
+		formPostURL:  "https://localhost:4000/ap_social",
+		idClaim:      "sub",
+		// The name is only in the user Apple posts the first time the user signs in. Users can hide their email
+		// behind an address at privaterelay.appleid.com, which forwards to them and is verified like any other,
+		// but only delivers mail from domains registered with Apple.
+		mapUser: mapClaims("email", "posted_user.name.firstName", "posted_user.name.lastName"),
+	},
+}
+
+// appleClientSecret signs the client secret of Sign in with Apple, a JWT for the Services ID signed by the team's key
+func appleClientSecret() (string, error) {
+	key, err := jwtgo.ParseECPrivateKeyFromPEM([]byte(secrets.ApplePrivateKey))
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+	token := jwtgo.NewWithClaims(jwtgo.SigningMethodES256, jwtgo.StandardClaims{
+		Issuer:    secrets.AppleTeamID,
+		Subject:   secrets.AppleID,
+		Audience:  appleIssuer,
+		IssuedAt:  now.Unix(),
+		ExpiresAt: now.Add(appleClientSecretExpire).Unix(),
+	})
+	token.Header["kid"] = secrets.AppleKeyID
+	return token.SignedString(key)
+}
+
+// microsoftEmailVerified trusts the email of personal Microsoft accounts, which Microsoft verifies. Microsoft
+// doesn't send email_verified, and the email of work and school accounts is set by their organization.
+func microsoftEmailVerified(profile userProfile) bool {
+	return profile.claim("tid") == microsoftConsumersTenant
+}
+
+// mapLogin uses the username as the first name when the user hasn't set their name, which is optional on
+// GitHub and GitLab
+func mapLogin(mapUser func(userProfile) providerUser) func(userProfile) providerUser {
+	return func(p userProfile) providerUser {
+		u := mapUser(p)
+		if u.FirstName == "" {
+			u.FirstName = p.claim("login")
+		}
+		if u.FirstName == "" {
+			u.FirstName = p.claim("username")
+		}
+		return u
+	}
+}
+
+// githubPrimaryEmail replaces the email of a GitHub profile with the user's primary email. The profile only has
+// the email the user made public, which can be missing and isn't always verified.
+func githubPrimaryEmail(ctx context.Context, client *http.Client, profile userProfile) error {
+	var emails []struct {
+		Email    string `json:"email"`
+		Primary  bool   `json:"primary"`
+		Verified bool   `json:"verified"`
+	}
+	if err := getProviderJSON(client, "https://api.github.com/user/emails", &emails); err != nil {
+		return err
+	}
+
+	delete(profile, "email")
+	for _, e := range emails {
+		if e.Primary && e.Verified {
+			profile["email"] = e.Email
+		}
+	}
+	return nil
+}
+
+// gitlabConfirmedEmail removes the email from a GitLab profile when the user hasn't confirmed it yet, which
+// self-hosted instances can allow
+func gitlabConfirmedEmail(ctx context.Context, client *http.Client, profile userProfile) error {
+	if profile.claim("confirmed_at") == "" {
+		delete(profile, "email")
+	}
+	return nil
+}
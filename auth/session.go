@@ -5,12 +5,16 @@ import (
 	"crypto/hmac"
 	"crypto/sha1"
 	"encoding/base64"
+	"errors"
 	"gigglesearch.org/giggle-auth/auth/app"
 	"gigglesearch.org/giggle-auth/auth/database"
 	"gigglesearch.org/giggle-auth/auth/models"
+	"gigglesearch.org/giggle-auth/auth/utils/crypto"
 	"gigglesearch.org/giggle-auth/utils/auth"
+	"gigglesearch.org/giggle-auth/utils/email"
 	"gigglesearch.org/giggle-auth/utils/log"
 	"gigglesearch.org/giggle-auth/utils/secrets"
+	jwtgo "github.com/dgrijalva/jwt-go"
 	"github.com/goadesign/goa"
 	"github.com/gofrs/uuid"
 	"github.com/mssola/user_agent"
@@ -18,12 +22,22 @@ import (
 	"net"
 	"net/http"
 	"net/url"
+	"strings"
 	"time"
 )
 
+var ErrSessionEnded = errors.New("Session not found")
+var ErrSessionTokenReused = errors.New("Session token has already been used")
+var ErrSessionClient = errors.New("Session was not created by this client")
+
 const (
-	SessionTime = 7 * 24 * time.Hour // 1 week
-	TokenTime   = 10 * time.Minute   // 10 minutes
+	SessionTime   = 7 * 24 * time.Hour // 1 week
+	TokenTime     = 10 * time.Minute   // 10 minutes
+	LoginLinkTime = 15 * time.Minute   // 15 minutes
+
+	loginLinkPath   = "/login/link"
+	loginLinkLimit  = 3
+	loginLinkWindow = time.Hour
 )
 
 // SessionController implements the session resource.
@@ -44,7 +58,7 @@ func NewSessionController(service *goa.Service, jwtSec auth.JWTSecurity) *Sessio
 func (c *SessionController) CleanLoginToken(ctx *app.CleanLoginTokenSessionContext) error {
 	// SessionController_CleanLoginToken: start_implement
 
-	if c.IsAdmin(ctx.Request) {
+	if c.Claims(ctx).HasAdminAccess() {
 		tokens, err := database.QueryLoginTokenOld(ctx, time.Now())
 		if err != nil {
 			return ctx.OK([]byte(""))
@@ -69,7 +83,7 @@ func (c *SessionController) CleanLoginToken(ctx *app.CleanLoginTokenSessionConte
 // CleanMergeToken runs the clean-merge-token action.
 func (c *SessionController) CleanMergeToken(ctx *app.CleanMergeTokenSessionContext) error {
 	// SessionController_CleanMergeToken: start_implement
-	if c.IsAdmin(ctx.Request) {
+	if c.Claims(ctx).HasAdminAccess() {
 		tokens, err := database.QueryMergeTokenOld(ctx, time.Now())
 		if err != nil {
 			return ctx.OK([]byte(""))
@@ -95,7 +109,7 @@ func (c *SessionController) CleanMergeToken(ctx *app.CleanMergeTokenSessionConte
 func (c *SessionController) CleanSessions(ctx *app.CleanSessionsSessionContext) error {
 	// SessionController_CleanSessions: start_implement
 
-	if c.IsAdmin(ctx.Request) {
+	if c.Claims(ctx).HasAdminAccess() {
 		sessionIds, err := database.QuerySessionOld(ctx, time.Now().Add(-SessionTime))
 		if err != nil {
 			return ctx.OK([]byte(""))
@@ -121,8 +135,8 @@ func (c *SessionController) CleanSessions(ctx *app.CleanSessionsSessionContext)
 func (c *SessionController) GetSessions(ctx *app.GetSessionsSessionContext) error {
 	// SessionController_GetSessions: start_implement
 
-	userID := c.GetUserID(ctx.Request)
-	sesID := c.GetSessionFromAuth(ctx.Request)
+	userID := c.Claims(ctx).UserID()
+	sesID := c.Claims(ctx).SessionID()
 	sessions, err := database.QuerySessionFromAccount(ctx, userID)
 	if err != nil {
 		return ctx.InternalServerError(goa.ErrInternal(err))
@@ -158,11 +172,14 @@ func (c *SessionController) GetSessions(ctx *app.GetSessionsSessionContext) erro
 func (c *SessionController) Logout(ctx *app.LogoutSessionContext) error {
 	// SessionController_Logout: start_implement
 
-	sesID := c.GetSessionFromAuth(ctx.Request)
+	sesID := c.Claims(ctx).SessionID()
 
 	if err := database.DeleteSession(ctx, sesID); err != nil {
 		return ctx.InternalServerError(goa.ErrInternal(err))
 	}
+	if err := revokeSessions(sesID); err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
 
 	return ctx.OK([]byte(""))
 
@@ -171,8 +188,8 @@ func (c *SessionController) Logout(ctx *app.LogoutSessionContext) error {
 
 // LogoutOther runs the logout-other action.
 func (c *SessionController) LogoutOther(ctx *app.LogoutOtherSessionContext) error {
-	uID := c.GetUserID(ctx.Request)
-	sesID := c.GetSessionFromAuth(ctx.Request)
+	uID := c.Claims(ctx).UserID()
+	sesID := c.Claims(ctx).SessionID()
 
 	err := logoutAllSessionsBut(ctx, uID, sesID)
 	if err != nil {
@@ -184,7 +201,7 @@ func (c *SessionController) LogoutOther(ctx *app.LogoutOtherSessionContext) erro
 
 // LogoutSpecific runs the logout-specific action.
 func (c *SessionController) LogoutSpecific(ctx *app.LogoutSpecificSessionContext) error {
-	uID := c.GetUserID(ctx.Request)
+	uID := c.Claims(ctx).UserID()
 	sesID := ctx.SessionID
 	if sesID == "" {
 		return ctx.BadRequest(goa.ErrBadRequest("Session ID must be provided"))
@@ -204,10 +221,102 @@ func (c *SessionController) LogoutSpecific(ctx *app.LogoutSpecificSessionContext
 	if err != nil {
 		return ctx.InternalServerError(goa.ErrInternal(err))
 	}
+	if err := revokeSessions(sesID); err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
 
 	return ctx.OK([]byte(""))
 }
 
+// RedeemLoginCode runs the redeem-login-code action.
+func (c *SessionController) RedeemLoginCode(ctx *app.RedeemLoginCodeSessionContext) error {
+	// SessionController_RedeemLoginCode: start_implement
+
+	u, err := database.QueryUserEmail(ctx, strings.ToLower(ctx.Payload.Email))
+	if err == database.ErrUserNotFound {
+		return ctx.Unauthorized(goa.ErrUnauthorized(ErrCodeInvalid))
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	ev, err := checkEmailCode(ctx, u.ID.Hex(), ctx.Payload.Code, true)
+	if err == ErrCodeInvalid {
+		return ctx.Unauthorized(goa.ErrUnauthorized(err))
+	} else if err == ErrCodeLocked {
+		return ctx.Forbidden(errCodeLocked(err))
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	// Receiving the code proves the user owns the address it was sent to
+	if !u.VerifiedEmail && u.Email == ev.Email {
+		u.VerifiedEmail = true
+		u.ChangingEmail = u.Email
+		if err := database.UpdateUser(ctx, u); err != nil {
+			return ctx.InternalServerError(goa.ErrInternal(err))
+		}
+	}
+
+	if u.TwoFactorEnabled {
+		challenge, err := c.createTwoFactorChallenge(ctx, *u, database.FirstFactorEmailCode, nil)
+		if err != nil {
+			return ctx.InternalServerError(goa.ErrInternal(err))
+		}
+		return ctx.Accepted(challenge)
+	}
+
+	sesToken, authToken, err := c.loginUser(ctx, ctx.Request, *u, nil)
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	ctx.ResponseData.Header().Set("X-Session", sesToken)
+	ctx.ResponseData.Header().Set("Authorization", "Bearer "+authToken)
+	return ctx.OK(database.UserToUser(u))
+
+	// SessionController_RedeemLoginCode: end_implement
+}
+
+// RedeemPhoneLoginCode runs the redeem-phone-login-code action.
+func (c *SessionController) RedeemPhoneLoginCode(ctx *app.RedeemPhoneLoginCodeSessionContext) error {
+	// SessionController_RedeemPhoneLoginCode: start_implement
+
+	u, err := database.QueryUserPhone(ctx, ctx.Payload.Phone)
+	if err == database.ErrUserNotFound {
+		return ctx.Unauthorized(goa.ErrUnauthorized(ErrCodeInvalid))
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	err = checkPhoneCode(ctx, u, ctx.Payload.Code, database.PhoneCodeLogin)
+	if err == ErrCodeInvalid {
+		return ctx.Unauthorized(goa.ErrUnauthorized(err))
+	} else if err == ErrCodeLocked {
+		return ctx.Forbidden(errCodeLocked(err))
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	if u.TwoFactorEnabled {
+		challenge, err := c.createTwoFactorChallenge(ctx, *u, database.FirstFactorPhoneCode, nil)
+		if err != nil {
+			return ctx.InternalServerError(goa.ErrInternal(err))
+		}
+		return ctx.Accepted(challenge)
+	}
+
+	sesToken, authToken, err := c.loginUser(ctx, ctx.Request, *u, nil)
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	ctx.ResponseData.Header().Set("X-Session", sesToken)
+	ctx.ResponseData.Header().Set("Authorization", "Bearer "+authToken)
+	return ctx.OK(database.UserToUser(u))
+
+	// SessionController_RedeemPhoneLoginCode: end_implement
+}
+
 // RedeemToken runs the redeemToken action.
 func (c *SessionController) RedeemToken(ctx *app.RedeemTokenSessionContext) error {
 	// SessionController_RedeemToken: start_implement
@@ -223,23 +332,38 @@ func (c *SessionController) RedeemToken(ctx *app.RedeemTokenSessionContext) erro
 		return ctx.Forbidden(errTokenMismatch("Token does not exist"))
 	}
 
-	user, err := database.GetUser(ctx, t.UserID)
-	if err != nil {
+	if !crypto.VerifySignature(t.Token.String(), ctx.Payload.Signature, secrets.LoginLinkKey) {
+		return ctx.Forbidden(errTokenMismatch("Token does not exist"))
+	}
+
+	// Deleting the token before logging in makes sure it can only be used once
+	err = database.DeleteLoginToken(ctx, t.Token)
+	if err == database.ErrLoginTokenNotFound {
+		return ctx.Forbidden(errTokenMismatch("Token does not exist"))
+	} else if err != nil {
 		return ctx.InternalServerError(goa.ErrInternal(err))
 	}
 
-	sesToken, authToken, err := c.loginUser(ctx, ctx.Request, *user, nil)
+	user, err := database.GetUser(ctx, t.UserID)
 	if err != nil {
 		return ctx.InternalServerError(goa.ErrInternal(err))
 	}
 
-	err = database.DeleteLoginToken(ctx, t.Token)
+	if user.TwoFactorEnabled {
+		challenge, err := c.createTwoFactorChallenge(ctx, *user, database.FirstFactorLoginLink, nil)
+		if err != nil {
+			return ctx.InternalServerError(goa.ErrInternal(err))
+		}
+		return ctx.Accepted(challenge)
+	}
+
+	sesToken, authToken, err := c.loginUser(ctx, ctx.Request, *user, nil)
 	if err != nil {
-		log.Warning(ctx, "Unable to delete login token %s", t.Token)
+		return ctx.InternalServerError(goa.ErrInternal(err))
 	}
 
 	ctx.ResponseData.Header().Set("X-Session", sesToken)
-	ctx.ResponseData.Header().Set("Authorization", authToken)
+	ctx.ResponseData.Header().Set("Authorization", "Bearer "+authToken)
 	return ctx.Created()
 
 	// SessionController_RedeemToken: end_implement
@@ -249,42 +373,137 @@ func (c *SessionController) RedeemToken(ctx *app.RedeemTokenSessionContext) erro
 func (c *SessionController) Refresh(ctx *app.RefreshSessionContext) error {
 	// SessionController_Refresh: start_implement
 
-	sesID := c.GetSessionCode(ctx.Request)
+	sesID, tokenID := c.GetSessionCode(ctx.Request)
 	if sesID == "" {
 		return ctx.BadRequest(goa.ErrBadRequest("Invalid session ID"))
 	}
-	s, err := database.GetSession(ctx, sesID)
-	if err == database.ErrSessionNotFound {
-		return ctx.Unauthorized(goa.ErrUnauthorized("Session not found"))
+
+	sessToken, authToken, err := c.refreshSession(ctx, ctx.Request, sesID, tokenID)
+	if err == ErrSessionEnded || err == ErrSessionTokenReused || err == ErrSessionClient {
+		return ctx.Unauthorized(goa.ErrUnauthorized(err))
 	} else if err != nil {
 		return ctx.InternalServerError(goa.ErrInternal(err))
 	}
-	if !s.LastUsed.Add(SessionTime).After(time.Now()) {
-		return ctx.Unauthorized(goa.ErrUnauthorized("Session not found"))
+
+	ctx.ResponseData.Header().Set("X-Session", sessToken)
+	ctx.ResponseData.Header().Set("Authorization", "Bearer "+authToken)
+	return ctx.OK([]byte(""))
+
+	// SessionController_Refresh: end_implement
+}
+
+// SendLoginCode runs the send-login-code action.
+func (c *SessionController) SendLoginCode(ctx *app.SendLoginCodeSessionContext) error {
+	// SessionController_SendLoginCode: start_implement
+
+	addr := strings.ToLower(ctx.Email)
+
+	limited, err := emailCodeRateLimited(ctx, addr)
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+	if limited {
+		return ctx.TooManyRequests(goa.ErrBadRequest("Too many codes requested, try again later"))
 	}
 
-	updatedSession := c.createSession(ctx.Request, s.UserID, s.IsAdmin, s.IsPluginAuthor, s.IsEventAuthor)
-	updatedSession.ID = s.ID
-	err = database.UpdateSession(ctx, updatedSession)
+	u, err := database.QueryUserEmail(ctx, addr)
+	if err == database.ErrUserNotFound {
+		return ctx.OK([]byte(""))
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	// Sending in the background keeps the response the same for addresses without an account
+	bg := log.Detach(ctx)
+	go func() {
+		if err := sendEmailCode(bg, u, u.Email, true); err != nil {
+			log.Warning(bg, "Unable to send login code, userID=%s, err=%v", u.ID.Hex(), err)
+		}
+	}()
+
+	return ctx.OK([]byte(""))
+
+	// SessionController_SendLoginCode: end_implement
+}
+
+// SendLoginLink runs the send-login-link action.
+func (c *SessionController) SendLoginLink(ctx *app.SendLoginLinkSessionContext) error {
+	// SessionController_SendLoginLink: start_implement
+
+	addr := strings.ToLower(ctx.Email)
+
+	// The limit is counted before looking up the user so it applies the same way to every address
+	count, err := database.IncrementRateLimit(ctx, "login-link:"+addr, loginLinkWindow)
 	if err != nil {
 		return ctx.InternalServerError(goa.ErrInternal(err))
 	}
+	if count > loginLinkLimit {
+		return ctx.TooManyRequests(goa.ErrBadRequest("Too many login links requested, try again later"))
+	}
 
-	sessToken, err := c.SignSessionToken(SessionTime, s.ID.Hex())
+	u, err := database.QueryUserEmail(ctx, addr)
+	if err == database.ErrUserNotFound {
+		return ctx.OK([]byte(""))
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	token, err := database.CreateLoginToken(ctx, &database.LoginToken{
+		TimeExpire: time.Now().Add(LoginLinkTime),
+		UserID:     u.ID.Hex(),
+	})
 	if err != nil {
 		return ctx.InternalServerError(goa.ErrInternal(err))
 	}
 
-	authToken, err := c.SignAuthToken(TokenTime, s.ID.Hex(), s.UserID, s.IsAdmin, s.IsPluginAuthor, s.IsEventAuthor)
+	link := secrets.URL + loginLinkPath + "?token=" + url.QueryEscape(token.String()) + "&signature=" + url.QueryEscape(crypto.Sign(token.String(), secrets.LoginLinkKey))
+	textContent := "Go to " + link + " to login to your account. The link can only be used once and expires in 15 minutes."
+	htmlContent := "Click <a href=\"" + link + "\">here</a> to login to your account. The link can only be used once and expires in 15 minutes."
+	toName := u.FirstName + " " + u.LastName
+
+	// Sending in the background keeps the response time the same for addresses without an account
+	bg := log.Detach(ctx)
+	go func() {
+		if err := email.SendMail("Your login link", toName, u.Email, textContent, htmlContent); err != nil {
+			log.Warning(bg, "Unable to send login link, userID=%s, err=%v", u.ID.Hex(), err)
+		}
+	}()
+
+	return ctx.OK([]byte(""))
+
+	// SessionController_SendLoginLink: end_implement
+}
+
+// SendPhoneLoginCode runs the send-phone-login-code action.
+func (c *SessionController) SendPhoneLoginCode(ctx *app.SendPhoneLoginCodeSessionContext) error {
+	// SessionController_SendPhoneLoginCode: start_implement
+
+	limited, err := phoneCodeRateLimited(ctx, ctx.Payload.Phone)
 	if err != nil {
 		return ctx.InternalServerError(goa.ErrInternal(err))
 	}
+	if limited {
+		return ctx.TooManyRequests(goa.ErrBadRequest("Too many codes requested, try again later"))
+	}
+
+	u, err := database.QueryUserPhone(ctx, ctx.Payload.Phone)
+	if err == database.ErrUserNotFound {
+		return ctx.OK([]byte(""))
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	// Sending in the background keeps the response the same for numbers without an account
+	bg := log.Detach(ctx)
+	go func() {
+		if err := sendPhoneCode(bg, u, database.PhoneCodeLogin); err != nil {
+			log.Warning(bg, "Unable to send phone login code, userID=%s, err=%v", u.ID.Hex(), err)
+		}
+	}()
 
-	ctx.ResponseData.Header().Set("X-Session", sessToken)
-	ctx.ResponseData.Header().Set("Authorization", "Bearer "+authToken)
 	return ctx.OK([]byte(""))
 
-	// SessionController_Refresh: end_implement
+	// SessionController_SendPhoneLoginCode: end_implement
 }
 
 func (c *SessionController) createSession(req *http.Request, userID string, isAdmin, isPluginAuthor, isEventAuthor bool) *database.Session {
@@ -319,18 +538,116 @@ func (c *SessionController) createSession(req *http.Request, userID string, isAd
 }
 
 func (c *SessionController) loginUser(ctx context.Context, req *http.Request, user models.User, mergeToken *uuid.UUID) (sessionToken string, authToken string, err error) {
+	return c.loginUserOIDC(ctx, req, user, auth.ContextClient(ctx).TokenScopes(), nil)
+}
+
+// loginUserOIDC logs the user in to a client through OpenID Connect, with the API and OpenID Connect scopes the
+// user approved
+func (c *SessionController) loginUserOIDC(ctx context.Context, req *http.Request, user models.User, scopes, oidcScopes []string) (sessionToken string, authToken string, err error) {
+	tokenID, err := uuid.NewV4()
+	if err != nil {
+		return "", "", err
+	}
+
+	client := auth.ContextClient(ctx)
 	newSession := c.createSession(req, user.ID.Hex(), user.IsAdmin, user.IsPluginAuthor, user.IsEventAuthor)
+	newSession.RefreshTokenID = tokenID.String()
+	newSession.Audience = client.TokenAudience()
+	newSession.Scopes = scopes
+	newSession.OIDCScopes = oidcScopes
 	sesID, err := database.CreateSession(ctx, newSession)
 	if err != nil {
 		return "", "", err
 	}
 
-	sessionToken, err = c.SignSessionToken(SessionTime, sesID)
+	sessionToken, err = c.SignSessionToken(SessionTime, sesID, newSession.RefreshTokenID)
+	if err != nil {
+		return "", "", err
+	}
+
+	authToken, err = c.SignAuthToken(TokenTime, sessionClaims(newSession))
+	if err != nil {
+		return "", "", err
+	}
+
+	return sessionToken, authToken, nil
+}
+
+// sessionClaims are the claims of the auth tokens issued to the session
+func sessionClaims(s *database.Session) *auth.Claims {
+	return &auth.Claims{
+		StandardClaims: jwtgo.StandardClaims{
+			Subject:  s.UserID,
+			Audience: s.Audience,
+		},
+		Session:      s.ID.Hex(),
+		Admin:        s.IsAdmin,
+		PluginAuthor: s.IsPluginAuthor,
+		EventAuthor:  s.IsEventAuthor,
+		Scope:        strings.Join(s.Scopes, " "),
+		OIDCScope:    strings.Join(s.OIDCScopes, " "),
+	}
+}
+
+// refreshSession issues new tokens to a session in exchange for its session token, which can't be used again
+func (c *SessionController) refreshSession(ctx context.Context, req *http.Request, sesID, tokenID string) (sessionToken string, authToken string, err error) {
+	s, err := database.GetSession(ctx, sesID)
+	if err == database.ErrSessionNotFound {
+		return "", "", ErrSessionEnded
+	} else if err != nil {
+		return "", "", err
+	}
+	if !s.LastUsed.Add(SessionTime).After(time.Now()) {
+		return "", "", ErrSessionEnded
+	}
+
+	// Each session token can only be used once, so a spent one means it was copied and either copy may
+	// belong to an attacker
+	if tokenID != s.RefreshTokenID {
+		if err := endReusedSession(ctx, s); err != nil {
+			return "", "", err
+		}
+		return "", "", ErrSessionTokenReused
+	}
+
+	// Auth tokens are only issued to the client the session was created through, sessions from before
+	// tokens had an audience take the client that refreshes them
+	client := auth.ContextClient(ctx)
+	if s.Audience == "" {
+		s.Audience = client.TokenAudience()
+		s.Scopes = client.TokenScopes()
+	} else if s.Audience != client.TokenAudience() {
+		return "", "", ErrSessionClient
+	} else if len(s.OIDCScopes) == 0 {
+		// Sessions take changes to the scopes of their client, unless the user approved the scopes through
+		// OpenID Connect
+		s.Scopes = client.TokenScopes()
+	}
+
+	newTokenID, err := uuid.NewV4()
+	if err != nil {
+		return "", "", err
+	}
+
+	updatedSession := c.createSession(req, s.UserID, s.IsAdmin, s.IsPluginAuthor, s.IsEventAuthor)
+	updatedSession.ID = s.ID
+	updatedSession.RefreshTokenID = newTokenID.String()
+	updatedSession.Audience = s.Audience
+	updatedSession.Scopes = s.Scopes
+	updatedSession.OIDCScopes = s.OIDCScopes
+	err = database.RotateSession(ctx, updatedSession, tokenID)
+	if err == database.ErrSessionNotFound {
+		return "", "", ErrSessionEnded
+	} else if err != nil {
+		return "", "", err
+	}
+
+	sessionToken, err = c.SignSessionToken(SessionTime, s.ID.Hex(), updatedSession.RefreshTokenID)
 	if err != nil {
 		return "", "", err
 	}
 
-	authToken, err = c.SignAuthToken(TokenTime, sesID, user.ID.Hex(), user.IsAdmin, user.IsPluginAuthor, user.IsEventAuthor)
+	authToken, err = c.SignAuthToken(TokenTime, sessionClaims(s))
 	if err != nil {
 		return "", "", err
 	}
@@ -355,9 +672,42 @@ func logoutAllSessionsBut(ctx context.Context, userID, sessionID string) error {
 	if err != nil {
 		return err
 	}
+	return revokeSessions(sesIds...)
+}
+
+// endReusedSession logs out a session whose token was used twice and lets the user know
+func endReusedSession(ctx context.Context, s *database.Session) error {
+	sesID := s.ID.Hex()
+	if err := database.DeleteSession(ctx, sesID); err != nil && err != database.ErrSessionNotFound {
+		return err
+	}
+	if err := revokeSessions(sesID); err != nil {
+		return err
+	}
+	log.Warning(ctx, "Session token reused, logged out session, sesID=%s, uID=%s", sesID, s.UserID)
+
+	u, err := database.GetUser(ctx, s.UserID)
+	if err != nil {
+		log.Warning(ctx, "Unable to get user to notify of reused session token, uID=%s, err=%v", s.UserID, err)
+		return nil
+	}
+
+	device := strings.TrimSpace(s.Browser + " on " + s.Os)
+	textContent := "A login token for your session on " + device + " was used more than once, which can mean it was stolen. We have logged out that session to protect your account. If you did not expect this, change your password."
+	htmlContent := "A login token for your session on <b>" + device + "</b> was used more than once, which can mean it was stolen. We have logged out that session to protect your account.<br>If you did not expect this, change your password."
+	if err := email.SendMail("A session on your account was logged out", u.FirstName+" "+u.LastName, u.Email, textContent, htmlContent); err != nil {
+		log.Warning(ctx, "Unable to notify user of reused session token, uID=%s, err=%v", s.UserID, err)
+	}
+
 	return nil
 }
 
+// revokeSessions stops the auth tokens already issued to the sessions from working, otherwise they stay
+// valid for up to TokenTime after the session is deleted
+func revokeSessions(sessionIDs ...string) error {
+	return auth.RevokeSessions(sessionIDs, time.Now().Add(TokenTime))
+}
+
 //func getIPLocation(headerInfo http.Header) (string, string) {
 //	country := headerInfo.Get("X-Appengine-Country")
 //	if country == "ZZ" || country == "" {
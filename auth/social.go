@@ -0,0 +1,478 @@
+package auth
+
+import (
+	"context"
+	"gigglesearch.org/giggle-auth/auth/app"
+	"gigglesearch.org/giggle-auth/auth/database"
+	"gigglesearch.org/giggle-auth/auth/utils/crypto"
+	"gigglesearch.org/giggle-auth/utils/auth"
+	"gigglesearch.org/giggle-auth/utils/log"
+	"github.com/globalsign/mgo/bson"
+	"github.com/goadesign/goa"
+	"github.com/gofrs/uuid"
+	"golang.org/x/oauth2"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// SocialController implements the social resource.
+type SocialController struct {
+	*goa.Controller
+	auth.JWTSecurity
+	sessionController *SessionController
+}
+
+const (
+	socialRegister = iota
+	socialLogin
+	socialAttach
+)
+
+const (
+	socialConnectionExpire = 30 * time.Minute
+	socialRegisterExpire   = time.Hour
+	socialNonceBytes       = 16
+	socialBindingBytes     = 32
+	// RFC 7636 requires 43 to 128 characters, 32 bytes are 43 in base64
+	socialCodeVerifierBytes = 32
+)
+
+// NewSocialController creates a social controller.
+func NewSocialController(service *goa.Service, jwtSec auth.JWTSecurity, sesCont *SessionController) *SocialController {
+	return &SocialController{
+		Controller:        service.NewController("SocialController"),
+		JWTSecurity:       jwtSec,
+		sessionController: sesCont,
+	}
+}
+
+// AttachToAccount runs the attach-to-account action.
+func (c *SocialController) AttachToAccount(ctx *app.AttachToAccountSocialContext) error {
+	// SocialController_AttachToAccount: start_implement
+
+	p := getProvider(ctx.Provider)
+	if p == nil {
+		return ctx.NotFound(goa.ErrNotFound("Unknown provider " + ctx.Provider))
+	}
+
+	conn, err := createSocialConnection(ctx, p, socialAttach, uuid.Nil, c.Claims(ctx).UserID())
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+	return ctx.OK(conn)
+
+	// SocialController_AttachToAccount: end_implement
+}
+
+// DetachFromAccount runs the detach-from-account action.
+func (c *SocialController) DetachFromAccount(ctx *app.DetachFromAccountSocialContext) error {
+	// SocialController_DetachFromAccount: start_implement
+
+	p := getProvider(ctx.Provider)
+	if p == nil {
+		return ctx.NotFound(goa.ErrNotFound("Unknown provider " + ctx.Provider))
+	}
+
+	uID := c.Claims(ctx).UserID()
+
+	if getNumLoginMethods(ctx, uID) <= 1 {
+		return ctx.Forbidden(errMustBeAbleToLogin("Cannot detach last login method"))
+	}
+
+	pID, err := database.QuerySocialAccountUser(ctx, p.name, uID)
+	if err == database.ErrSocialAccountNotFound {
+		return ctx.NotFound(goa.ErrNotFound("User account is not connected to " + p.title))
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+	err = database.DeleteSocialAccount(ctx, p.name, pID)
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+	return ctx.OK([]byte(""))
+
+	// SocialController_DetachFromAccount: end_implement
+}
+
+// Login runs the login action.
+func (c *SocialController) Login(ctx *app.LoginSocialContext) error {
+	// SocialController_Login: start_implement
+
+	p := getProvider(ctx.Provider)
+	if p == nil {
+		return ctx.NotFound(goa.ErrNotFound("Unknown provider " + ctx.Provider))
+	}
+
+	var mt uuid.UUID
+	if ctx.Token != nil {
+		mt = *ctx.Token
+	}
+
+	conn, err := createSocialConnection(ctx, p, socialLogin, mt, "")
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+	return ctx.OK(conn)
+
+	// SocialController_Login: end_implement
+}
+
+// Receive runs the receive action.
+func (c *SocialController) Receive(ctx *app.ReceiveSocialContext) error {
+	// SocialController_Receive: start_implement
+
+	p := getProvider(ctx.Provider)
+	if p == nil {
+		return ctx.NotFound(goa.ErrNotFound("Unknown provider " + ctx.Provider))
+	}
+
+	sc, err := database.GetSocialConnection(ctx, p.name, ctx.State)
+	if err == database.ErrSocialConnectionNotFound {
+		return ctx.BadRequest(goa.ErrBadRequest(p.title + " connection must be created with other API methods"))
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+	// The connection is only deleted once the browser is known, so a replayed code can't use it up
+	if crypto.HashCode(ctx.Binding) != sc.Binding {
+		return ctx.BadRequest(goa.ErrBadRequest(p.title + " connection was started in another browser"))
+	}
+
+	err = database.DeleteSocialConnection(ctx, p.name, ctx.State)
+	if err != nil {
+		log.Warning(ctx, "Unable to delete %s connection, state=%s", p.title, ctx.State)
+	}
+
+	if sc.TimeExpires.Before(time.Now()) {
+		return ctx.BadRequest(goa.ErrBadRequest(p.title + " connection must be created with other API methods"))
+	}
+
+	conf, err := p.config()
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+	token, err := conf.Exchange(ctx, ctx.Code, oauth2.SetAuthURLParam("code_verifier", sc.CodeVerifier))
+	if err != nil {
+		return ctx.BadRequest(goa.ErrBadRequest(err))
+	}
+
+	profile, err := p.profile(ctx, token, sc.Nonce)
+	if err == errProviderData {
+		return ctx.BadRequest(goa.ErrBadRequest("Invalid " + p.title + " data"))
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+	if sc.User != "" {
+		if err := profile.addPostedUser(sc.User); err != nil {
+			log.Warning(ctx, "Unable to read the user %s posted, state=%s: %v", p.title, ctx.State, err)
+		}
+	}
+
+	pID := profile.claim(p.idClaim)
+	if pID == "" {
+		return ctx.BadRequest(goa.ErrBadRequest("Unable to get " + p.title + " user ID"))
+	}
+
+	switch sc.Purpose {
+	case socialRegister:
+		_, err := getSocialAccount(ctx, p, profile, pID)
+		if err == nil {
+			return ctx.BadRequest(goa.ErrBadRequest("This " + p.title + " account is already attached to an account"))
+		} else if err != database.ErrSocialAccountNotFound {
+			return ctx.InternalServerError(goa.ErrInternal(err))
+		}
+
+		user := p.mapUser(profile)
+		sr := &database.SocialRegister{
+			Provider:    p.name,
+			ProviderID:  pID,
+			Email:       user.Email,
+			TimeCreated: time.Now(),
+		}
+		regID, err := database.CreateSocialRegister(ctx, sr)
+		if err != nil {
+			return ctx.InternalServerError(goa.ErrInternal(err))
+		}
+
+		return ctx.OK(&app.SocialRegisterMedia{
+			OauthKey:  regID,
+			Email:     user.Email,
+			FirstName: user.FirstName,
+			LastName:  user.LastName,
+		})
+	case socialLogin:
+		account, err := getSocialAccount(ctx, p, profile, pID)
+		if err == database.ErrSocialAccountNotFound {
+			return ctx.BadRequest(goa.ErrBadRequest("No account associated with that " + p.title + " account"))
+		} else if err != nil {
+			return ctx.InternalServerError(goa.ErrInternal(err))
+		}
+		u, err := database.GetUser(ctx, account.UserID)
+		if err != nil {
+			return ctx.InternalServerError(goa.ErrInternal(err))
+		}
+
+		var mt *uuid.UUID
+		if sc.MergeToken != uuid.Nil {
+			mt = &sc.MergeToken
+		}
+
+		if u.TwoFactorEnabled {
+			challenge, err := c.sessionController.createTwoFactorChallenge(ctx, *u, database.FirstFactorSocial, mt)
+			if err != nil {
+				return ctx.InternalServerError(goa.ErrInternal(err))
+			}
+			return ctx.OK(challenge)
+		}
+
+		sesToken, authToken, err := c.sessionController.loginUser(ctx, ctx.Request, *u, mt)
+		if err != nil {
+			return ctx.InternalServerError(goa.ErrInternal(err))
+		}
+		ctx.ResponseData.Header().Set("X-Session", sesToken)
+		ctx.ResponseData.Header().Set("Authorization", "Bearer "+authToken)
+
+		return ctx.OK(database.UserToUser(u))
+	case socialAttach:
+		_, err := getSocialAccount(ctx, p, profile, pID)
+		if err == nil {
+			return ctx.BadRequest(goa.ErrBadRequest("This " + p.title + " account is already attached to an account"))
+		} else if err != database.ErrSocialAccountNotFound {
+			return ctx.InternalServerError(goa.ErrInternal(err))
+		}
+
+		uID := c.Claims(ctx).UserID()
+		if uID == "" {
+			return ctx.Unauthorized(goa.ErrUnauthorized("You must be logged in"))
+		}
+		if uID != sc.UserID {
+			return ctx.Unauthorized(goa.ErrUnauthorized("The connection was started by another user"))
+		}
+		_, err = database.GetUser(ctx, uID)
+		if err == database.ErrUserNotFound {
+			log.Critical(ctx, "Unable to get user account, userID=%s", uID)
+			return ctx.InternalServerError(goa.ErrInternal("Unable to find user account"))
+		} else if err != nil {
+			return ctx.InternalServerError(goa.ErrInternal(err))
+		}
+
+		account := &database.SocialAccount{
+			Provider:   p.name,
+			ProviderID: pID,
+			UserID:     uID,
+		}
+		err = database.CreateSocialAccount(ctx, account)
+		if err == database.ErrSocialAccountExists {
+			return ctx.BadRequest(goa.ErrBadRequest("This " + p.title + " account is already attached to an account"))
+		} else if err != nil {
+			return ctx.InternalServerError(goa.ErrInternal(err))
+		}
+	default:
+		log.Critical(ctx, "Bad %s receive type", p.title)
+		return ctx.InternalServerError(goa.ErrInternal("Invalid " + p.title + " connection type"))
+	}
+
+	return ctx.OK(nil)
+
+	// SocialController_Receive: end_implement
+}
+
+// ReceiveForm runs the receive-form action.
+func (c *SocialController) ReceiveForm(ctx *app.ReceiveFormSocialContext) error {
+	// SocialController_ReceiveForm: start_implement
+
+	p := getProvider(ctx.Provider)
+	if p == nil || p.formPostURL == "" {
+		return ctx.NotFound(goa.ErrNotFound("Unknown provider " + ctx.Provider))
+	}
+
+	// The user is only posted the first time they authorize the application, so it is kept with the connection
+	// until the front-end sends the code to the receive action
+	if ctx.Payload.User != nil {
+		err := database.SetSocialConnectionUser(ctx, p.name, ctx.Payload.State, *ctx.Payload.User)
+		if err == database.ErrSocialConnectionNotFound {
+			return ctx.BadRequest(goa.ErrBadRequest(p.title + " connection must be created with other API methods"))
+		} else if err != nil {
+			return ctx.InternalServerError(goa.ErrInternal(err))
+		}
+	}
+
+	query := url.Values{"state": {ctx.Payload.State.String()}}
+	if ctx.Payload.Code != nil {
+		query.Set("code", *ctx.Payload.Code)
+	}
+	if ctx.Payload.Error != nil {
+		query.Set("error", *ctx.Payload.Error)
+	}
+	ctx.ResponseData.Header().Set("Location", withQuery(p.formPostURL, query))
+	return ctx.SeeOther()
+
+	// SocialController_ReceiveForm: end_implement
+}
+
+// Register runs the register action.
+func (c *SocialController) Register(ctx *app.RegisterSocialContext) error {
+	// SocialController_Register: start_implement
+
+	p := getProvider(ctx.Provider)
+	if p == nil {
+		return ctx.NotFound(goa.ErrNotFound("Unknown provider " + ctx.Provider))
+	}
+
+	sr, err := database.GetSocialRegister(ctx, p.name, ctx.Payload.OauthKey)
+	if err == database.ErrSocialRegisterNotFound {
+		return ctx.NotFound(goa.ErrNotFound("Invalid registration key"))
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+	if sr.TimeCreated.Add(socialRegisterExpire).Before(time.Now()) {
+		return ctx.NotFound(goa.ErrNotFound("Invalid registration key"))
+	}
+	_, err = database.GetSocialAccount(ctx, p.name, sr.ProviderID)
+	if err == nil {
+		return ctx.Forbidden(errAlreadyExists("This " + p.title + " account is already attached to an account"))
+	} else if err != database.ErrSocialAccountNotFound {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+	_, err = database.QueryUserEmail(ctx, ctx.Payload.Email)
+	if err == nil {
+		return ctx.Forbidden(errAlreadyExists("This email is already in use", "email", ctx.Payload.Email))
+	} else if err != database.ErrUserNotFound {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	ipAddr, _, err := net.SplitHostPort(ctx.RequestData.RemoteAddr)
+	if err != nil {
+		ipAddr = ctx.RequestData.RemoteAddr
+	}
+
+	// Users can replace the email from the provider, such as an Apple relay address with their own
+	verifiedEmail := sr.Email != "" && strings.EqualFold(sr.Email, ctx.Payload.Email)
+	newU := database.UserFromSocialRegisterParams(ctx.Payload, verifiedEmail)
+	newU.ID = bson.NewObjectId()
+
+	// The account is attached before the user is created, so a registration that loses a race to attach it
+	// doesn't leave a user behind
+	account := &database.SocialAccount{
+		Provider:   p.name,
+		ProviderID: sr.ProviderID,
+		UserID:     newU.ID.Hex(),
+	}
+	err = database.CreateSocialAccount(ctx, account)
+	if err == database.ErrSocialAccountExists {
+		return ctx.Forbidden(errAlreadyExists("This " + p.title + " account is already attached to an account"))
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	_, err = createUser(ctx, newU, &ctx.Payload.GRecaptchaResponse, ipAddr)
+	if err != nil {
+		if err := database.DeleteSocialAccount(ctx, p.name, sr.ProviderID); err != nil {
+			log.Warning(ctx, "Unable to remove %s account of a failed registration, providerID=%s", p.title, sr.ProviderID)
+		}
+	}
+	if err == ErrInvalidRecaptcha {
+		return ctx.BadRequest(goa.ErrBadRequest(err))
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+	err = database.DeleteSocialRegister(ctx, ctx.Payload.OauthKey)
+	if err != nil {
+		log.Warning(ctx, "Unable to delete %s registration progress, key=%v", p.title, ctx.Payload.OauthKey)
+	}
+
+	sesToken, authToken, err := c.sessionController.loginUser(ctx, ctx.Request, *newU, nil)
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	ctx.ResponseData.Header().Set("X-Session", sesToken)
+	ctx.ResponseData.Header().Set("Authorization", "Bearer "+authToken)
+
+	return ctx.OK(database.UserToUser(newU))
+
+	// SocialController_Register: end_implement
+}
+
+// RegisterURL runs the register-url action.
+func (c *SocialController) RegisterURL(ctx *app.RegisterURLSocialContext) error {
+	// SocialController_RegisterURL: start_implement
+
+	p := getProvider(ctx.Provider)
+	if p == nil {
+		return ctx.NotFound(goa.ErrNotFound("Unknown provider " + ctx.Provider))
+	}
+
+	conn, err := createSocialConnection(ctx, p, socialRegister, uuid.Nil, "")
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+	return ctx.OK(conn)
+
+	// SocialController_RegisterURL: end_implement
+}
+
+// createSocialConnection records that the user is being sent to the provider, and returns the URL to send them
+// to. The state in the URL identifies the connection when the provider sends them back. The connection is bound
+// to the binding returned with the URL, which the front-end keeps in the browser that started it, so a code
+// can't be received from another browser, such as one an attacker got the provider's redirect into. Attachments
+// are also bound to the user that started them.
+func createSocialConnection(ctx context.Context, p *provider, purpose int, mergeToken uuid.UUID, userID string) (*app.SocialConnectionMedia, error) {
+	binding, err := crypto.GenerateToken(socialBindingBytes)
+	if err != nil {
+		return nil, err
+	}
+	verifier, err := crypto.GenerateToken(socialCodeVerifierBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	sc := &database.SocialConnection{
+		Provider:     p.name,
+		Purpose:      purpose,
+		MergeToken:   mergeToken,
+		UserID:       userID,
+		Binding:      crypto.HashCode(binding),
+		CodeVerifier: verifier,
+		TimeCreated:  now,
+		TimeExpires:  now.Add(socialConnectionExpire),
+	}
+	if p.idToken != nil {
+		nonce, err := crypto.GenerateToken(socialNonceBytes)
+		if err != nil {
+			return nil, err
+		}
+		sc.Nonce = nonce
+	}
+	if _, err := database.CreateSocialConnection(ctx, sc); err != nil {
+		return nil, err
+	}
+
+	return &app.SocialConnectionMedia{URL: p.authCodeURL(sc), Binding: binding}, nil
+}
+
+// getSocialAccount finds the account attached with the provider. Accounts attached before the provider's accounts
+// were identified by the ID claim are found by the legacy ID claim, and switched to the ID claim.
+func getSocialAccount(ctx context.Context, p *provider, profile userProfile, pID string) (*database.SocialAccount, error) {
+	account, err := database.GetSocialAccount(ctx, p.name, pID)
+	if err != database.ErrSocialAccountNotFound || p.legacyIDClaim == "" {
+		return account, err
+	}
+
+	legacyID := profile.claim(p.legacyIDClaim)
+	if legacyID == "" {
+		return nil, database.ErrSocialAccountNotFound
+	}
+	account, err = database.GetLegacySocialAccount(ctx, p.name, legacyID)
+	if err != nil {
+		return nil, err
+	}
+	if err := database.UpdateSocialAccountID(ctx, account.ID, pID); err != nil {
+		return nil, err
+	}
+	account.ProviderID = pID
+	account.LegacyID = ""
+	return account, nil
+}
@@ -0,0 +1,194 @@
+package auth
+
+import (
+	"context"
+	"gigglesearch.org/giggle-auth/auth/app"
+	"gigglesearch.org/giggle-auth/auth/database"
+	"gigglesearch.org/giggle-auth/utils/auth"
+	jwtgo "github.com/dgrijalva/jwt-go"
+	"github.com/goadesign/goa"
+	"strings"
+	"time"
+)
+
+const (
+	accessTokenType  = "access_token"
+	refreshTokenType = "refresh_token"
+)
+
+// TokenController implements the token resource.
+type TokenController struct {
+	*goa.Controller
+	auth.JWTSecurity
+}
+
+// NewTokenController creates a token controller.
+func NewTokenController(service *goa.Service, jwtSec auth.JWTSecurity) *TokenController {
+	return &TokenController{
+		Controller:  service.NewController("TokenController"),
+		JWTSecurity: jwtSec,
+	}
+}
+
+// tokenState is a verified auth or session token and the session it was issued to
+type tokenState struct {
+	// Claims of an auth token, nil for session tokens
+	claims *auth.Claims
+	// Claims of a session token, nil for auth tokens
+	sessionClaims *auth.SessionClaims
+	// Session the token was issued to, nil for service account tokens
+	session *database.Session
+}
+
+// Introspect runs the introspect action.
+func (c *TokenController) Introspect(ctx *app.IntrospectTokenContext) error {
+	// TokenController_Introspect: start_implement
+
+	t, err := c.lookupToken(ctx, ctx.Payload)
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+	if t == nil {
+		return ctx.OK(&app.TokenIntrospection{Active: false})
+	}
+
+	res := &app.TokenIntrospection{Active: true}
+	s := t.session
+	if s != nil {
+		sessionID := s.ID.Hex()
+		res.SessionID = &sessionID
+		res.Sub = &s.UserID
+	} else {
+		res.Sub = &t.claims.Subject
+	}
+
+	var std jwtgo.StandardClaims
+	tokenType, scope := accessTokenType, ""
+	if t.claims != nil {
+		std = t.claims.StandardClaims
+		scope = t.claims.Scope
+		res.Admin = &t.claims.Admin
+		res.PluginAuthor = &t.claims.PluginAuthor
+		res.EventAuthor = &t.claims.EventAuthor
+	} else {
+		std = t.sessionClaims.StandardClaims
+		std.Audience = s.Audience
+		tokenType, scope = refreshTokenType, strings.Join(s.Scopes, " ")
+		res.Admin = &s.IsAdmin
+		res.PluginAuthor = &s.IsPluginAuthor
+		res.EventAuthor = &s.IsEventAuthor
+	}
+
+	res.TokenType = &tokenType
+	res.Scope = &scope
+	exp, iat := int(std.ExpiresAt), int(std.IssuedAt)
+	res.Exp = &exp
+	res.Iat = &iat
+	res.Iss = &std.Issuer
+	res.Jti = &std.Id
+	if std.Audience != "" {
+		res.Aud = &std.Audience
+	}
+
+	return ctx.OK(res)
+
+	// TokenController_Introspect: end_implement
+}
+
+// Revoke runs the revoke action.
+func (c *TokenController) Revoke(ctx *app.RevokeTokenContext) error {
+	// TokenController_Revoke: start_implement
+
+	t, err := c.lookupToken(ctx, ctx.Payload)
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+	// Invalid tokens don't need revoking
+	if t == nil {
+		return ctx.OK()
+	}
+
+	aud := auth.ContextClient(ctx).TokenAudience()
+
+	if t.claims != nil {
+		if t.claims.Audience != aud {
+			return ctx.Forbidden(goa.ErrUnauthorized("Token was not issued to this client"))
+		}
+		if err := auth.RevokeToken(t.claims.Id, time.Unix(t.claims.ExpiresAt, 0)); err != nil {
+			return ctx.InternalServerError(goa.ErrInternal(err))
+		}
+		return ctx.OK()
+	}
+
+	if t.session.Audience != "" && t.session.Audience != aud {
+		return ctx.Forbidden(goa.ErrUnauthorized("Token was not issued to this client"))
+	}
+
+	sesID := t.session.ID.Hex()
+	if err := database.DeleteSession(ctx, sesID); err != nil && err != database.ErrSessionNotFound {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+	if err := revokeSessions(sesID); err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	return ctx.OK()
+
+	// TokenController_Revoke: end_implement
+}
+
+// lookupToken verifies the token in the payload and gets its session, it returns nil when the token is invalid,
+// revoked or its session has ended. The token type hint is only used to decide which type to try first.
+func (c *TokenController) lookupToken(ctx context.Context, payload *app.TokenParams) (*tokenState, error) {
+	t := &tokenState{}
+	var err error
+
+	parseAccess := func() bool {
+		t.claims, err = c.ParseIssuedClaims(payload.Token)
+		return err == nil
+	}
+	parseRefresh := func() bool {
+		t.sessionClaims, err = c.ParseSessionClaims(payload.Token)
+		return err == nil
+	}
+
+	var ok bool
+	if payload.TokenTypeHint != nil && *payload.TokenTypeHint == refreshTokenType {
+		ok = parseRefresh() || parseAccess()
+	} else {
+		ok = parseAccess() || parseRefresh()
+	}
+	if !ok {
+		if err == auth.ErrInvalidToken || err == auth.ErrTokenRevoked {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	// Service account tokens are not issued to a session
+	if t.claims.IsService() {
+		return t, nil
+	}
+
+	sessionID := t.claims.SessionID()
+	if t.sessionClaims != nil {
+		sessionID = t.sessionClaims.SessionID
+	}
+
+	t.session, err = database.GetSession(ctx, sessionID)
+	if err == database.ErrSessionNotFound {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if !t.session.LastUsed.Add(SessionTime).After(time.Now()) {
+		return nil, nil
+	}
+	// Spent session tokens can't be used to refresh, so they are not active
+	if t.sessionClaims != nil && t.sessionClaims.Id != t.session.RefreshTokenID {
+		return nil, nil
+	}
+
+	return t, nil
+}
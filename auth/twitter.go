@@ -89,7 +89,7 @@ func (c *TwitterController) AttachToAccount(ctx *app.AttachToAccountTwitterConte
 func (c *TwitterController) DetachFromAccount(ctx *app.DetachFromAccountTwitterContext) error {
 	// TwitterController_DetachFromAccount: start_implement
 
-	uID := c.GetUserID(ctx.Request)
+	uID := c.Claims(ctx).UserID()
 
 	if getNumLoginMethods(ctx, uID) <= 1 {
 		return ctx.Forbidden(errMustBeAbleToLogin("Cannot detach last login method"))
@@ -249,6 +249,14 @@ func (c *TwitterController) Receive(ctx *app.ReceiveTwitterContext) error {
 			mt = &gc.MergeToken
 		}
 
+		if u.TwoFactorEnabled {
+			challenge, err := c.sessionController.createTwoFactorChallenge(ctx, *u, database.FirstFactorSocial, mt)
+			if err != nil {
+				return ctx.InternalServerError(goa.ErrInternal(err))
+			}
+			return ctx.OK(challenge)
+		}
+
 		sesToken, authToken, err := c.sessionController.loginUser(ctx, ctx.Request, *u, mt)
 		if err != nil {
 			return ctx.InternalServerError(goa.ErrInternal(err))
@@ -264,7 +272,7 @@ func (c *TwitterController) Receive(ctx *app.ReceiveTwitterContext) error {
 			return ctx.InternalServerError(goa.ErrInternal(err))
 		}
 
-		uID := c.GetUserID(ctx.Request)
+		uID := c.Claims(ctx).UserID()
 		if uID == "" {
 			return ctx.Unauthorized(goa.ErrUnauthorized("You must be logged in"))
 		}
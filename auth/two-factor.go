@@ -0,0 +1,415 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"gigglesearch.org/giggle-auth/auth/app"
+	"gigglesearch.org/giggle-auth/auth/database"
+	"gigglesearch.org/giggle-auth/auth/models"
+	"gigglesearch.org/giggle-auth/auth/utils/crypto"
+	"gigglesearch.org/giggle-auth/auth/utils/totp"
+	"gigglesearch.org/giggle-auth/utils/auth"
+	"gigglesearch.org/giggle-auth/utils/email"
+	"gigglesearch.org/giggle-auth/utils/log"
+	"gigglesearch.org/giggle-auth/utils/secrets"
+	"github.com/goadesign/goa"
+	"github.com/gofrs/uuid"
+	"net"
+	"time"
+)
+
+const (
+	twoFactorIssuer          = "Giggle"
+	twoFactorChallengeExpire = 5 * time.Minute
+	twoFactorMaxAttempts     = 5
+)
+
+// TwoFactorController implements the two-factor resource.
+type TwoFactorController struct {
+	*goa.Controller
+	auth.JWTSecurity
+	sessionController *SessionController
+}
+
+// NewTwoFactorController creates a two-factor controller.
+func NewTwoFactorController(service *goa.Service, jwtSec auth.JWTSecurity, sesCont *SessionController) *TwoFactorController {
+	return &TwoFactorController{
+		Controller:        service.NewController("TwoFactorController"),
+		JWTSecurity:       jwtSec,
+		sessionController: sesCont,
+	}
+}
+
+// Confirm runs the confirm action.
+func (c *TwoFactorController) Confirm(ctx *app.ConfirmTwoFactorContext) error {
+	// TwoFactorController_Confirm: start_implement
+
+	u, err := database.GetUser(ctx, c.Claims(ctx).UserID())
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	if u.TwoFactorEnabled {
+		return ctx.BadRequest(goa.ErrBadRequest("Two factor authentication is already enabled"))
+	}
+	if u.TwoFactorSecret == "" {
+		return ctx.BadRequest(goa.ErrBadRequest("Two factor authentication must be enrolled first"))
+	}
+
+	ipAddr, _, err := net.SplitHostPort(ctx.RequestData.RemoteAddr)
+	if err != nil {
+		ipAddr = ctx.RequestData.RemoteAddr
+	}
+
+	// Passcodes count against the same limits as passwords, so a stolen token can't be used to guess one
+	attempt, wait, err := startLoginAttempt(ctx, u, ipAddr, false)
+	if err == ErrAccountLocked {
+		ctx.ResponseData.Header().Set("Retry-After", retryAfter(wait))
+		return ctx.Forbidden(errAccountLocked(err))
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+	if wait > 0 {
+		ctx.ResponseData.Header().Set("Retry-After", retryAfter(wait))
+		return ctx.TooManyRequests(goa.ErrBadRequest("Too many failed attempts, try again later"))
+	}
+
+	valid, err := checkTwoFactor(ctx, u, ctx.Payload.Passcode)
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+	if !valid {
+		if err := recordLoginFailure(ctx, attempt); err != nil {
+			return ctx.InternalServerError(goa.ErrInternal(err))
+		}
+		return ctx.Unauthorized(goa.ErrUnauthorized("Invalid passcode"))
+	}
+
+	u.TwoFactorEnabled = true
+	err = database.UpdateUser(ctx, u)
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	// Cleared after the user is saved, which would otherwise put back the failures it was read with
+	if err := clearLoginFailures(ctx, attempt, ipAddr); err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	return ctx.OK([]byte(""))
+
+	// TwoFactorController_Confirm: end_implement
+}
+
+// Disable runs the disable action.
+func (c *TwoFactorController) Disable(ctx *app.DisableTwoFactorContext) error {
+	// TwoFactorController_Disable: start_implement
+
+	u, err := database.GetUser(ctx, c.Claims(ctx).UserID())
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	if !u.TwoFactorEnabled {
+		return ctx.BadRequest(goa.ErrBadRequest("Two factor authentication is not enabled"))
+	}
+
+	ipAddr, _, err := net.SplitHostPort(ctx.RequestData.RemoteAddr)
+	if err != nil {
+		ipAddr = ctx.RequestData.RemoteAddr
+	}
+
+	// Passcodes count against the same limits as passwords, so a stolen token can't be used to guess one
+	attempt, wait, err := startLoginAttempt(ctx, u, ipAddr, false)
+	if err == ErrAccountLocked {
+		ctx.ResponseData.Header().Set("Retry-After", retryAfter(wait))
+		return ctx.Forbidden(errAccountLocked(err))
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+	if wait > 0 {
+		ctx.ResponseData.Header().Set("Retry-After", retryAfter(wait))
+		return ctx.TooManyRequests(goa.ErrBadRequest("Too many failed attempts, try again later"))
+	}
+
+	valid, err := checkTwoFactor(ctx, u, ctx.Payload.Passcode)
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+	if !valid {
+		if err := recordLoginFailure(ctx, attempt); err != nil {
+			return ctx.InternalServerError(goa.ErrInternal(err))
+		}
+		return ctx.Unauthorized(goa.ErrUnauthorized("Invalid passcode"))
+	}
+
+	u.TwoFactorEnabled = false
+	u.TwoFactorSecret = ""
+	err = database.UpdateUser(ctx, u)
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	// Cleared after the user is saved, which would otherwise put back the failures it was read with
+	if err := clearLoginFailures(ctx, attempt, ipAddr); err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	err = database.DeleteTwoFactorChallengeByUserID(ctx, u.ID.Hex())
+	if err != nil {
+		log.Warning(ctx, "Unable to delete two factor challenges, userID=%s", u.ID.Hex())
+	}
+
+	return ctx.OK([]byte(""))
+
+	// TwoFactorController_Disable: end_implement
+}
+
+// Enroll runs the enroll action.
+func (c *TwoFactorController) Enroll(ctx *app.EnrollTwoFactorContext) error {
+	// TwoFactorController_Enroll: start_implement
+
+	u, err := database.GetUser(ctx, c.Claims(ctx).UserID())
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	if u.TwoFactorEnabled {
+		return ctx.BadRequest(goa.ErrBadRequest("Two factor authentication is already enabled"))
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	u.TwoFactorSecret, err = crypto.Encrypt(secret, secrets.TwoFactorKey)
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	err = database.UpdateUser(ctx, u)
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	return ctx.OK(&app.TwoFactorEnroll{
+		Secret: secret,
+		URI:    totp.KeyURI(twoFactorIssuer, u.Email, secret),
+	})
+
+	// TwoFactorController_Enroll: end_implement
+}
+
+// SendPhoneCode runs the send-phone-code action.
+func (c *TwoFactorController) SendPhoneCode(ctx *app.SendPhoneCodeTwoFactorContext) error {
+	// TwoFactorController_SendPhoneCode: start_implement
+
+	tc, err := database.GetTwoFactorChallenge(ctx, ctx.Challenge)
+	if err == database.ErrTwoFactorChallengeNotFound {
+		return ctx.NotFound(goa.ErrNotFound("Challenge does not exist"))
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	if tc.TimeExpire.Before(time.Now()) || tc.Attempts >= twoFactorMaxAttempts {
+		return ctx.NotFound(goa.ErrNotFound("Challenge does not exist"))
+	}
+
+	u, err := database.GetUser(ctx, tc.UserID)
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	if !u.VerifiedPhone {
+		return ctx.NotFound(goa.ErrNotFound("No verified phone number on this account"))
+	}
+	// The phone can't be both factors
+	if tc.FirstFactor == database.FirstFactorPhoneCode {
+		return ctx.NotFound(goa.ErrNotFound("The phone was already used to login"))
+	}
+
+	limited, err := phoneCodeRateLimited(ctx, u.Phone)
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+	if limited {
+		return ctx.TooManyRequests(goa.ErrBadRequest("Too many codes requested, try again later"))
+	}
+
+	err = sendPhoneCode(ctx, u, database.PhoneCodeTwoFactor)
+	if err == ErrCodeLocked {
+		return ctx.TooManyRequests(goa.ErrBadRequest(err))
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	return ctx.OK([]byte(""))
+
+	// TwoFactorController_SendPhoneCode: end_implement
+}
+
+// Verify runs the verify action.
+func (c *TwoFactorController) Verify(ctx *app.VerifyTwoFactorContext) error {
+	// TwoFactorController_Verify: start_implement
+
+	tc, err := database.GetTwoFactorChallenge(ctx, ctx.Payload.Challenge)
+	if err == database.ErrTwoFactorChallengeNotFound {
+		return ctx.Unauthorized(goa.ErrUnauthorized("Challenge does not exist"))
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	if tc.TimeExpire.Before(time.Now()) || tc.Attempts >= twoFactorMaxAttempts {
+		err = database.DeleteTwoFactorChallenge(ctx, tc.Token)
+		if err != nil {
+			log.Warning(ctx, "Unable to delete two factor challenge %s", tc.Token)
+		}
+		return ctx.Unauthorized(goa.ErrUnauthorized("Challenge does not exist"))
+	}
+
+	// The attempt is counted before the passcode is checked, so guesses sent in parallel can't go past the limit
+	err = database.IncrementTwoFactorChallengeAttempts(ctx, tc.Token, twoFactorMaxAttempts)
+	if err == database.ErrTwoFactorChallengeNotFound {
+		return ctx.Unauthorized(goa.ErrUnauthorized("Challenge does not exist"))
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	u, err := database.GetUser(ctx, tc.UserID)
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	ipAddr, _, err := net.SplitHostPort(ctx.RequestData.RemoteAddr)
+	if err != nil {
+		ipAddr = ctx.RequestData.RemoteAddr
+	}
+
+	attempt, wait, err := startLoginAttempt(ctx, u, ipAddr, false)
+	if err == ErrAccountLocked {
+		ctx.ResponseData.Header().Set("Retry-After", retryAfter(wait))
+		return ctx.Forbidden(errAccountLocked(err))
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+	if wait > 0 {
+		ctx.ResponseData.Header().Set("Retry-After", retryAfter(wait))
+		return ctx.TooManyRequests(goa.ErrBadRequest("Too many failed attempts, try again later"))
+	}
+
+	valid, err := checkTwoFactor(ctx, attempt, ctx.Payload.Passcode)
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+	if !valid && attempt.VerifiedPhone && tc.FirstFactor != database.FirstFactorPhoneCode {
+		err = checkPhoneCode(ctx, attempt, ctx.Payload.Passcode, database.PhoneCodeTwoFactor)
+		if err == nil {
+			valid = true
+		} else if err != ErrCodeInvalid && err != ErrCodeLocked {
+			return ctx.InternalServerError(goa.ErrInternal(err))
+		}
+	}
+	if !valid {
+		valid, err = useRecoveryCode(ctx, attempt, ctx.Payload.Passcode)
+		if err != nil {
+			return ctx.InternalServerError(goa.ErrInternal(err))
+		}
+	}
+	if !valid {
+		if err := recordLoginFailure(ctx, attempt); err != nil {
+			return ctx.InternalServerError(goa.ErrInternal(err))
+		}
+		return ctx.Unauthorized(goa.ErrUnauthorized("Invalid passcode"))
+	}
+
+	err = database.DeleteTwoFactorChallenge(ctx, tc.Token)
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	if err := clearLoginFailures(ctx, attempt, ipAddr); err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	sesToken, authToken, err := c.sessionController.loginUser(ctx, ctx.Request, *attempt, tc.MergeToken)
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	ctx.ResponseData.Header().Set("X-Session", sesToken)
+	ctx.ResponseData.Header().Set("Authorization", "Bearer "+authToken)
+	return ctx.OK(database.UserToUser(attempt))
+
+	// TwoFactorController_Verify: end_implement
+}
+
+// createTwoFactorChallenge is used in place of loginUser when the user has two factor enabled,
+// the session is only created once the challenge is verified. firstFactor is how the user just logged in.
+func (c *SessionController) createTwoFactorChallenge(ctx context.Context, user models.User, firstFactor string, mergeToken *uuid.UUID) (*app.TwoFactorChallenge, error) {
+	tc := &database.TwoFactorChallenge{
+		FirstFactor: firstFactor,
+		MergeToken:  mergeToken,
+		TimeExpire:  time.Now().Add(twoFactorChallengeExpire),
+		UserID:      user.ID.Hex(),
+	}
+
+	token, err := database.CreateTwoFactorChallenge(ctx, tc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &app.TwoFactorChallenge{
+		Challenge: token,
+		ExpiresAt: tc.TimeExpire,
+	}, nil
+}
+
+// checkTwoFactor checks a passcode from the user's authenticator app. Each passcode is only accepted once, since
+// it would otherwise keep working for as long as the clock skew allows.
+func checkTwoFactor(ctx context.Context, user *models.User, passcode string) (bool, error) {
+	secret, err := crypto.Decrypt(user.TwoFactorSecret, secrets.TwoFactorKey)
+	if err != nil {
+		return false, err
+	}
+
+	step, ok := totp.ValidateStep(secret, passcode, time.Now())
+	if !ok {
+		return false, nil
+	}
+
+	err = database.UseUserTwoFactorStep(ctx, user.ID.Hex(), step)
+	if err == database.ErrUserNotFound {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	user.TwoFactorStep = step
+	return true, nil
+}
+
+// useRecoveryCode accepts one of the user's recovery codes in place of a passcode, each code only works once.
+// The user is emailed whenever one is used, since it means they may have lost their authenticator.
+func useRecoveryCode(ctx context.Context, user *models.User, code string) (bool, error) {
+	err := database.UsePasswordLoginRecovery(ctx, user.ID.Hex(), crypto.HashRecoveryCode(code))
+	if err == database.ErrPasswordLoginNotFound {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	remaining := 0
+	passl, err := database.QueryPasswordLoginFromIDWithBody(ctx, user.ID.Hex())
+	if err == nil {
+		remaining = len(passl.Recovery)
+	}
+
+	textContent := fmt.Sprintf("A recovery code was just used to login to your account. You have %d recovery codes left. If this was not you, change your password and regenerate your recovery codes.", remaining)
+	htmlContent := fmt.Sprintf("A recovery code was just used to login to your account. You have <b>%d</b> recovery codes left.<br>If this was not you, change your password and regenerate your recovery codes.", remaining)
+	if err := email.SendMail("A recovery code was used", user.FirstName+" "+user.LastName, user.Email, textContent, htmlContent); err != nil {
+		log.Warning(ctx, "Unable to send recovery code notice, userID=%s, err=%v", user.ID.Hex(), err)
+	}
+
+	return true, nil
+}
@@ -12,6 +12,7 @@ import (
 	"gigglesearch.org/giggle-auth/utils/email"
 	"gigglesearch.org/giggle-auth/utils/secrets"
 	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
 	"github.com/goadesign/goa"
 	"github.com/simukti/emailcheck"
 	"io"
@@ -25,6 +26,7 @@ import (
 const emailValidateExpiration = 7 * 24 * time.Hour
 
 var ErrInvalidRecaptcha = errors.New("Invalid recaptcha response")
+var ErrEmailChanged = errors.New("Email is not the same as the one currently attached to this account")
 
 // UserController implements the user resource.
 type UserController struct {
@@ -53,7 +55,7 @@ func (c *UserController) Deactivate(ctx *app.DeactivateUserContext) error {
 	if *ctx.Admin {
 		uID = *ctx.ID
 	} else {
-		uID = c.GetUserID(ctx.Request)
+		uID = c.Claims(ctx).UserID()
 	}
 
 	user, err := database.GetUser(ctx, uID)
@@ -70,36 +72,11 @@ func (c *UserController) Deactivate(ctx *app.DeactivateUserContext) error {
 	}
 
 	getAccountItems := []access{
-		{
-			query:    database.QueryGoogleAccountUser,
-			notFound: database.ErrGoogleAccountNotFound,
-			deletion: database.DeleteGoogleAccount,
-		},
-		{
-			query:    database.QueryFacebookAccountUser,
-			notFound: database.ErrFacebookAccountNotFound,
-			deletion: database.DeleteFacebookAccount,
-		},
 		{
 			query:    database.QueryTwitterAccountUser,
 			notFound: database.ErrTwitterAccountNotFound,
 			deletion: database.DeleteTwitterAccount,
 		},
-		{
-			query:    database.QueryLinkedinAccountUser,
-			notFound: database.ErrLinkedinAccountNotFound,
-			deletion: database.DeleteLinkedinAccount,
-		},
-		{
-			query:    database.QueryMicrosoftAccountUser,
-			notFound: database.ErrMicrosoftAccountNotFound,
-			deletion: database.DeleteMicrosoftAccount,
-		},
-		{
-			query:    database.QueryPasswordLoginFromID,
-			notFound: database.ErrPasswordLoginNotFound,
-			deletion: database.DeletePasswordLogin,
-		},
 	}
 
 	for _, v := range getAccountItems {
@@ -114,6 +91,29 @@ func (c *UserController) Deactivate(ctx *app.DeactivateUserContext) error {
 		}
 	}
 
+	// Accounts with every OAuth 2.0 provider are detached at once
+	if _, err := database.DeleteSocialAccountsUser(ctx, uID); err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	// Users can have several security keys and passkeys, they are all removed
+	if _, err := database.DeleteWebauthnCredentialsUser(ctx, uID); err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	// The recovery codes are removed with the password, accounts without a password can also have them
+	if _, err := database.DeletePasswordLoginsUser(ctx, uID); err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	// A verified phone number can also be used to login
+	if user.VerifiedPhone {
+		user.VerifiedPhone = false
+		if err := database.UpdateUser(ctx, user); err != nil {
+			return ctx.InternalServerError(goa.ErrInternal(err))
+		}
+	}
+
 	// @TODO: Send Notification Via Email
 
 	_ = struct {
@@ -143,7 +143,7 @@ func (c *UserController) Deactivate(ctx *app.DeactivateUserContext) error {
 func (c *UserController) GetAllUsers(ctx *app.GetAllUsersUserContext) error {
 	// UserController_GetAllUsersFiltered: start_implement
 
-	if c.IsAdmin(ctx.Request) {
+	if c.Claims(ctx).HasAdminAccess() {
 		users, err := database.GetAllUsers()
 		if err != nil {
 			return ctx.InternalServerError(goa.ErrInternal(err))
@@ -167,7 +167,7 @@ func (c *UserController) GetAllUsers(ctx *app.GetAllUsersUserContext) error {
 func (c *UserController) GetByEmail(ctx *app.GetByEmailUserContext) error {
 	// UserController_GetByEmail: start_implement
 
-	if !c.IsAdmin(ctx.Request) {
+	if !c.Claims(ctx).HasAdminAccess() {
 		return ctx.NotFound(goa.ErrNotFound(ctx.RequestURI))
 	}
 
@@ -205,7 +205,7 @@ func (c *UserController) GetMany(ctx *app.GetManyUserContext) error {
 		return ctx.InternalServerError(goa.ErrInternal(err))
 	}
 
-	if c.IsAdmin(ctx.Request) {
+	if c.Claims(ctx).HasAdminAccess() {
 		res := make(app.UserAdminCollection, 0, len(users))
 		for _, v := range users {
 			data := database.UserToUserAdmin(v)
@@ -232,13 +232,14 @@ func (c *UserController) GetAuths(ctx *app.GetAuthsUserContext) error {
 
 	var uID string
 	var err error
+	claims := c.Claims(ctx)
 
 	if ctx.UserID == nil || *ctx.UserID == "" {
 		return ctx.BadRequest(goa.ErrBadRequest("User ID must be a number"))
-	} else if ctx.UserID != nil && c.IsAdmin(ctx.Request) {
+	} else if ctx.UserID != nil && claims.HasAdminAccess() {
 		uID = *ctx.UserID
 	} else {
-		uID = c.GetUserID(ctx.Request)
+		uID = claims.UserID()
 		if uID == "" {
 			return ctx.Unauthorized(goa.ErrUnauthorized("User ID was not recognised."))
 		}
@@ -255,50 +256,50 @@ func (c *UserController) GetAuths(ctx *app.GetAuthsUserContext) error {
 		AST.Standard = true
 	}
 
-	_, err = database.QueryGoogleAccountUser(ctx, uID)
+	accounts, err := database.QuerySocialAccountsUser(ctx, uID)
 	if err != nil {
-		if err != database.ErrGoogleAccountNotFound {
-			return ctx.InternalServerError(goa.ErrInternal(err))
-		}
-	} else {
-		AST.Google = true
+		return ctx.InternalServerError(goa.ErrInternal(err))
 	}
-
-	_, err = database.QueryFacebookAccountUser(ctx, uID)
-	if err != nil {
-		if err != database.ErrFacebookAccountNotFound {
-			return ctx.InternalServerError(goa.ErrInternal(err))
+	AST.Social = []string{}
+	for _, a := range accounts {
+		AST.Social = append(AST.Social, a.Provider)
+		switch a.Provider {
+		case "google":
+			AST.Google = true
+		case "facebook":
+			AST.Facebook = true
+		case "linkedin":
+			AST.Linkedin = true
+		case "microsoft":
+			AST.Microsoft = true
+		case "amazon":
+			AST.Amazon = true
 		}
-	} else {
-		AST.Facebook = true
 	}
 
-	_, err = database.QueryLinkedinAccountUser(ctx, uID)
+	_, err = database.QueryTwitterAccountUser(ctx, uID)
 	if err != nil {
-		if err != database.ErrLinkedinAccountNotFound {
+		if err != database.ErrTwitterAccountNotFound {
 			return ctx.InternalServerError(goa.ErrInternal(err))
 		}
 	} else {
-		AST.Linkedin = true
+		AST.Twitter = true
 	}
 
-	_, err = database.QueryMicrosoftAccountUser(ctx, uID)
+	_, err = database.QueryWebauthnCredentialUser(ctx, uID)
 	if err != nil {
-		if err != database.ErrMicrosoftAccountNotFound {
+		if err != database.ErrWebauthnCredentialNotFound {
 			return ctx.InternalServerError(goa.ErrInternal(err))
 		}
 	} else {
-		AST.Microsoft = true
+		AST.Webauthn = true
 	}
 
-	_, err = database.QueryTwitterAccountUser(ctx, uID)
+	u, err := database.GetUser(ctx, uID)
 	if err != nil {
-		if err != database.ErrTwitterAccountNotFound {
-			return ctx.InternalServerError(goa.ErrInternal(err))
-		}
-	} else {
-		AST.Twitter = true
+		return ctx.InternalServerError(goa.ErrInternal(err))
 	}
+	AST.TwoFactor = u.TwoFactorEnabled
 
 	return ctx.OK(AST)
 
@@ -309,7 +310,7 @@ func (c *UserController) GetAuths(ctx *app.GetAuthsUserContext) error {
 func (c *UserController) ResendVerifyEmail(ctx *app.ResendVerifyEmailUserContext) error {
 	// UserController_ResendVerifyEmail: start_implement
 
-	uID := c.GetUserID(ctx.Request)
+	uID := c.Claims(ctx).UserID()
 
 	u, err := database.GetUser(ctx, uID)
 	if err != nil {
@@ -387,7 +388,8 @@ func (c *UserController) Retrieve(ctx *app.RetrieveUserContext) error {
 		userID = *ctx.UserID
 	}
 
-	uID := c.GetUserID(ctx.Request)
+	claims := c.Claims(ctx)
+	uID := claims.UserID()
 
 	if userID == "" {
 		userID = uID
@@ -403,7 +405,7 @@ func (c *UserController) Retrieve(ctx *app.RetrieveUserContext) error {
 		return ctx.InternalServerError(goa.ErrInternal(err))
 	}
 
-	if c.IsAdmin(ctx.Request) {
+	if claims.HasAdminAccess() {
 		res := database.UserToUserAdmin(u)
 		return ctx.OKAdmin(res)
 	}
@@ -417,11 +419,109 @@ func (c *UserController) Retrieve(ctx *app.RetrieveUserContext) error {
 	// UserController_Retrieve: end_implement
 }
 
+// SendPhoneCode runs the send-phone-code action.
+func (c *UserController) SendPhoneCode(ctx *app.SendPhoneCodeUserContext) error {
+	// UserController_SendPhoneCode: start_implement
+
+	uID := c.Claims(ctx).UserID()
+
+	u, err := database.GetUser(ctx, uID)
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	if u.Phone == "" || u.VerifiedPhone {
+		return ctx.NotFound(goa.ErrNotFound("No phone number needs verifying currently"))
+	}
+
+	limited, err := phoneCodeRateLimited(ctx, u.Phone)
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+	if limited {
+		return ctx.TooManyRequests(goa.ErrBadRequest("Too many codes requested, try again later"))
+	}
+
+	err = sendPhoneCode(ctx, u, database.PhoneCodeVerify)
+	if err == ErrCodeLocked {
+		return ctx.TooManyRequests(goa.ErrBadRequest(err))
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	return ctx.OK([]byte(""))
+
+	// UserController_SendPhoneCode: end_implement
+}
+
+// SendVerifyCode runs the send-verify-code action.
+func (c *UserController) SendVerifyCode(ctx *app.SendVerifyCodeUserContext) error {
+	// UserController_SendVerifyCode: start_implement
+
+	uID := c.Claims(ctx).UserID()
+
+	u, err := database.GetUser(ctx, uID)
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	addr := u.Email
+	if u.VerifiedEmail {
+		if u.ChangingEmail == "" || u.ChangingEmail == u.Email {
+			return ctx.NotFound(goa.ErrNotFound("No email needs verifying currently"))
+		}
+		addr = u.ChangingEmail
+	}
+
+	limited, err := emailCodeRateLimited(ctx, addr)
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+	if limited {
+		return ctx.TooManyRequests(goa.ErrBadRequest("Too many codes requested, try again later"))
+	}
+
+	err = sendEmailCode(ctx, u, addr, false)
+	if err == ErrCodeLocked {
+		return ctx.TooManyRequests(goa.ErrBadRequest(err))
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	return ctx.OK([]byte(""))
+
+	// UserController_SendVerifyCode: end_implement
+}
+
+// Unlock runs the unlock action.
+func (c *UserController) Unlock(ctx *app.UnlockUserContext) error {
+	// UserController_Unlock: start_implement
+
+	if !c.Claims(ctx).HasAdminAccess() {
+		return ctx.Forbidden(goa.ErrUnauthorized("user is not admin"))
+	}
+
+	if !bson.IsObjectIdHex(ctx.UID) {
+		return ctx.NotFound(goa.ErrNotFound("user not found"))
+	}
+
+	err := database.UnlockUser(ctx, ctx.UID)
+	if err == database.ErrUserNotFound {
+		return ctx.NotFound(goa.ErrNotFound("user not found"))
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	return ctx.OK([]byte(""))
+
+	// UserController_Unlock: end_implement
+}
+
 // Update runs the update action.
 func (c *UserController) Update(ctx *app.UpdateUserContext) error {
 	// UserController_Update: start_implement
 
-	uID := c.GetUserID(ctx.Request)
+	uID := c.Claims(ctx).UserID()
 	u, err := database.GetUser(ctx, uID)
 	if err != nil {
 		return ctx.InternalServerError(goa.ErrInternal(err))
@@ -449,6 +549,17 @@ func (c *UserController) Update(ctx *app.UpdateUserContext) error {
 		ctx.Payload.Email = nil
 	}
 
+	if ctx.Payload.Phone != nil && *ctx.Payload.Phone != u.Phone {
+		if *ctx.Payload.Phone != "" && !phoneRegexp.MatchString(*ctx.Payload.Phone) {
+			return ctx.BadRequest(goa.ErrBadRequest("Phone number must be in E.164 format"))
+		}
+		// A new number has to be verified again, the same as a new email
+		u.VerifiedPhone = false
+		if err := database.DeletePhoneVerificationsByUserID(ctx, uID); err != nil {
+			return ctx.InternalServerError(goa.ErrInternal(err))
+		}
+	}
+
 	if ctx.Payload.GetNewsletter != nil && *ctx.Payload.GetNewsletter != u.GetNewsletter {
 		if *ctx.Payload.GetNewsletter {
 			_ = database.AddSubscriber(models.NewsletterSubscriber{
@@ -534,7 +645,7 @@ func (c *UserController) Update(ctx *app.UpdateUserContext) error {
 }
 
 func (c *UserController) UpdateAdmin(ctx *app.UpdateAdminUserContext) error {
-	if c.IsAdmin(ctx.Request) {
+	if c.Claims(ctx).HasAdminAccess() {
 		uID := *ctx.UID
 		u, err := database.GetUser(ctx, uID)
 		if err != nil {
@@ -580,20 +691,111 @@ func (c *UserController) ValidateEmail(ctx *app.ValidateEmailUserContext) error
 		return ctx.NotFound([]byte("Invalid verification code, if the code was old, resend the email so a new code will be generated"))
 	}
 
-	u, err := database.GetUser(ctx, ev.UserID)
+	err = confirmEmail(ctx, ev)
+	if err == ErrEmailChanged {
+		return ctx.NotFound([]byte(err.Error()))
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	if err := database.DeleteEmailVerification(ctx, ctx.ValidateID); err != nil {
+		return err
+	}
+
+	http.SetCookie(ctx.ResponseWriter, &http.Cookie{
+		Name:   "verifyemail",
+		Value:  "true",
+		Path:   "/",
+		MaxAge: 5 * 60, // 5 Minutes
+	})
+	ctx.ResponseData.Header().Set("Location", "/")
+	return ctx.SeeOther()
+	// UserController_ValidateEmail: end_implement
+}
+
+// VerifyEmailCode runs the verify-email-code action.
+func (c *UserController) VerifyEmailCode(ctx *app.VerifyEmailCodeUserContext) error {
+	// UserController_VerifyEmailCode: start_implement
+
+	uID := c.Claims(ctx).UserID()
+
+	ev, err := checkEmailCode(ctx, uID, ctx.Payload.Code, false)
+	if err == ErrCodeInvalid {
+		return ctx.BadRequest(goa.ErrBadRequest(err))
+	} else if err == ErrCodeLocked {
+		return ctx.Forbidden(errCodeLocked(err))
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	err = confirmEmail(ctx, ev)
+	if err == ErrEmailChanged {
+		return ctx.NotFound(goa.ErrNotFound(err))
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	return ctx.OK([]byte(""))
+
+	// UserController_VerifyEmailCode: end_implement
+}
+
+// VerifyPhone runs the verify-phone action.
+func (c *UserController) VerifyPhone(ctx *app.VerifyPhoneUserContext) error {
+	// UserController_VerifyPhone: start_implement
+
+	uID := c.Claims(ctx).UserID()
+
+	u, err := database.GetUser(ctx, uID)
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	// Phone numbers can be used to login so each one can only be verified on a single account
+	other, err := database.QueryUserPhone(ctx, u.Phone)
+	if err == nil && other.ID != u.ID {
+		return ctx.Forbidden(errAlreadyExists("Phone number is already verified on another account"))
+	} else if err != nil && err != database.ErrUserNotFound {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	err = checkPhoneCode(ctx, u, ctx.Payload.Code, database.PhoneCodeVerify)
+	if err == ErrCodeInvalid {
+		return ctx.BadRequest(goa.ErrBadRequest(err))
+	} else if err == ErrCodeLocked {
+		return ctx.Forbidden(errCodeLocked(err))
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	u.VerifiedPhone = true
+	err = database.UpdateUser(ctx, u)
 	if err != nil {
 		return ctx.InternalServerError(goa.ErrInternal(err))
 	}
 
+	return ctx.OK([]byte(""))
+
+	// UserController_VerifyPhone: end_implement
+}
+
+// confirmEmail marks the email address in ev as verified on the user's account, replacing the
+// current email if the user is changing it
+func confirmEmail(ctx context.Context, ev *database.EmailVerification) error {
+	u, err := database.GetUser(ctx, ev.UserID)
+	if err != nil {
+		return err
+	}
+
 	if !u.VerifiedEmail {
 		if u.Email != ev.Email {
-			return ctx.NotFound([]byte("Email is not the same as the one currently attached to this account"))
+			return ErrEmailChanged
 		}
 		u.VerifiedEmail = true
 		u.ChangingEmail = u.Email
 	} else {
 		if u.ChangingEmail != ev.Email {
-			return ctx.NotFound([]byte("Email is not the same as the one currently attached to this account"))
+			return ErrEmailChanged
 		}
 
 		pl, err := database.GetPasswordLogin(ctx, u.Email)
@@ -601,37 +803,20 @@ func (c *UserController) ValidateEmail(ctx *app.ValidateEmailUserContext) error
 			pl.Email = u.ChangingEmail
 			err = database.UpdatePasswordLogin(ctx, pl)
 			if err != nil {
-				return ctx.InternalServerError(goa.ErrInternal(err))
+				return err
 			}
 			err = database.DeletePasswordLogin(ctx, u.Email)
 			if err != nil {
-				return ctx.InternalServerError(goa.ErrInternal(err))
+				return err
 			}
 		} else if err != database.ErrPasswordLoginNotFound {
-			return ctx.InternalServerError(goa.ErrInternal(err))
+			return err
 		}
 
 		u.Email = u.ChangingEmail
 	}
 
-	err = database.UpdateUser(ctx, u)
-	if err != nil {
-		return ctx.InternalServerError(goa.ErrInternal(err))
-	}
-
-	if err := database.DeleteEmailVerification(ctx, ctx.ValidateID); err != nil {
-		return err
-	}
-
-	http.SetCookie(ctx.ResponseWriter, &http.Cookie{
-		Name:   "verifyemail",
-		Value:  "true",
-		Path:   "/",
-		MaxAge: 5 * 60, // 5 Minutes
-	})
-	ctx.ResponseData.Header().Set("Location", "/")
-	return ctx.SeeOther()
-	// UserController_ValidateEmail: end_implement
+	return database.UpdateUser(ctx, u)
 }
 
 func createUser(ctx context.Context, u *models.User, recaptchaResponse *string, ipAddr string) (string, error) {
@@ -737,9 +922,9 @@ func generateEmailID(emailAddr string) (string, error) {
 
 func getNumLoginMethods(ctx context.Context, userID string) int64 {
 	getAccountItems := []func(context.Context, string) (string, error){
-		database.QueryGoogleAccountUser,
-		database.QueryFacebookAccountUser,
+		database.QueryTwitterAccountUser,
 		database.QueryPasswordLoginFromID,
+		database.QueryWebauthnCredentialUser,
 	}
 	var wg sync.WaitGroup
 	var numLogins int64
@@ -753,6 +938,10 @@ func getNumLoginMethods(ctx context.Context, userID string) int64 {
 			wg.Done()
 		}(v)
 	}
+	// Each attached account with an OAuth 2.0 provider is a way to login
+	if accounts, err := database.QuerySocialAccountsUser(ctx, userID); err == nil {
+		atomic.AddInt64(&numLogins, int64(len(accounts)))
+	}
 	wg.Wait()
 	return numLogins
 }
@@ -1,33 +1,145 @@
 package crypto
 
 import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
 	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
 	"encoding/hex"
+	"errors"
+	"fmt"
 	"io"
-	"log"
-
-	"golang.org/x/crypto/scrypt"
+	"math/big"
+	"strings"
 )
 
-// GenerateSalt generates a random salt
-func GenerateSalt() string {
-	saltBytes := make([]byte, 16)
-	_, err := io.ReadFull(rand.Reader, saltBytes)
+// Encrypt encrypts a string with AES-GCM using the hex encoded key, the nonce is prepended to the result
+func Encrypt(plaintext, hexKey string) (string, error) {
+	gcm, err := newGCM(hexKey)
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", err
+	}
+
+	sealed := gcm.Seal(nonce, nonce, []byte(plaintext), nil)
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt decrypts a string created by Encrypt
+func Decrypt(ciphertext, hexKey string) (string, error) {
+	gcm, err := newGCM(hexKey)
+	if err != nil {
+		return "", err
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(ciphertext)
+	if err != nil {
+		return "", err
+	}
+	if len(sealed) < gcm.NonceSize() {
+		return "", errors.New("ciphertext is too short")
+	}
+
+	plain, err := gcm.Open(nil, sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():], nil)
+	if err != nil {
+		return "", err
+	}
+	return string(plain), nil
+}
+
+func newGCM(hexKey string) (cipher.AEAD, error) {
+	key, err := hex.DecodeString(hexKey)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(key)
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+const recoveryAlphabet = "abcdefghjkmnpqrstuvwxyz23456789"
+
+// GenerateRecoveryCodes creates n random codes in the form xxxxx-xxxxx
+func GenerateRecoveryCodes(n int) ([]string, error) {
+	codes := make([]string, n)
+	b := make([]byte, 1)
+	for i := range codes {
+		code := make([]byte, 0, 10)
+		for len(code) < cap(code) {
+			if _, err := io.ReadFull(rand.Reader, b); err != nil {
+				return nil, err
+			}
+			// Skip values that would bias the result towards the start of the alphabet
+			if int(b[0]) >= 256-256%len(recoveryAlphabet) {
+				continue
+			}
+			code = append(code, recoveryAlphabet[int(b[0])%len(recoveryAlphabet)])
+		}
+		codes[i] = string(code[:5]) + "-" + string(code[5:])
 	}
-	salt := make([]byte, 32)
-	hex.Encode(salt, saltBytes)
-	return string(salt)
+	return codes, nil
 }
 
-// HashPassword hashes a string
-func HashPassword(password, salt string) string {
-	hashedPasswordBytes, err := scrypt.Key([]byte(password), []byte(salt), 16384, 8, 1, 32)
+// HashRecoveryCode normalises a recovery code as typed by the user and hashes it for storage
+func HashRecoveryCode(code string) string {
+	return HashCode(strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code)))
+}
+
+// GenerateNumericCode creates a random code made of the given number of digits
+func GenerateNumericCode(digits int) (string, error) {
+	max := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(digits)), nil)
+	n, err := rand.Int(rand.Reader, max)
 	if err != nil {
-		log.Fatal("Unable to hash password")
+		return "", err
 	}
-	hashedPassword := make([]byte, 64)
-	hex.Encode(hashedPassword, hashedPasswordBytes)
-	return string(hashedPassword)
+	return fmt.Sprintf("%0*d", digits, n), nil
+}
+
+// HashCode hashes a one-time code for storage
+func HashCode(code string) string {
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
+
+// Sign creates a HMAC-SHA256 signature of the message, encoded to be safe in URLs
+func Sign(message, key string) string {
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(message))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySignature checks a signature created by Sign
+func VerifySignature(message, signature, key string) bool {
+	sig, err := base64.RawURLEncoding.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(message))
+	return hmac.Equal(sig, mac.Sum(nil))
+}
+
+// GenerateToken creates a random token from n bytes, encoded to be safe in URLs
+func GenerateToken(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := io.ReadFull(rand.Reader, b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// CodeChallenge creates the PKCE S256 challenge of a code verifier (RFC 7636)
+func CodeChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
 }
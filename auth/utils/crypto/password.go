@@ -0,0 +1,270 @@
+package crypto
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/crypto/scrypt"
+)
+
+var ErrUnknownHash = errors.New("Unknown password hash format")
+
+// PasswordHasher hashes passwords into PHC string format: $id$params$salt$hash
+type PasswordHasher interface {
+	// ID is the name of the algorithm, like argon2id
+	ID() string
+	Hash(password string) (string, error)
+	Verify(password, encoded string) (bool, error)
+	// NeedsRehash reports whether encoded was made with weaker settings than the hasher's
+	NeedsRehash(encoded string) bool
+}
+
+// CurrentHasher is used for new password hashes, it is replaced with the configured hasher in main.
+// Hashes made by any supported algorithm can still be verified.
+var CurrentHasher PasswordHasher = &Argon2idHasher{Memory: 64 * 1024, Time: 3, Threads: 2}
+
+// HashPassword hashes password with CurrentHasher
+func HashPassword(password string) (string, error) {
+	return CurrentHasher.Hash(password)
+}
+
+// VerifyPassword checks password against an encoded hash made by any supported algorithm. rehash is
+// set when the password matched but the hash should be replaced with one from CurrentHasher.
+func VerifyPassword(password, encoded string) (ok, rehash bool, err error) {
+	h, err := hasherFor(encoded)
+	if err != nil {
+		return false, false, err
+	}
+
+	ok, err = h.Verify(password, encoded)
+	if err != nil || !ok {
+		return false, false, err
+	}
+
+	if h.ID() != CurrentHasher.ID() {
+		return true, true, nil
+	}
+
+	return true, CurrentHasher.NeedsRehash(encoded), nil
+}
+
+var (
+	dummyHashOnce sync.Once
+	dummyHash     string
+)
+
+// VerifyDummyPassword takes as long as checking password against a hash from CurrentHasher, for when there is no
+// hash to check it against, so that how long the check took doesn't tell whether there was one
+func VerifyDummyPassword(password string) {
+	// Made on first use, since CurrentHasher is only configured in main
+	dummyHashOnce.Do(func() {
+		dummyHash, _ = HashPassword("dummy password")
+	})
+	if dummyHash != "" {
+		_, _, _ = VerifyPassword(password, dummyHash)
+	}
+}
+
+// hasherFor returns a hasher that can verify encoded, the settings come from the hash itself
+func hasherFor(encoded string) (PasswordHasher, error) {
+	parts := strings.SplitN(encoded, "$", 3)
+	if len(parts) < 3 || parts[0] != "" {
+		return nil, ErrUnknownHash
+	}
+
+	switch parts[1] {
+	case "2a", "2b", "2y":
+		return &BcryptHasher{}, nil
+	case "scrypt":
+		return &ScryptHasher{}, nil
+	case "argon2id":
+		return &Argon2idHasher{}, nil
+	}
+
+	return nil, ErrUnknownHash
+}
+
+// BcryptHasher uses bcrypt's own modular crypt format, which is how hashes were stored before other
+// algorithms were supported
+type BcryptHasher struct {
+	Cost int
+}
+
+func (h *BcryptHasher) ID() string {
+	return "bcrypt"
+}
+
+func (h *BcryptHasher) Hash(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), h.Cost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+func (h *BcryptHasher) Verify(password, encoded string) (bool, error) {
+	err := bcrypt.CompareHashAndPassword([]byte(encoded), []byte(password))
+	if err == bcrypt.ErrMismatchedHashAndPassword {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (h *BcryptHasher) NeedsRehash(encoded string) bool {
+	cost, err := bcrypt.Cost([]byte(encoded))
+	return err != nil || cost < h.Cost
+}
+
+// ScryptHasher encodes hashes as $scrypt$ln=15,r=8,p=1$salt$hash, where N is 2^ln
+type ScryptHasher struct {
+	LogN uint
+	R    int
+	P    int
+}
+
+func (h *ScryptHasher) ID() string {
+	return "scrypt"
+}
+
+func (h *ScryptHasher) Hash(password string) (string, error) {
+	salt, err := generatePasswordSalt()
+	if err != nil {
+		return "", err
+	}
+
+	key, err := scrypt.Key([]byte(password), salt, 1<<h.LogN, h.R, h.P, passwordKeyLength)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("$scrypt$ln=%d,r=%d,p=%d$%s$%s", h.LogN, h.R, h.P, encodePHC(salt), encodePHC(key)), nil
+}
+
+func (h *ScryptHasher) Verify(password, encoded string) (bool, error) {
+	var s ScryptHasher
+	salt, key, err := decodePHC(encoded, "scrypt", "ln=%d,r=%d,p=%d", &s.LogN, &s.R, &s.P)
+	if err != nil {
+		return false, err
+	}
+
+	other, err := scrypt.Key([]byte(password), salt, 1<<s.LogN, s.R, s.P, len(key))
+	if err != nil {
+		return false, err
+	}
+
+	return subtle.ConstantTimeCompare(key, other) == 1, nil
+}
+
+func (h *ScryptHasher) NeedsRehash(encoded string) bool {
+	var s ScryptHasher
+	if _, _, err := decodePHC(encoded, "scrypt", "ln=%d,r=%d,p=%d", &s.LogN, &s.R, &s.P); err != nil {
+		return true
+	}
+	return s.LogN < h.LogN || s.R < h.R || s.P < h.P
+}
+
+// Argon2idHasher encodes hashes as $argon2id$v=19$m=65536,t=3,p=2$salt$hash, where Memory is in KiB
+type Argon2idHasher struct {
+	Memory  uint32
+	Time    uint32
+	Threads uint8
+}
+
+func (h *Argon2idHasher) ID() string {
+	return "argon2id"
+}
+
+func (h *Argon2idHasher) Hash(password string) (string, error) {
+	salt, err := generatePasswordSalt()
+	if err != nil {
+		return "", err
+	}
+
+	key := argon2.IDKey([]byte(password), salt, h.Time, h.Memory, h.Threads, passwordKeyLength)
+
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s", argon2.Version, h.Memory, h.Time, h.Threads, encodePHC(salt), encodePHC(key)), nil
+}
+
+func (h *Argon2idHasher) Verify(password, encoded string) (bool, error) {
+	var a Argon2idHasher
+	var version int
+	salt, key, err := decodePHC(encoded, "argon2id", "v=%d$m=%d,t=%d,p=%d", &version, &a.Memory, &a.Time, &a.Threads)
+	if err != nil {
+		return false, err
+	}
+	if version != argon2.Version {
+		return false, ErrUnknownHash
+	}
+
+	other := argon2.IDKey([]byte(password), salt, a.Time, a.Memory, a.Threads, uint32(len(key)))
+
+	return subtle.ConstantTimeCompare(key, other) == 1, nil
+}
+
+func (h *Argon2idHasher) NeedsRehash(encoded string) bool {
+	var a Argon2idHasher
+	var version int
+	if _, _, err := decodePHC(encoded, "argon2id", "v=%d$m=%d,t=%d,p=%d", &version, &a.Memory, &a.Time, &a.Threads); err != nil {
+		return true
+	}
+	return version != argon2.Version || a.Memory < h.Memory || a.Time < h.Time || a.Threads < h.Threads
+}
+
+const (
+	passwordSaltLength = 16
+	passwordKeyLength  = 32
+)
+
+func generatePasswordSalt() ([]byte, error) {
+	salt := make([]byte, passwordSaltLength)
+	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+		return nil, err
+	}
+	return salt, nil
+}
+
+// encodePHC encodes a salt or hash the way the PHC string format does, base64 without padding
+func encodePHC(b []byte) string {
+	return base64.RawStdEncoding.EncodeToString(b)
+}
+
+// decodePHC splits encoded into the salt and hash, scanning the parameters between the algorithm id
+// and the salt with format
+func decodePHC(encoded, id, format string, params ...interface{}) (salt, key []byte, err error) {
+	prefix := "$" + id + "$"
+	if !strings.HasPrefix(encoded, prefix) {
+		return nil, nil, ErrUnknownHash
+	}
+
+	i := strings.LastIndex(encoded, "$")
+	j := strings.LastIndex(encoded[:i], "$")
+	if j < len(prefix) {
+		return nil, nil, ErrUnknownHash
+	}
+
+	if _, err := fmt.Sscanf(encoded[len(prefix):j], format, params...); err != nil {
+		return nil, nil, ErrUnknownHash
+	}
+
+	if salt, err = base64.RawStdEncoding.DecodeString(encoded[j+1 : i]); err != nil {
+		return nil, nil, ErrUnknownHash
+	}
+	if key, err = base64.RawStdEncoding.DecodeString(encoded[i+1:]); err != nil {
+		return nil, nil, ErrUnknownHash
+	}
+	if len(key) == 0 {
+		return nil, nil, ErrUnknownHash
+	}
+
+	return salt, key, nil
+}
@@ -0,0 +1,105 @@
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// Period is the number of seconds each passcode is valid for
+	Period = 30
+	// Digits is the length of a generated passcode
+	Digits = 6
+	// Skew is the number of periods before and after the current one that are also accepted
+	Skew = 1
+
+	secretSize = 20
+)
+
+var b32 = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateSecret creates a new random base32 encoded secret
+func GenerateSecret() (string, error) {
+	secret := make([]byte, secretSize)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return b32.EncodeToString(secret), nil
+}
+
+// KeyURI creates the otpauth:// URI used by authenticator apps to add the secret
+func KeyURI(issuer, account, secret string) string {
+	v := url.Values{}
+	v.Set("secret", secret)
+	v.Set("issuer", issuer)
+	v.Set("algorithm", "SHA1")
+	v.Set("digits", fmt.Sprint(Digits))
+	v.Set("period", fmt.Sprint(Period))
+
+	label := url.PathEscape(issuer + ":" + account)
+	return "otpauth://totp/" + label + "?" + v.Encode()
+}
+
+// Code generates the passcode for the secret at the given time
+func Code(secret string, t time.Time) (string, error) {
+	return code(secret, uint64(t.Unix()/Period))
+}
+
+// Validate checks the passcode against the secret at the given time, allowing for clock skew
+func Validate(secret, passcode string, t time.Time) bool {
+	_, ok := ValidateStep(secret, passcode, t)
+	return ok
+}
+
+// ValidateStep checks the passcode like Validate and returns the time step it was generated for. Passcodes for a
+// step at or before the last one accepted have to be refused, so each passcode can only be used once.
+func ValidateStep(secret, passcode string, t time.Time) (int64, bool) {
+	passcode = strings.TrimSpace(passcode)
+	if len(passcode) != Digits {
+		return 0, false
+	}
+
+	counter := t.Unix() / Period
+	for i := int64(-Skew); i <= Skew; i++ {
+		c, err := code(secret, uint64(counter+i))
+		if err != nil {
+			return 0, false
+		}
+		if subtle.ConstantTimeCompare([]byte(c), []byte(passcode)) == 1 {
+			return counter + i, true
+		}
+	}
+	return 0, false
+}
+
+// code implements the HOTP algorithm from RFC 4226
+func code(secret string, counter uint64) (string, error) {
+	key, err := b32.DecodeString(strings.ToUpper(strings.TrimRight(secret, "=")))
+	if err != nil {
+		return "", err
+	}
+
+	msg := make([]byte, 8)
+	binary.BigEndian.PutUint64(msg, counter)
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg)
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0xf
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	mod := uint32(1)
+	for i := 0; i < Digits; i++ {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", Digits, value%mod), nil
+}
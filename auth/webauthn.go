@@ -0,0 +1,413 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"gigglesearch.org/giggle-auth/auth/app"
+	"gigglesearch.org/giggle-auth/auth/database"
+	"gigglesearch.org/giggle-auth/auth/models"
+	"gigglesearch.org/giggle-auth/utils/auth"
+	"gigglesearch.org/giggle-auth/utils/log"
+	"gigglesearch.org/giggle-auth/utils/secrets"
+	"github.com/duo-labs/webauthn/protocol"
+	"github.com/duo-labs/webauthn/webauthn"
+	"github.com/goadesign/goa"
+	"github.com/gofrs/uuid"
+	"strings"
+	"time"
+)
+
+const (
+	webauthnSessionExpire = 5 * time.Minute
+	webauthnDefaultName   = "Passkey"
+)
+
+// WebauthnController implements the webauthn resource.
+type WebauthnController struct {
+	*goa.Controller
+	auth.JWTSecurity
+	sessionController *SessionController
+	webAuthn          *webauthn.WebAuthn
+}
+
+// NewWebauthnController creates a webauthn controller.
+func NewWebauthnController(service *goa.Service, jwtSec auth.JWTSecurity, sesCont *SessionController) *WebauthnController {
+	w, err := webauthn.New(&webauthn.Config{
+		RPDisplayName: "Giggle",
+		RPID:          secrets.WebauthnRPID,
+		RPOrigin:      secrets.URL,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return &WebauthnController{
+		Controller:        service.NewController("WebauthnController"),
+		JWTSecurity:       jwtSec,
+		sessionController: sesCont,
+		webAuthn:          w,
+	}
+}
+
+// List runs the list action.
+func (c *WebauthnController) List(ctx *app.ListWebauthnContext) error {
+	// WebauthnController_List: start_implement
+
+	creds, err := database.QueryWebauthnCredentials(ctx, c.Claims(ctx).UserID())
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	res := make(app.WebauthnCredentialCollection, len(creds))
+	for i, cred := range creds {
+		res[i] = database.WebauthnCredentialToWebauthnCredential(cred)
+	}
+
+	return ctx.OK(res)
+
+	// WebauthnController_List: end_implement
+}
+
+// LoginFinish runs the login-finish action.
+func (c *WebauthnController) LoginFinish(ctx *app.LoginFinishWebauthnContext) error {
+	// WebauthnController_LoginFinish: start_implement
+
+	ws, err := redeemWebauthnSession(ctx, ctx.Payload.Ceremony)
+	if err == database.ErrWebauthnSessionNotFound {
+		return ctx.Unauthorized(goa.ErrUnauthorized("Ceremony does not exist"))
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+	if ws.Register {
+		return ctx.Unauthorized(goa.ErrUnauthorized("Ceremony does not exist"))
+	}
+	if ws.UserID == "" {
+		return ctx.Unauthorized(goa.ErrUnauthorized("Unable to verify passkey"))
+	}
+
+	body, err := json.Marshal(ctx.Payload.Credential)
+	if err != nil {
+		return ctx.BadRequest(goa.ErrBadRequest(err))
+	}
+	parsed, err := protocol.ParseCredentialRequestResponseBody(bytes.NewReader(body))
+	if err != nil {
+		return ctx.BadRequest(goa.ErrBadRequest(err))
+	}
+
+	wu, err := loadWebauthnUser(ctx, ws.UserID)
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	cred, err := c.webAuthn.ValidateLogin(wu, webauthnSessionData(ws), parsed)
+	if err != nil {
+		return ctx.Unauthorized(goa.ErrUnauthorized("Unable to verify passkey"))
+	}
+	// Checked here as well as by the session, which is only as strict as the options it was started with
+	if !parsed.Response.AuthenticatorData.Flags.UserVerified() {
+		return ctx.Unauthorized(goa.ErrUnauthorized("Unable to verify passkey"))
+	}
+	if cred.Authenticator.CloneWarning {
+		log.Warning(ctx, "Possible cloned authenticator used to login, userID=%s", ws.UserID)
+		return ctx.Unauthorized(goa.ErrUnauthorized("Unable to verify passkey"))
+	}
+
+	for _, stored := range wu.credentials {
+		if bytes.Equal(stored.CredentialID, cred.ID) {
+			now := time.Now()
+			stored.SignCount = cred.Authenticator.SignCount
+			stored.LastUsed = &now
+			err = database.UpdateWebauthnCredential(ctx, stored)
+			if err != nil {
+				log.Warning(ctx, "Unable to update webauthn credential, id=%s", stored.ID.Hex())
+			}
+			break
+		}
+	}
+
+	// The passkey is only accepted with user verification, a PIN or biometric, so it already counts as two factors
+	// and does not need the TOTP challenge
+	sesToken, authToken, err := c.sessionController.loginUser(ctx, ctx.Request, *wu.user, nil)
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	ctx.ResponseData.Header().Set("X-Session", sesToken)
+	ctx.ResponseData.Header().Set("Authorization", "Bearer "+authToken)
+	return ctx.OK(database.UserToUser(wu.user))
+
+	// WebauthnController_LoginFinish: end_implement
+}
+
+// LoginStart runs the login-start action.
+func (c *WebauthnController) LoginStart(ctx *app.LoginStartWebauthnContext) error {
+	// WebauthnController_LoginStart: start_implement
+
+	// Unknown emails and accounts without passkeys get a ceremony that can't be finished, so the response doesn't
+	// tell anyone which emails have an account
+	uID := ""
+	wu := &webauthnUser{
+		user:        &models.User{},
+		credentials: []*database.WebauthnCredential{{CredentialID: []byte(uuid.Must(uuid.NewV4()).String())}},
+	}
+	u, err := database.QueryUserEmail(ctx, strings.ToLower(ctx.Payload.Email))
+	if err == nil {
+		found, err := loadWebauthnUser(ctx, u.ID.Hex())
+		if err != nil {
+			return ctx.InternalServerError(goa.ErrInternal(err))
+		}
+		if len(found.credentials) > 0 {
+			uID = u.ID.Hex()
+			wu = found
+		}
+	} else if err != database.ErrUserNotFound {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	assertion, sd, err := c.webAuthn.BeginLogin(wu, webauthn.WithUserVerification(protocol.VerificationRequired))
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+	// The allowed credentials stay in the session only, the browser offers the account's discoverable passkeys
+	assertion.Response.AllowedCredentials = nil
+
+	ceremony, err := database.CreateWebauthnSession(ctx, newWebauthnSession(sd, uID, false))
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	return ctx.OK(&app.WebauthnOptions{
+		Ceremony: ceremony,
+		Options:  assertion,
+	})
+
+	// WebauthnController_LoginStart: end_implement
+}
+
+// RegisterFinish runs the register-finish action.
+func (c *WebauthnController) RegisterFinish(ctx *app.RegisterFinishWebauthnContext) error {
+	// WebauthnController_RegisterFinish: start_implement
+
+	uID := c.Claims(ctx).UserID()
+
+	ws, err := redeemWebauthnSession(ctx, ctx.Payload.Ceremony)
+	if err == database.ErrWebauthnSessionNotFound {
+		return ctx.BadRequest(goa.ErrBadRequest("Ceremony does not exist"))
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+	if !ws.Register || ws.UserID != uID {
+		return ctx.BadRequest(goa.ErrBadRequest("Ceremony does not exist"))
+	}
+
+	body, err := json.Marshal(ctx.Payload.Credential)
+	if err != nil {
+		return ctx.BadRequest(goa.ErrBadRequest(err))
+	}
+	parsed, err := protocol.ParseCredentialCreationResponseBody(bytes.NewReader(body))
+	if err != nil {
+		return ctx.BadRequest(goa.ErrBadRequest(err))
+	}
+
+	wu, err := loadWebauthnUser(ctx, uID)
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	cred, err := c.webAuthn.CreateCredential(wu, webauthnSessionData(ws), parsed)
+	if err != nil {
+		return ctx.BadRequest(goa.ErrBadRequest(err))
+	}
+
+	name := webauthnDefaultName
+	if ctx.Payload.Name != nil && *ctx.Payload.Name != "" {
+		name = *ctx.Payload.Name
+	}
+
+	newCred := &database.WebauthnCredential{
+		AAGUID:          cred.Authenticator.AAGUID,
+		AttestationType: cred.AttestationType,
+		CredentialID:    cred.ID,
+		Name:            name,
+		PublicKey:       cred.PublicKey,
+		SignCount:       cred.Authenticator.SignCount,
+		TimeCreated:     time.Now(),
+		UserID:          uID,
+	}
+	_, err = database.CreateWebauthnCredential(ctx, newCred)
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	return ctx.OK(database.WebauthnCredentialToWebauthnCredential(newCred))
+
+	// WebauthnController_RegisterFinish: end_implement
+}
+
+// RegisterStart runs the register-start action.
+func (c *WebauthnController) RegisterStart(ctx *app.RegisterStartWebauthnContext) error {
+	// WebauthnController_RegisterStart: start_implement
+
+	uID := c.Claims(ctx).UserID()
+
+	wu, err := loadWebauthnUser(ctx, uID)
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	// Stop the same authenticator from being registered twice
+	var exclusions []protocol.CredentialDescriptor
+	for _, cred := range wu.credentials {
+		exclusions = append(exclusions, protocol.CredentialDescriptor{
+			Type:         protocol.PublicKeyCredentialType,
+			CredentialID: cred.CredentialID,
+		})
+	}
+
+	// Passkeys have to be discoverable, since logins don't list the account's credentials
+	creation, sd, err := c.webAuthn.BeginRegistration(wu, webauthn.WithExclusions(exclusions), webauthn.WithAuthenticatorSelection(protocol.AuthenticatorSelection{
+		RequireResidentKey: protocol.ResidentKeyRequired(),
+		UserVerification:   protocol.VerificationRequired,
+	}))
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	ceremony, err := database.CreateWebauthnSession(ctx, newWebauthnSession(sd, uID, true))
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	return ctx.OK(&app.WebauthnOptions{
+		Ceremony: ceremony,
+		Options:  creation,
+	})
+
+	// WebauthnController_RegisterStart: end_implement
+}
+
+// Remove runs the remove action.
+func (c *WebauthnController) Remove(ctx *app.RemoveWebauthnContext) error {
+	// WebauthnController_Remove: start_implement
+
+	uID := c.Claims(ctx).UserID()
+
+	cred, err := database.GetWebauthnCredential(ctx, ctx.ID)
+	if err == database.ErrWebauthnCredentialNotFound {
+		return ctx.NotFound(goa.ErrNotFound("No passkey with the given ID found"))
+	} else if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+	if cred.UserID != uID {
+		return ctx.NotFound(goa.ErrNotFound("No passkey with the given ID found"))
+	}
+
+	creds, err := database.QueryWebauthnCredentials(ctx, uID)
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+	if len(creds) <= 1 && getNumLoginMethods(ctx, uID) <= 1 {
+		return ctx.Forbidden(errMustBeAbleToLogin("Cannot remove passkey if it is the only way to login"))
+	}
+
+	err = database.DeleteWebauthnCredential(ctx, ctx.ID)
+	if err != nil {
+		return ctx.InternalServerError(goa.ErrInternal(err))
+	}
+
+	return ctx.OK([]byte(""))
+
+	// WebauthnController_Remove: end_implement
+}
+
+// redeemWebauthnSession gets and deletes a ceremony, so that each challenge can only be answered once
+func redeemWebauthnSession(ctx context.Context, ceremony uuid.UUID) (*database.WebauthnSession, error) {
+	ws, err := database.GetWebauthnSession(ctx, ceremony)
+	if err != nil {
+		return nil, err
+	}
+
+	err = database.DeleteWebauthnSession(ctx, ws.ID)
+	if err != nil {
+		log.Warning(ctx, "Unable to delete webauthn session %s", ws.ID)
+	}
+
+	if ws.TimeExpire.Before(time.Now()) {
+		return nil, database.ErrWebauthnSessionNotFound
+	}
+
+	return ws, nil
+}
+
+func newWebauthnSession(sd *webauthn.SessionData, userID string, register bool) *database.WebauthnSession {
+	return &database.WebauthnSession{
+		AllowedCredentialIDs: sd.AllowedCredentialIDs,
+		Challenge:            sd.Challenge,
+		Register:             register,
+		TimeExpire:           time.Now().Add(webauthnSessionExpire),
+		UserID:               userID,
+		UserVerification:     string(sd.UserVerification),
+	}
+}
+
+func webauthnSessionData(ws *database.WebauthnSession) webauthn.SessionData {
+	return webauthn.SessionData{
+		AllowedCredentialIDs: ws.AllowedCredentialIDs,
+		Challenge:            ws.Challenge,
+		UserID:               []byte(ws.UserID),
+		UserVerification:     protocol.UserVerificationRequirement(ws.UserVerification),
+	}
+}
+
+// webauthnUser adapts a user and their registered credentials to webauthn.User
+type webauthnUser struct {
+	user        *models.User
+	credentials []*database.WebauthnCredential
+}
+
+func loadWebauthnUser(ctx context.Context, userID string) (*webauthnUser, error) {
+	u, err := database.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	creds, err := database.QueryWebauthnCredentials(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &webauthnUser{user: u, credentials: creds}, nil
+}
+
+func (u *webauthnUser) WebAuthnID() []byte {
+	return []byte(u.user.ID.Hex())
+}
+
+func (u *webauthnUser) WebAuthnName() string {
+	return u.user.Email
+}
+
+func (u *webauthnUser) WebAuthnDisplayName() string {
+	return u.user.FirstName + " " + u.user.LastName
+}
+
+func (u *webauthnUser) WebAuthnIcon() string {
+	return u.user.ProfileImage
+}
+
+func (u *webauthnUser) WebAuthnCredentials() []webauthn.Credential {
+	creds := make([]webauthn.Credential, len(u.credentials))
+	for i, cred := range u.credentials {
+		creds[i] = webauthn.Credential{
+			ID:              cred.CredentialID,
+			PublicKey:       cred.PublicKey,
+			AttestationType: cred.AttestationType,
+			Authenticator: webauthn.Authenticator{
+				AAGUID:    cred.AAGUID,
+				SignCount: cred.SignCount,
+			},
+		}
+	}
+	return creds
+}
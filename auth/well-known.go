@@ -0,0 +1,87 @@
+package auth
+
+import (
+	"gigglesearch.org/giggle-auth/auth/app"
+	"gigglesearch.org/giggle-auth/utils/auth"
+	"gigglesearch.org/giggle-auth/utils/secrets"
+	"github.com/goadesign/goa"
+)
+
+// How long verifiers may cache the key set, new keys are published for secrets.JWTKeyPublishHours before
+// they sign so this has to be shorter
+const keySetMaxAge = "3600"
+
+// WellKnownController implements the well-known resource.
+type WellKnownController struct {
+	*goa.Controller
+	auth.JWTSecurity
+}
+
+// NewWellKnownController creates a well-known controller.
+func NewWellKnownController(service *goa.Service, jwtSec auth.JWTSecurity) *WellKnownController {
+	return &WellKnownController{
+		Controller:  service.NewController("WellKnownController"),
+		JWTSecurity: jwtSec,
+	}
+}
+
+// KeySet runs the key-set action.
+func (c *WellKnownController) KeySet(ctx *app.KeySetWellKnownContext) error {
+	// WellKnownController_KeySet: start_implement
+
+	res := &app.JSONWebKeySet{Keys: []*app.JSONWebKey{}}
+	for _, k := range c.KeyRing().JWKS() {
+		k := k
+		jwk := &app.JSONWebKey{
+			Kty: k.Kty,
+			Use: k.Use,
+			Kid: k.Kid,
+			Alg: k.Alg,
+		}
+		if k.N != "" {
+			jwk.N = &k.N
+			jwk.E = &k.E
+		}
+		if k.Crv != "" {
+			jwk.Crv = &k.Crv
+			jwk.X = &k.X
+		}
+		if k.Y != "" {
+			jwk.Y = &k.Y
+		}
+		res.Keys = append(res.Keys, jwk)
+	}
+
+	ctx.ResponseData.Header().Set("Cache-Control", "public, max-age="+keySetMaxAge)
+	return ctx.OK(res)
+
+	// WellKnownController_KeySet: end_implement
+}
+
+// OpenidConfiguration runs the openid-configuration action.
+func (c *WellKnownController) OpenidConfiguration(ctx *app.OpenidConfigurationWellKnownContext) error {
+	// WellKnownController_OpenidConfiguration: start_implement
+
+	oauthURL := secrets.URL + oauthBasePath
+	res := &app.OpenidConfiguration{
+		Issuer:                            secrets.OIDCIssuer,
+		AuthorizationEndpoint:             oauthURL + "/authorize",
+		TokenEndpoint:                     oauthURL + "/token",
+		UserinfoEndpoint:                  oauthURL + "/userinfo",
+		JwksURI:                           secrets.URL + "/.well-known/jwks.json",
+		ResponseTypesSupported:            []string{"code"},
+		SubjectTypesSupported:             []string{"public"},
+		IDTokenSigningAlgValuesSupported:  c.KeyRing().Algorithms(),
+		ScopesSupported:                   oidcScopes,
+		TokenEndpointAuthMethodsSupported: []string{"none", "client_secret_basic", "client_secret_post"},
+		GrantTypesSupported:               grantTypes,
+		CodeChallengeMethodsSupported:     []string{"S256"},
+		ClaimsSupported: []string{"iss", "sub", "aud", "exp", "iat", "auth_time", "nonce", "name", "given_name",
+			"family_name", "gender", "picture", "email", "email_verified", "phone_number", "phone_number_verified"},
+	}
+
+	ctx.ResponseData.Header().Set("Cache-Control", "public, max-age="+keySetMaxAge)
+	return ctx.OK(res)
+
+	// WellKnownController_OpenidConfiguration: end_implement
+}
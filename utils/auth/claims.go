@@ -0,0 +1,169 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"gigglesearch.org/giggle-auth/utils/secrets"
+
+	jwtgo "github.com/dgrijalva/jwt-go"
+)
+
+var ErrInvalidToken = errors.New("Not a valid token")
+var ErrTokenRevoked = errors.New("Token has been revoked")
+
+// Claims are the claims of an auth token, the user is the subject. Tokens issued to service accounts have the
+// client as the subject instead, and no session.
+type Claims struct {
+	jwtgo.StandardClaims
+	// Session the token was issued to
+	Session      string `json:"ses"`
+	Admin        bool   `json:"adm,omitempty"`
+	PluginAuthor bool   `json:"pla,omitempty"`
+	EventAuthor  bool   `json:"iea,omitempty"`
+	Ghost        bool   `json:"ghs,omitempty"`
+	// Space separated scopes of the client the user logged in through
+	Scope string `json:"scope,omitempty"`
+	// Space separated OpenID Connect scopes the user approved, they only choose the claims returned by userinfo
+	// and grant no access to the API
+	OIDCScope string `json:"oidc_scope,omitempty"`
+	// Client of the service account the token was issued to, empty for tokens issued to users
+	ClientID string `json:"client_id,omitempty"`
+}
+
+// SessionClaims are the claims of a session token, which can only be used to get new auth tokens
+type SessionClaims struct {
+	jwtgo.StandardClaims
+	// Session the token refreshes
+	SessionID string `json:"prn"`
+}
+
+// IDTokenClaims are the claims of an OpenID Connect ID token, the profile claims are only included for the
+// scopes the client was granted
+type IDTokenClaims struct {
+	jwtgo.StandardClaims
+	Nonce    string `json:"nonce,omitempty"`
+	AuthTime int64  `json:"auth_time,omitempty"`
+	UserInfo
+}
+
+// UserInfo are the OpenID Connect standard claims about a user
+type UserInfo struct {
+	Name                string `json:"name,omitempty"`
+	GivenName           string `json:"given_name,omitempty"`
+	FamilyName          string `json:"family_name,omitempty"`
+	Gender              string `json:"gender,omitempty"`
+	Picture             string `json:"picture,omitempty"`
+	Email               string `json:"email,omitempty"`
+	EmailVerified       *bool  `json:"email_verified,omitempty"`
+	PhoneNumber         string `json:"phone_number,omitempty"`
+	PhoneNumberVerified *bool  `json:"phone_number_verified,omitempty"`
+}
+
+type contextKey int
+
+const claimsKey contextKey = iota
+
+// WithClaims stores the claims of the request's auth token on the context
+func WithClaims(ctx context.Context, claims *Claims) context.Context {
+	return context.WithValue(ctx, claimsKey, claims)
+}
+
+// ContextClaims returns the claims stored by the JWT middleware, nil when the request was not authenticated
+// with an auth token
+func ContextClaims(ctx context.Context) *Claims {
+	claims, _ := ctx.Value(claimsKey).(*Claims)
+	return claims
+}
+
+// validate checks the claims that are not checked by jwt-go, the signature and times of the token must
+// already have been verified. Tokens are only accepted from the client they were issued to.
+func (c *Claims) validate(ctx context.Context) error {
+	if !c.VerifyAudience(ContextClient(ctx).TokenAudience(), true) {
+		return ErrInvalidToken
+	}
+	return c.validateIssued()
+}
+
+// validateIssued checks that the claims were issued by the service and have not been revoked, for whichever
+// client
+func (c *Claims) validateIssued() error {
+	if !c.VerifyIssuer(secrets.TokenIssuer, true) || c.Subject == "" {
+		return ErrInvalidToken
+	}
+
+	if revoked, err := IsRevoked(c.Id, c.Session); err != nil {
+		return err
+	} else if revoked {
+		return ErrTokenRevoked
+	}
+	return nil
+}
+
+// The accessors can be used on nil claims, which have no permissions
+
+func (c *Claims) UserID() string {
+	if c == nil || c.IsService() {
+		return ""
+	}
+	return c.Subject
+}
+
+func (c *Claims) SessionID() string {
+	if c == nil {
+		return ""
+	}
+	return c.Session
+}
+
+func (c *Claims) IsAdmin() bool {
+	return c != nil && c.Admin
+}
+
+// IsService is whether the token was issued to a service account through the client credentials grant
+func (c *Claims) IsService() bool {
+	return c != nil && c.ClientID != ""
+}
+
+// HasAdminAccess is whether the token can use the admin actions, users have to be an admin and service accounts
+// have to be granted the admin scope
+func (c *Claims) HasAdminAccess() bool {
+	return c.IsAdmin() || (c.IsService() && c.HasScope("admin"))
+}
+
+func (c *Claims) IsPluginAuthor() bool {
+	return c != nil && c.PluginAuthor
+}
+
+func (c *Claims) IsEventAuthor() bool {
+	return c != nil && c.EventAuthor
+}
+
+func (c *Claims) IsGhost() bool {
+	return c != nil && c.Ghost
+}
+
+func (c *Claims) HasScope(scope string) bool {
+	if c == nil {
+		return false
+	}
+	return hasField(c.Scope, scope)
+}
+
+// HasOIDCScope is whether the user approved the OpenID Connect scope for the client
+func (c *Claims) HasOIDCScope(scope string) bool {
+	if c == nil {
+		return false
+	}
+	return hasField(c.OIDCScope, scope)
+}
+
+func hasField(fields, field string) bool {
+	for _, f := range strings.Fields(fields) {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
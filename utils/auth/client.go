@@ -0,0 +1,283 @@
+package auth
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"gigglesearch.org/giggle-auth/auth/utils/crypto"
+	"gigglesearch.org/giggle-auth/utils/database"
+	"gigglesearch.org/giggle-auth/utils/secrets"
+
+	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
+)
+
+// Grant types a client can be allowed to use at the token endpoint
+const (
+	GrantAuthorizationCode = "authorization_code"
+	GrantRefreshToken      = "refresh_token"
+	// Service accounts get tokens for themselves, rather than for a user, with the client credentials grant
+	GrantClientCredentials = "client_credentials"
+)
+
+const clientSecretBytes = 32
+
+var ErrClientNotFound = errors.New("No Client found in the database")
+
+// Scope that lets a token approve OpenID Connect authorization requests for the user, so only our own
+// applications can login users to other applications. Third party clients are never issued it.
+const ApproveScope = "approve"
+
+// Client is an application users login through. First party applications send their client ID in the API-Key
+// header, third party applications send it in OpenID Connect requests. Tokens issued to logins through a
+// client are limited to its audience and scopes.
+type Client struct {
+	ID string `bson:"client_id"`
+	// Hash of the client secret, empty for public clients such as browser and mobile applications
+	SecretHash string `bson:"secret_hash,omitempty"`
+	// Name of the application, shown to users when it asks them to login
+	Name    string `bson:"name"`
+	LogoURL string `bson:"logo_url,omitempty"`
+	// URLs the application can have users sent back to, after they login or from the links in emails
+	RedirectURIs []string `bson:"redirect_uris"`
+	// Grant types the application can use at the token endpoint
+	GrantTypes []string `bson:"grant_types"`
+	// Audience of the tokens issued to the client, secrets.TokenAudience when empty, or the client ID for third
+	// party clients
+	Audience string `bson:"audience,omitempty"`
+	// Scopes of the tokens issued to the client, secrets.DefaultTokenScopes when empty, or none for third party
+	// clients
+	Scopes []string `bson:"scopes,omitempty"`
+	// Whether the application is run by someone else, its tokens only work with its own client ID as the API key
+	ThirdParty  bool      `bson:"third_party,omitempty"`
+	TimeCreated time.Time `bson:"time_created"`
+}
+
+// apiKey is a key from before clients were registered, they are imported as clients by ImportAPIKeys
+type apiKey struct {
+	Key          string   `bson:"key"`
+	Name         string   `bson:"name,omitempty"`
+	RedirectURIs []string `bson:"redirect_uris,omitempty"`
+	Audience     string   `bson:"audience,omitempty"`
+	Scopes       []string `bson:"scopes,omitempty"`
+}
+
+const clientKey contextKey = iota + 1
+
+var clientIndex sync.Once
+
+func clientCollection() *mgo.Collection {
+	c := database.GetCollection("clients")
+	clientIndex.Do(func() {
+		_ = c.EnsureIndex(mgo.Index{Key: []string{"client_id"}, Unique: true})
+	})
+	return c
+}
+
+// GetClient looks up a client by its ID
+func GetClient(ID string) (*Client, error) {
+	var c Client
+	if err := clientCollection().Find(bson.M{"client_id": ID}).One(&c); err == mgo.ErrNotFound {
+		return nil, ErrClientNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// GetAllClients gets every registered client, sorted by name
+func GetAllClients() ([]Client, error) {
+	var clients []Client
+	if err := clientCollection().Find(nil).Sort("name").All(&clients); err != nil {
+		return nil, err
+	}
+	return clients, nil
+}
+
+// CreateClient registers a client with a new client ID. Confidential clients are given a secret, which is
+// returned so it can be shown once.
+func CreateClient(newClient *Client, confidential bool) (secret string, err error) {
+	newClient.ID, err = crypto.GenerateToken(18)
+	if err != nil {
+		return "", err
+	}
+	if confidential {
+		if secret, err = newClient.resetSecret(); err != nil {
+			return "", err
+		}
+	}
+	newClient.TimeCreated = time.Now()
+
+	if err := clientCollection().Insert(newClient); err != nil {
+		return "", err
+	}
+	return secret, nil
+}
+
+// UpdateClient replaces a client, the client ID and secret can't be changed this way
+func UpdateClient(updatedClient *Client) error {
+	err := clientCollection().Update(bson.M{"client_id": updatedClient.ID}, bson.M{
+		"$set": bson.M{
+			"name":          updatedClient.Name,
+			"logo_url":      updatedClient.LogoURL,
+			"redirect_uris": updatedClient.RedirectURIs,
+			"grant_types":   updatedClient.GrantTypes,
+			"audience":      updatedClient.Audience,
+			"scopes":        updatedClient.Scopes,
+		},
+	})
+	if err == mgo.ErrNotFound {
+		return ErrClientNotFound
+	}
+	return err
+}
+
+// RotateClientSecret replaces the secret of a client, the old secret stops working straight away
+func RotateClientSecret(ID string) (string, error) {
+	var c Client
+	secret, err := c.resetSecret()
+	if err != nil {
+		return "", err
+	}
+
+	err = clientCollection().Update(bson.M{"client_id": ID}, bson.M{"$set": bson.M{"secret_hash": c.SecretHash}})
+	if err == mgo.ErrNotFound {
+		return "", ErrClientNotFound
+	} else if err != nil {
+		return "", err
+	}
+	return secret, nil
+}
+
+func DeleteClient(ID string) error {
+	if err := clientCollection().Remove(bson.M{"client_id": ID}); err == mgo.ErrNotFound {
+		return ErrClientNotFound
+	} else if err != nil {
+		return err
+	}
+	return nil
+}
+
+// ImportAPIKeys registers a client for each API key that isn't one yet, keeping the key as the client ID so
+// applications keep working. It returns the number of clients created.
+func ImportAPIKeys() (int, error) {
+	var keys []apiKey
+	if err := database.GetCollection("keys").Find(nil).All(&keys); err != nil {
+		return 0, err
+	}
+
+	n := 0
+	for _, k := range keys {
+		grants := []string{GrantRefreshToken}
+		if len(k.RedirectURIs) > 0 {
+			grants = append(grants, GrantAuthorizationCode)
+		}
+		info, err := clientCollection().Upsert(bson.M{"client_id": k.Key}, bson.M{
+			"$setOnInsert": &Client{
+				ID:           k.Key,
+				Name:         k.Name,
+				RedirectURIs: k.RedirectURIs,
+				GrantTypes:   grants,
+				Audience:     k.Audience,
+				Scopes:       k.Scopes,
+				TimeCreated:  time.Now(),
+			},
+		})
+		if err != nil {
+			return n, err
+		}
+		if info.UpsertedId != nil {
+			n++
+		}
+	}
+	return n, nil
+}
+
+// WithClient stores the client a request was made by on the context, for requests that identify the client
+// another way than the API-Key header
+func WithClient(ctx context.Context, client *Client) context.Context {
+	return context.WithValue(ctx, clientKey, client)
+}
+
+// ContextClient returns the client the request was made by, nil for requests that don't need an API key
+func ContextClient(ctx context.Context) *Client {
+	client, _ := ctx.Value(clientKey).(*Client)
+	return client
+}
+
+func (c *Client) resetSecret() (string, error) {
+	secret, err := crypto.GenerateToken(clientSecretBytes)
+	if err != nil {
+		return "", err
+	}
+	c.SecretHash = crypto.HashCode(secret)
+	return secret, nil
+}
+
+// Confidential is whether the client has a secret it has to authenticate with
+func (c *Client) Confidential() bool {
+	return c.SecretHash != ""
+}
+
+// VerifySecret checks the secret of a confidential client
+func (c *Client) VerifySecret(secret string) bool {
+	if !c.Confidential() || secret == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(crypto.HashCode(secret)), []byte(c.SecretHash)) == 1
+}
+
+func (c *Client) TokenAudience() string {
+	if c != nil && c.Audience != "" {
+		return c.Audience
+	}
+	// Tokens of third party clients can't be used with the API keys of our own applications
+	if c != nil && c.ThirdParty {
+		return c.ID
+	}
+	return secrets.TokenAudience
+}
+
+func (c *Client) TokenScopes() []string {
+	if c != nil && c.ThirdParty {
+		var scopes []string
+		for _, s := range c.Scopes {
+			if s != ApproveScope {
+				scopes = append(scopes, s)
+			}
+		}
+		return scopes
+	}
+	if c == nil || len(c.Scopes) == 0 {
+		return strings.Fields(secrets.DefaultTokenScopes)
+	}
+	return c.Scopes
+}
+
+func (c *Client) HasRedirectURI(uri string) bool {
+	if c == nil {
+		return false
+	}
+	for _, u := range c.RedirectURIs {
+		if u == uri {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Client) HasGrantType(grantType string) bool {
+	if c == nil {
+		return false
+	}
+	for _, g := range c.GrantTypes {
+		if g == grantType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,47 @@
+package auth
+
+import (
+	"crypto/ed25519"
+
+	jwtgo "github.com/dgrijalva/jwt-go"
+)
+
+// SigningMethodEdDSA signs tokens with Ed25519 keys (RFC 8037), which jwt-go doesn't support itself
+var SigningMethodEdDSA = &signingMethodEdDSA{}
+
+type signingMethodEdDSA struct{}
+
+func init() {
+	jwtgo.RegisterSigningMethod(SigningMethodEdDSA.Alg(), func() jwtgo.SigningMethod {
+		return SigningMethodEdDSA
+	})
+}
+
+func (m *signingMethodEdDSA) Alg() string {
+	return "EdDSA"
+}
+
+func (m *signingMethodEdDSA) Sign(signingString string, key interface{}) (string, error) {
+	priv, ok := key.(ed25519.PrivateKey)
+	if !ok {
+		return "", jwtgo.ErrInvalidKeyType
+	}
+
+	return jwtgo.EncodeSegment(ed25519.Sign(priv, []byte(signingString))), nil
+}
+
+func (m *signingMethodEdDSA) Verify(signingString, signature string, key interface{}) error {
+	pub, ok := key.(ed25519.PublicKey)
+	if !ok {
+		return jwtgo.ErrInvalidKeyType
+	}
+
+	sig, err := jwtgo.DecodeSegment(signature)
+	if err != nil {
+		return err
+	}
+	if !ed25519.Verify(pub, []byte(signingString), sig) {
+		return jwtgo.ErrSignatureInvalid
+	}
+	return nil
+}
@@ -2,10 +2,8 @@ package auth
 
 import (
 	"context"
-	"crypto/rsa"
 	"fmt"
 	"net/http"
-	"strconv"
 	"strings"
 	"time"
 
@@ -17,268 +15,193 @@ import (
 	"github.com/satori/go.uuid"
 )
 
-func NewJWTMiddleware(security *goa.JWTSecurity) (goa.Middleware, error) {
-	key, err := loadJWTPublicKeys()
-	if err != nil {
-		return nil, err
+// NewJWTMiddleware verifies the bearer token in the header of the security scheme against the keys in the
+// ring. goa's JWT middleware can't verify EdDSA tokens so it isn't used. The token and its claims are stored on
+// the context for jwt.ContextJWT and ContextClaims. Tokens are rejected when they are missing a scope the
+// action requires.
+func NewJWTMiddleware(security *goa.JWTSecurity, keys *KeyRing) (goa.Middleware, error) {
+	if security.In != goa.LocHeader {
+		return nil, fmt.Errorf("JWT security scheme in %q is not supported", security.In)
 	}
-	validateHandler, err := goa.NewMiddleware(func(ctx context.Context, w http.ResponseWriter, r *http.Request) error {
-		token := jwt.ContextJWT(ctx)
-		claims, ok := token.Claims.(jwtgo.MapClaims)
-		if !ok {
-			return jwt.ErrJWTError("Not a valid token")
-		}
-		if !claims.VerifyIssuer("Giggle", true) {
-			return jwt.ErrJWTError("Not a valid token")
-		}
-		if sub, ok := claims["sub"]; !ok {
-			numStr, ok := sub.(string)
-			if !ok {
-				return jwt.ErrJWTError("Not a valid token")
+
+	return func(h goa.Handler) goa.Handler {
+		return func(ctx context.Context, rw http.ResponseWriter, req *http.Request) error {
+			val := req.Header.Get(security.Name)
+			if !strings.HasPrefix(strings.ToLower(val), "bearer ") {
+				return jwt.ErrJWTError(fmt.Sprintf("missing or malformed %q header, expected 'Bearer JWT-token...'", security.Name))
 			}
-			if num, err := strconv.ParseInt(numStr, 10, 64); err != nil || num <= 0 {
-				return jwt.ErrJWTError("Not a valid token")
+
+			claims := &Claims{}
+			token, err := jwtgo.ParseWithClaims(val[len("bearer "):], claims, keys.Keyfunc)
+			if err != nil || !token.Valid {
+				return jwt.ErrJWTError("JWT validation failed")
+			}
+			if err := claims.validate(ctx); err == ErrInvalidToken || err == ErrTokenRevoked {
+				return jwt.ErrJWTError(err.Error())
+			} else if err != nil {
+				return err
+			}
+
+			// Scopes the action declares in the design
+			required := goa.ContextRequiredScopes(ctx)
+			for _, scope := range required {
+				if !claims.HasScope(scope) {
+					return jwt.ErrJWTError(fmt.Sprintf("token is missing the %q scope", scope))
+				}
 			}
+			// Service accounts don't have a user, so they can only use the admin actions
+			if claims.IsService() && !containsScope(required, "admin") {
+				return jwt.ErrJWTError("service account tokens can only be used for admin actions")
+			}
+
+			ctx = jwt.WithJWT(ctx, token)
+			return h(WithClaims(ctx, claims), rw, req)
+		}
+	}, nil
+}
+
+func containsScope(scopes []string, scope string) bool {
+	for _, s := range scopes {
+		if s == scope {
+			return true
 		}
-		return nil
-	})
-	if err != nil {
-		panic(err)
 	}
-	return jwt.New(jwt.NewSimpleResolver([]jwt.Key{key}), validateHandler, security), nil
+	return false
 }
 
 type JWTSecurity struct {
-	privateKey *rsa.PrivateKey
-	publicKey  jwt.Key
+	keys *KeyRing
 }
 
+// NewJWTSecurity creates a key ring with the RSA, P-256 or Ed25519 private key from secrets, rotated keys are added to it by
+// KeyRing.Load and KeyRing.RunRotation
 func NewJWTSecurity() (JWTSecurity, error) {
-	privKey, err := jwtgo.ParseRSAPrivateKeyFromPEM([]byte(secrets.JWTPrivateKey))
+	privKey, err := parsePrivateKey(secrets.JWTPrivateKey)
 	if err != nil {
-		return JWTSecurity{}, nil
+		return JWTSecurity{}, err
 	}
-	pubKey, err := loadJWTPublicKeys()
+	static, err := NewSigningKey(privKey, "")
 	if err != nil {
-		return JWTSecurity{}, nil
+		return JWTSecurity{}, err
 	}
 
 	return JWTSecurity{
-		privateKey: privKey,
-		publicKey:  pubKey,
+		keys: NewKeyRing(static),
 	}, nil
 }
 
-func (j *JWTSecurity) IsAdmin(req *http.Request) bool {
-	tokenString := req.Header.Get("Authorization")
-	token, err := jwtgo.Parse(strings.TrimPrefix(tokenString, "Bearer "), func(t *jwtgo.Token) (interface{}, error) {
-		return j.publicKey, nil
-	})
-	if err != nil || token == nil || !token.Valid {
-		return false
-	}
-	claims, ok := token.Claims.(jwtgo.MapClaims)
-	if !ok {
-		return false
-	}
-
-	if !claims.VerifyIssuer("Giggle", true) {
-		return false
-	}
-
-	fmt.Println("Claims: ", claims)
-
-	subject := claims["adm"]
-	subStr, ok := subject.(string)
-	if !ok {
-		return false
-	}
-	isAdmin, _ := strconv.ParseBool(subStr)
-	return isAdmin
+func (j *JWTSecurity) KeyRing() *KeyRing {
+	return j.keys
 }
 
-func (j *JWTSecurity) IsGhost(req *http.Request) bool {
-	tokenString := req.Header.Get("Authorization")
-	token, err := jwtgo.Parse(strings.TrimPrefix(tokenString, "Bearer "), func(t *jwtgo.Token) (interface{}, error) {
-		return j.publicKey, nil
-	})
-	if err != nil || token == nil || !token.Valid {
-		return false
-	}
-	claims, ok := token.Claims.(jwtgo.MapClaims)
-	if !ok {
-		return false
-	}
-
-	if !claims.VerifyIssuer("Giggle", true) {
-		return false
+// Claims returns the claims of the auth token the request was authenticated with. Actions that don't require
+// an auth token are not run through the JWT middleware, so the Authorization header is verified for them
+// if one was sent.
+func (j *JWTSecurity) Claims(ctx context.Context) *Claims {
+	if claims := ContextClaims(ctx); claims != nil {
+		return claims
 	}
 
-	fmt.Println("Claims: ", claims)
-
-	subject := claims["ghs"]
-	subStr, ok := subject.(string)
-	if !ok {
-		return false
-	}
-	isGhost, _ := strconv.ParseBool(subStr)
-	return isGhost
-}
-
-func (j *JWTSecurity) IsPluginAuthor(req *http.Request) bool {
-	tokenString := req.Header.Get("Authorization")
-	token, err := jwtgo.Parse(strings.TrimPrefix(tokenString, "Bearer "), func(t *jwtgo.Token) (interface{}, error) {
-		return j.publicKey, nil
-	})
-	if err != nil || token == nil || !token.Valid {
-		return false
-	}
-	claims, ok := token.Claims.(jwtgo.MapClaims)
-	if !ok {
-		return false
-	}
-
-	if !claims.VerifyIssuer("Giggle", true) {
-		return false
+	req := goa.ContextRequest(ctx)
+	if req == nil || req.Request == nil || req.Header.Get("Authorization") == "" {
+		return nil
 	}
-
-	fmt.Println("Claims: ", claims)
-
-	subject := claims["pla"]
-	subStr, ok := subject.(string)
-	if !ok {
-		return false
+	claims, err := j.ParseClaims(ctx, req.Header.Get("Authorization"))
+	if err != nil {
+		return nil
 	}
-	isPluginAuthor, _ := strconv.ParseBool(subStr)
-	return isPluginAuthor
+	return claims
 }
 
-func (j *JWTSecurity) IsEventAuthor(req *http.Request) bool {
-	tokenString := req.Header.Get("Authorization")
-	token, err := jwtgo.Parse(strings.TrimPrefix(tokenString, "Bearer "), func(t *jwtgo.Token) (interface{}, error) {
-		return j.publicKey, nil
-	})
-	if err != nil || token == nil || !token.Valid {
-		return false
-	}
-	claims, ok := token.Claims.(jwtgo.MapClaims)
-	if !ok {
-		return false
-	}
-
-	if !claims.VerifyIssuer("Giggle", true) {
-		return false
+// ParseClaims verifies an auth token, with or without the Bearer prefix, and returns its claims
+func (j *JWTSecurity) ParseClaims(ctx context.Context, tokenString string) (*Claims, error) {
+	claims, err := j.ParseIssuedClaims(tokenString)
+	if err != nil {
+		return nil, err
 	}
 
-	fmt.Println("Claims: ", claims)
-
-	subject := claims["iea"]
-	subStr, ok := subject.(string)
-	if !ok {
-		return false
+	if !claims.VerifyAudience(ContextClient(ctx).TokenAudience(), true) {
+		return nil, ErrInvalidToken
 	}
-	isPluginAuthor, _ := strconv.ParseBool(subStr)
-	return isPluginAuthor
+	return claims, nil
 }
 
-func (j *JWTSecurity) GetUserID(req *http.Request) string {
-	tokenString := req.Header.Get("Authorization")
-	token, err := jwtgo.Parse(strings.TrimPrefix(tokenString, "Bearer "), func(t *jwtgo.Token) (interface{}, error) {
-		return j.publicKey, nil
-	})
-	if err != nil || token == nil || !token.Valid {
-		return ""
-	}
-	claims, ok := token.Claims.(jwtgo.MapClaims)
-	if !ok {
-		return ""
+// ParseIssuedClaims verifies an auth token like ParseClaims, but accepts tokens issued to any client
+func (j *JWTSecurity) ParseIssuedClaims(tokenString string) (*Claims, error) {
+	claims := &Claims{}
+	token, err := jwtgo.ParseWithClaims(strings.TrimPrefix(tokenString, "Bearer "), claims, j.keys.Keyfunc)
+	if err != nil || !token.Valid {
+		return nil, ErrInvalidToken
 	}
 
-	if !claims.VerifyIssuer("Giggle", true) {
-		return ""
+	if err := claims.validateIssued(); err != nil {
+		return nil, err
 	}
-
-	return claims["sub"].(string)
+	return claims, nil
 }
 
-func (j *JWTSecurity) GetSessionCode(req *http.Request) string {
-	tokenString := req.Header.Get("X-Session")
-	token, err := jwtgo.Parse(tokenString, func(t *jwtgo.Token) (interface{}, error) {
-		return j.publicKey, nil
-	})
-	if err != nil || token == nil || !token.Valid {
-		return ""
-	}
-	claims, ok := token.Claims.(jwtgo.MapClaims)
-	if !ok {
-		return ""
+// ParseSessionClaims verifies a session token and returns its claims
+func (j *JWTSecurity) ParseSessionClaims(tokenString string) (*SessionClaims, error) {
+	claims := &SessionClaims{}
+	token, err := jwtgo.ParseWithClaims(tokenString, claims, j.keys.Keyfunc)
+	if err != nil || !token.Valid || !claims.VerifyIssuer(secrets.TokenIssuer, true) || claims.SessionID == "" {
+		return nil, ErrInvalidToken
 	}
 
-	if !claims.VerifyIssuer("Giggle", true) {
-		return ""
+	if revoked, err := IsRevoked(claims.Id, claims.SessionID); err != nil {
+		return nil, err
+	} else if revoked {
+		return nil, ErrTokenRevoked
 	}
-
-	return claims["prn"].(string)
+	return claims, nil
 }
 
-func (j *JWTSecurity) GetSessionFromAuth(req *http.Request) string {
-	tokenString := req.Header.Get("Authorization")
-	token, err := jwtgo.Parse(strings.TrimPrefix(tokenString, "Bearer "), func(t *jwtgo.Token) (interface{}, error) {
-		return j.publicKey, nil
-	})
-	if err != nil || token == nil || !token.Valid {
-		return ""
-	}
-	claims := token.Claims.(jwtgo.MapClaims)
-
-	if !claims.VerifyIssuer("Giggle", true) {
-		return ""
+// GetSessionCode returns the session a session token is for and the ID of the token, the token ID is empty
+// for tokens issued before session tokens were rotated
+func (j *JWTSecurity) GetSessionCode(req *http.Request) (sessionID, tokenID string) {
+	claims, err := j.ParseSessionClaims(req.Header.Get("X-Session"))
+	if err != nil {
+		return "", ""
 	}
 
-	return claims["ses"].(string)
+	return claims.SessionID, claims.Id
 }
 
-func (j *JWTSecurity) SignSessionToken(expTime time.Duration, sessionID string) (string, error) {
-	sesExpTime := time.Now().Add(expTime).Unix()
-	token := jwtgo.NewWithClaims(jwtgo.SigningMethodRS512, jwtgo.MapClaims{
-		"iss": "Giggle",
-		"exp": sesExpTime,
-		"prn": sessionID,
-		"iat": time.Now().Unix(),
-		"nbf": 2,
+// SignSessionToken signs a session token, tokenID identifies the token so it can only be used once
+func (j *JWTSecurity) SignSessionToken(expTime time.Duration, sessionID, tokenID string) (string, error) {
+	return j.keys.Sign(secrets.JWTSigningAlgorithm, &SessionClaims{
+		StandardClaims: jwtgo.StandardClaims{
+			Issuer:    secrets.TokenIssuer,
+			ExpiresAt: time.Now().Add(expTime).Unix(),
+			Id:        tokenID,
+			IssuedAt:  time.Now().Unix(),
+			NotBefore: 2,
+		},
+		SessionID: sessionID,
 	})
-	return token.SignedString(j.privateKey)
 }
 
-func (j *JWTSecurity) SignAuthToken(expTime time.Duration, sessionID, userID string, isAdmin, isPluginAuthor, isEventAuthor bool) (string, error) {
-	authExpTime := time.Now().Add(expTime).Unix()
+// SignAuthToken signs an auth token with the claims, which have to set the subject, session, audience and
+// scope, or the client instead of the session for service accounts. The issuer, times and jti are set here.
+func (j *JWTSecurity) SignAuthToken(expTime time.Duration, claims *Claims) (string, error) {
 	tokenID, err := uuid.NewV4()
 	if err != nil {
 		return "", err
 	}
 
-	fmt.Println("Admin: ", isAdmin)
-
-	token := jwtgo.NewWithClaims(jwtgo.SigningMethodRS512, jwtgo.MapClaims{
-		"iss": "Giggle",
-		"exp": authExpTime,
-		"jti": tokenID.String(),
-		"iat": time.Now().Unix(),
-		"nbf": 2,
-		"sub": userID,
-		"ses": sessionID,
-		"adm": strconv.FormatBool(isAdmin),
-		"pla": strconv.FormatBool(isPluginAuthor),
-		"iea": strconv.FormatBool(isEventAuthor),
-		"ghs": strconv.FormatBool(false),
-	})
-	return token.SignedString(j.privateKey)
+	claims.Issuer = secrets.TokenIssuer
+	claims.ExpiresAt = time.Now().Add(expTime).Unix()
+	claims.Id = tokenID.String()
+	claims.IssuedAt = time.Now().Unix()
+	claims.NotBefore = 2
+	return j.keys.Sign(secrets.JWTSigningAlgorithm, claims)
 }
 
-func loadJWTPublicKeys() (jwt.Key, error) {
-	key, err := jwtgo.ParseRSAPublicKeyFromPEM([]byte(secrets.JWTPublicKey))
-	if err != nil {
-		return nil, err
-	}
-	return key, nil
+// SignIDToken signs an OpenID Connect ID token with the claims, which have to set the subject, audience and
+// nonce. The issuer and times are set here.
+func (j *JWTSecurity) SignIDToken(expTime time.Duration, claims *IDTokenClaims) (string, error) {
+	claims.Issuer = secrets.OIDCIssuer
+	claims.ExpiresAt = time.Now().Add(expTime).Unix()
+	claims.IssuedAt = time.Now().Unix()
+	return j.keys.Sign(secrets.IDTokenSigningAlgorithm, claims)
 }
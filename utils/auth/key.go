@@ -2,9 +2,6 @@ package auth
 
 import (
 	"context"
-	"fmt"
-	"gigglesearch.org/giggle-auth/utils/database"
-	"github.com/globalsign/mgo/bson"
 	"github.com/goadesign/goa"
 	"net/http"
 	"strings"
@@ -13,27 +10,59 @@ import (
 var ErrNoKey = goa.ErrBadRequest("api key must be provided")
 var ErrUnauthorized = goa.ErrUnauthorized("invalid api key")
 
+// Endpoints of the OpenID Connect provider that are called by browsers and third party applications
+var oauthPaths = []string{"/oauth/authorize", "/oauth/token", "/oauth/userinfo"}
+
 func NewKeyMiddleware() (goa.Middleware, error) {
-	validateHandler, err := goa.NewMiddleware(func(ctx context.Context, w http.ResponseWriter, r *http.Request) error {
+	return func(h goa.Handler) goa.Handler {
+		return func(ctx context.Context, rw http.ResponseWriter, r *http.Request) error {
+			if strings.Contains(r.RequestURI, "/stripe/events") {
+				return h(ctx, rw, r)
+			}
 
-		if strings.Contains(r.RequestURI, "/stripe/events") {
-			return nil
-		}
+			// Public keys are fetched by services that don't have an API key
+			if strings.HasPrefix(r.URL.Path, "/.well-known/") {
+				return h(ctx, rw, r)
+			}
+
+			// OpenID Connect clients send their client ID in the request instead
+			for _, p := range oauthPaths {
+				if strings.HasSuffix(r.URL.Path, p) {
+					return h(ctx, rw, r)
+				}
+			}
 
-		key := r.Header.Get("API-Key")
+			if isSocialFormPost(r) {
+				return h(ctx, rw, r)
+			}
 
-		fmt.Println(key)
+			key := r.Header.Get("API-Key")
+			if key == "" {
+				return ErrNoKey
+			}
 
-		if key == "" {
-			return ErrNoKey
-		} else {
-			if count, err := database.GetCollection("keys").Find(bson.M{"key": key}).Count(); err != nil || count == 0 {
+			client, err := GetClient(key)
+			if err == ErrClientNotFound {
 				return ErrUnauthorized
-			} else {
-				return nil
+			} else if err != nil {
+				return err
 			}
+
+			return h(WithClient(ctx, client), rw, r)
 		}
-	})
+	}, nil
+}
 
-	return validateHandler, err
+// isSocialFormPost checks for the post of a provider that uses the form_post response mode, the provider sends
+// the browser to POST /auth/:provider/receive so it can't have an API key
+func isSocialFormPost(r *http.Request) bool {
+	if r.Method != http.MethodPost || !strings.HasSuffix(r.URL.Path, "/receive") {
+		return false
+	}
+	i := strings.Index(r.URL.Path, "/auth/")
+	if i < 0 {
+		return false
+	}
+	provider := strings.TrimSuffix(r.URL.Path[i+len("/auth/"):], "/receive")
+	return provider != "" && !strings.Contains(provider, "/")
 }
@@ -0,0 +1,519 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"gigglesearch.org/giggle-auth/auth/utils/crypto"
+	"gigglesearch.org/giggle-auth/utils/database"
+	"gigglesearch.org/giggle-auth/utils/log"
+	"gigglesearch.org/giggle-auth/utils/secrets"
+
+	jwtgo "github.com/dgrijalva/jwt-go"
+	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
+)
+
+const (
+	// How often the ring checks for keys created by other instances and whether the signing key is due
+	// to be rotated
+	keyRingCheckInterval = 10 * time.Minute
+	// A token with an unknown kid reloads the keys at most this often
+	keyRingReloadInterval = 30 * time.Second
+	rotatedKeyBits        = 2048
+)
+
+var ErrUnknownKey = errors.New("Token was not signed by a known key")
+
+// SigningKey is a key pair in a KeyRing
+type SigningKey struct {
+	ID         string
+	Method     jwtgo.SigningMethod
+	PrivateKey interface{}
+	PublicKey  interface{}
+	// The key signs tokens from TimeActive until a newer key becomes active, it is published before then
+	// so verifiers have it by the time the first token arrives
+	TimeActive time.Time
+	// Tokens signed by the key are accepted until TimeExpires, the zero time never expires
+	TimeExpires time.Time
+}
+
+// JSONWebKey is the public part of a key in the JWK format (RFC 7517)
+type JSONWebKey struct {
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	Kid string `json:"kid"`
+	Alg string `json:"alg"`
+	// RSA keys
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+	// EC and OKP keys, OKP keys only have x
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+}
+
+// storedKey is a rotated key in the database, the private key is PKCS#8 PEM encrypted with
+// secrets.JWTKeyEncryptionKey
+type storedKey struct {
+	ID          string    `bson:"kid"`
+	Algorithm   string    `bson:"alg"`
+	PrivateKey  string    `bson:"private_key"`
+	TimeCreated time.Time `bson:"time_created"`
+	TimeActive  time.Time `bson:"time_active"`
+	TimeExpires time.Time `bson:"time_expires"`
+}
+
+// KeyRing holds the keys tokens are signed and verified with. Tokens carry the kid of the key that signed
+// them, tokens without one were signed with the static key from secrets before keys were rotated.
+type KeyRing struct {
+	mu         sync.RWMutex
+	static     *SigningKey
+	keys       map[string]*SigningKey
+	lastReload time.Time
+}
+
+// NewKeyRing creates a ring holding only the static key
+func NewKeyRing(static *SigningKey) *KeyRing {
+	return &KeyRing{
+		static: static,
+		keys:   map[string]*SigningKey{static.ID: static},
+	}
+}
+
+func keyCollection() *mgo.Collection {
+	return database.GetCollection("jwt-keys")
+}
+
+// Load replaces the rotated keys with the unexpired keys in the database
+func (k *KeyRing) Load(ctx context.Context) error {
+	var stored []storedKey
+	err := keyCollection().Find(bson.M{"time_expires": bson.M{"$gt": time.Now()}}).All(&stored)
+	if err != nil {
+		return err
+	}
+
+	keys := map[string]*SigningKey{k.static.ID: k.static}
+	for _, s := range stored {
+		key, err := s.signingKey()
+		if err != nil {
+			log.Warning(ctx, "Unable to load JWT key %v: %v", s.ID, err)
+			continue
+		}
+		keys[key.ID] = key
+	}
+
+	k.mu.Lock()
+	k.keys = keys
+	k.lastReload = time.Now()
+	k.mu.Unlock()
+	return nil
+}
+
+// Rotate creates the next signing key for secrets.JWTSigningAlgorithm and secrets.IDTokenSigningAlgorithm when
+// the newest one is due to be replaced or the algorithm has changed. The new key is published for
+// secrets.JWTKeyPublishHours before it starts signing, and tokens signed by the old keys keep verifying until
+// the keys expire, so the algorithm can be changed without logging anyone out.
+func (k *KeyRing) Rotate(ctx context.Context) error {
+	algs := []string{secrets.JWTSigningAlgorithm}
+	if secrets.IDTokenSigningAlgorithm != secrets.JWTSigningAlgorithm {
+		algs = append(algs, secrets.IDTokenSigningAlgorithm)
+	}
+
+	rotated := false
+	for _, alg := range algs {
+		created, err := k.rotate(ctx, alg)
+		if err != nil {
+			return err
+		}
+		rotated = rotated || created
+	}
+	if !rotated {
+		return nil
+	}
+
+	return k.Load(ctx)
+}
+
+// rotate stores a new key for alg if the newest one is due to be replaced, it returns whether it did
+func (k *KeyRing) rotate(ctx context.Context, alg string) (bool, error) {
+	rotation := time.Duration(secrets.JWTKeyRotationDays) * 24 * time.Hour
+	publish := time.Duration(secrets.JWTKeyPublishHours) * time.Hour
+	retention := time.Duration(secrets.JWTKeyRetentionDays) * 24 * time.Hour
+
+	newest := k.newest(alg)
+	if newest != nil && newest != k.static && time.Now().Add(publish).Before(newest.TimeActive.Add(rotation)) {
+		return false, nil
+	}
+
+	priv, err := generatePrivateKey(alg)
+	if err != nil {
+		return false, err
+	}
+	key, err := NewSigningKey(priv, alg)
+	if err != nil {
+		return false, err
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(priv)
+	if err != nil {
+		return false, err
+	}
+	encrypted, err := crypto.Encrypt(string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})), secrets.JWTKeyEncryptionKey)
+	if err != nil {
+		return false, err
+	}
+
+	// The key stops signing when the one after it becomes active and has to verify the tokens it signed
+	// until they expire
+	active := time.Now().Add(publish)
+	s := storedKey{
+		ID:          key.ID,
+		Algorithm:   key.Method.Alg(),
+		PrivateKey:  encrypted,
+		TimeCreated: time.Now(),
+		TimeActive:  active,
+		TimeExpires: active.Add(rotation + retention),
+	}
+	if err := keyCollection().Insert(s); err != nil {
+		return false, err
+	}
+	log.Info(ctx, "Created %v JWT signing key %v, active from %v", alg, s.ID, s.TimeActive)
+
+	return true, nil
+}
+
+// RunRotation periodically reloads the keys and rotates the signing key until ctx is done. Instances
+// racing to rotate may both create a key, which is harmless as both are published.
+func (k *KeyRing) RunRotation(ctx context.Context) {
+	ticker := time.NewTicker(keyRingCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := k.Load(ctx); err != nil {
+			log.Warning(ctx, "Unable to load JWT keys: %v", err)
+		} else if err := k.Rotate(ctx); err != nil {
+			log.Warning(ctx, "Unable to rotate JWT signing key: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// newest returns the key for alg that was activated or will be activated last, nil if there is none
+func (k *KeyRing) newest(alg string) *SigningKey {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	var newest *SigningKey
+	for _, key := range k.keys {
+		if key.Method.Alg() != alg {
+			continue
+		}
+		if newest == nil || key.TimeActive.After(newest.TimeActive) {
+			newest = key
+		}
+	}
+	return newest
+}
+
+// SigningKey returns the most recently activated key for alg. Until one is active it falls back to the most
+// recently activated key of any algorithm, and then to the static key.
+func (k *KeyRing) SigningKey(alg string) *SigningKey {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	now := time.Now()
+	var signing *SigningKey
+	fallback := k.static
+	for _, key := range k.keys {
+		if key.TimeActive.After(now) || (!key.TimeExpires.IsZero() && key.TimeExpires.Before(now)) {
+			continue
+		}
+		if key.Method.Alg() == alg && (signing == nil || key.TimeActive.After(signing.TimeActive)) {
+			signing = key
+		}
+		if key.TimeActive.After(fallback.TimeActive) {
+			fallback = key
+		}
+	}
+	if signing == nil {
+		return fallback
+	}
+	return signing
+}
+
+// Sign signs claims with the current signing key for alg, the kid header identifies the key to verifiers
+func (k *KeyRing) Sign(alg string, claims jwtgo.Claims) (string, error) {
+	key := k.SigningKey(alg)
+	token := jwtgo.NewWithClaims(key.Method, claims)
+	token.Header["kid"] = key.ID
+	return token.SignedString(key.PrivateKey)
+}
+
+// Key returns the unexpired key with the given kid, the keys are reloaded when it is not known in case
+// another instance has rotated. An empty kid selects the static key.
+func (k *KeyRing) Key(ctx context.Context, kid string) (*SigningKey, error) {
+	if kid == "" {
+		return k.static, nil
+	}
+
+	k.mu.RLock()
+	key, ok := k.keys[kid]
+	reload := time.Since(k.lastReload) > keyRingReloadInterval
+	k.mu.RUnlock()
+
+	if !ok && reload {
+		if err := k.Load(ctx); err != nil {
+			return nil, err
+		}
+		k.mu.RLock()
+		key, ok = k.keys[kid]
+		k.mu.RUnlock()
+	}
+
+	if !ok || (!key.TimeExpires.IsZero() && key.TimeExpires.Before(time.Now())) {
+		return nil, ErrUnknownKey
+	}
+	return key, nil
+}
+
+// Keyfunc selects the verification key for a token parsed by jwt-go
+func (k *KeyRing) Keyfunc(t *jwtgo.Token) (interface{}, error) {
+	kid, _ := t.Header["kid"].(string)
+	key, err := k.Key(context.Background(), kid)
+	if err != nil {
+		return nil, err
+	}
+	if t.Method.Alg() != key.Method.Alg() {
+		return nil, ErrUnknownKey
+	}
+	return key.PublicKey, nil
+}
+
+// JWKS returns the public keys tokens may be verified with, including keys that are not signing yet
+func (k *KeyRing) JWKS() []JSONWebKey {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	now := time.Now()
+	jwks := []JSONWebKey{}
+	for _, key := range k.keys {
+		if !key.TimeExpires.IsZero() && key.TimeExpires.Before(now) {
+			continue
+		}
+		jwk, ok := publicJWK(key.PublicKey)
+		if !ok {
+			continue
+		}
+		jwk.Use = "sig"
+		jwk.Kid = key.ID
+		jwk.Alg = key.Method.Alg()
+		jwks = append(jwks, jwk)
+	}
+
+	sort.Slice(jwks, func(i, j int) bool { return jwks[i].Kid < jwks[j].Kid })
+	return jwks
+}
+
+// Algorithms returns the algorithms of the keys in JWKS, which are the ones tokens may be signed with
+func (k *KeyRing) Algorithms() []string {
+	seen := map[string]bool{}
+	algs := []string{}
+	for _, jwk := range k.JWKS() {
+		if !seen[jwk.Alg] {
+			seen[jwk.Alg] = true
+			algs = append(algs, jwk.Alg)
+		}
+	}
+
+	sort.Strings(algs)
+	return algs
+}
+
+func (s *storedKey) signingKey() (*SigningKey, error) {
+	plain, err := crypto.Decrypt(s.PrivateKey, secrets.JWTKeyEncryptionKey)
+	if err != nil {
+		return nil, err
+	}
+	priv, err := parsePrivateKey(plain)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := NewSigningKey(priv, s.Algorithm)
+	if err != nil {
+		return nil, err
+	}
+	if key.ID != s.ID {
+		return nil, errors.New("stored key does not match its kid and algorithm")
+	}
+
+	key.TimeActive = s.TimeActive
+	key.TimeExpires = s.TimeExpires
+	return key, nil
+}
+
+// NewSigningKey creates a key for an RSA, P-256 ECDSA or Ed25519 private key, which sign with RS256 or RS512,
+// ES256 and EdDSA respectively. An empty alg picks RS512 for RSA keys. The kid is the RFC 7638 thumbprint of
+// the public key.
+func NewSigningKey(priv interface{}, alg string) (*SigningKey, error) {
+	key := &SigningKey{PrivateKey: priv}
+	switch k := priv.(type) {
+	case *rsa.PrivateKey:
+		key.Method = jwtgo.SigningMethodRS512
+		if alg == jwtgo.SigningMethodRS256.Alg() {
+			key.Method = jwtgo.SigningMethodRS256
+		}
+		key.PublicKey = &k.PublicKey
+	case *ecdsa.PrivateKey:
+		if k.Curve != elliptic.P256() {
+			return nil, errors.New("only P-256 ECDSA keys are supported")
+		}
+		key.Method = jwtgo.SigningMethodES256
+		key.PublicKey = &k.PublicKey
+	case ed25519.PrivateKey:
+		key.Method = SigningMethodEdDSA
+		key.PublicKey = k.Public()
+	default:
+		return nil, errors.New("unsupported private key type")
+	}
+	if alg != "" && key.Method.Alg() != alg {
+		return nil, errors.New("key can't sign with " + alg)
+	}
+
+	jwk, _ := publicJWK(key.PublicKey)
+	key.ID = jwk.thumbprint()
+	return key, nil
+}
+
+// generatePrivateKey creates a private key for the algorithm, one of RS256, RS512, ES256 or EdDSA
+func generatePrivateKey(alg string) (interface{}, error) {
+	switch alg {
+	case jwtgo.SigningMethodRS256.Alg(), jwtgo.SigningMethodRS512.Alg():
+		return rsa.GenerateKey(rand.Reader, rotatedKeyBits)
+	case jwtgo.SigningMethodES256.Alg():
+		return ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case SigningMethodEdDSA.Alg():
+		_, priv, err := ed25519.GenerateKey(rand.Reader)
+		return priv, err
+	}
+	return nil, errors.New("unsupported signing algorithm " + alg)
+}
+
+// parsePrivateKey parses a PEM encoded PKCS#1 RSA, SEC 1 EC or PKCS#8 private key
+func parsePrivateKey(data string) (interface{}, error) {
+	block, _ := pem.Decode([]byte(data))
+	if block == nil {
+		return nil, errors.New("private key is not PEM encoded")
+	}
+
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		return x509.ParseECPrivateKey(block.Bytes)
+	}
+	return x509.ParsePKCS8PrivateKey(block.Bytes)
+}
+
+// publicJWK returns the key type specific members of the JWK for a public key
+func publicJWK(pub interface{}) (JSONWebKey, bool) {
+	b64 := base64.RawURLEncoding.EncodeToString
+	switch k := pub.(type) {
+	case *rsa.PublicKey:
+		return JSONWebKey{
+			Kty: "RSA",
+			N:   b64(k.N.Bytes()),
+			E:   b64(big.NewInt(int64(k.E)).Bytes()),
+		}, true
+	case *ecdsa.PublicKey:
+		// The coordinates are padded to the size of the curve
+		size := (k.Curve.Params().BitSize + 7) / 8
+		return JSONWebKey{
+			Kty: "EC",
+			Crv: k.Curve.Params().Name,
+			X:   b64(k.X.FillBytes(make([]byte, size))),
+			Y:   b64(k.Y.FillBytes(make([]byte, size))),
+		}, true
+	case ed25519.PublicKey:
+		return JSONWebKey{
+			Kty: "OKP",
+			Crv: "Ed25519",
+			X:   b64(k),
+		}, true
+	}
+	return JSONWebKey{}, false
+}
+
+// thumbprint is the RFC 7638 thumbprint of a JWK, used as the kid of the key
+func (jwk JSONWebKey) thumbprint() string {
+	// Only the required members are used and they have to be in lexicographic order, which json.Marshal
+	// does for maps
+	members := map[string]string{"kty": jwk.Kty}
+	for name, v := range map[string]string{"crv": jwk.Crv, "e": jwk.E, "n": jwk.N, "x": jwk.X, "y": jwk.Y} {
+		if v != "" {
+			members[name] = v
+		}
+	}
+
+	b, _ := json.Marshal(members)
+	sum := sha256.Sum256(b)
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// PublicKey parses the public key of an RSA or EC JWK, for verifying tokens signed by other issuers
+func (jwk JSONWebKey) PublicKey() (interface{}, error) {
+	b64 := base64.RawURLEncoding.DecodeString
+	switch jwk.Kty {
+	case "RSA":
+		n, err := b64(jwk.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := b64(jwk.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch jwk.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, errors.New("unsupported curve " + jwk.Crv)
+		}
+		x, err := b64(jwk.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := b64(jwk.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}, nil
+	}
+	return nil, errors.New("unsupported key type " + jwk.Kty)
+}
@@ -0,0 +1,78 @@
+package auth
+
+import (
+	"sync"
+	"time"
+
+	"gigglesearch.org/giggle-auth/utils/database"
+
+	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
+)
+
+// revocation revokes a single token by its jti or every token of a session. It is only needed until the
+// revoked tokens would have expired, after which mongo removes it.
+type revocation struct {
+	JTI         string    `bson:"jti,omitempty"`
+	SessionID   string    `bson:"session_id,omitempty"`
+	TimeExpires time.Time `bson:"time_expires"`
+}
+
+var revocationIndex sync.Once
+
+func revocationCollection() *mgo.Collection {
+	c := database.GetCollection("revoked-tokens")
+	revocationIndex.Do(func() {
+		// Expired entries are also ignored by IsRevoked, the TTL index just keeps the collection small
+		_ = c.EnsureIndex(mgo.Index{Key: []string{"time_expires"}, ExpireAfter: time.Second})
+	})
+	return c
+}
+
+// RevokeToken revokes the token with the given jti, Expires should be the expiry of the token
+func RevokeToken(JTI string, Expires time.Time) error {
+	return revocationCollection().Insert(&revocation{
+		JTI:         JTI,
+		TimeExpires: Expires,
+	})
+}
+
+// RevokeSessions revokes every token issued to the sessions, Expires should be when the last token
+// issued to them expires
+func RevokeSessions(SessionIDs []string, Expires time.Time) error {
+	if len(SessionIDs) == 0 {
+		return nil
+	}
+
+	docs := make([]interface{}, len(SessionIDs))
+	for i, ID := range SessionIDs {
+		docs[i] = &revocation{
+			SessionID:   ID,
+			TimeExpires: Expires,
+		}
+	}
+	return revocationCollection().Insert(docs...)
+}
+
+// IsRevoked checks whether the token with the given jti, or the session it was issued to, has been revoked
+func IsRevoked(JTI, SessionID string) (bool, error) {
+	or := []bson.M{}
+	if JTI != "" {
+		or = append(or, bson.M{"jti": JTI})
+	}
+	if SessionID != "" {
+		or = append(or, bson.M{"session_id": SessionID})
+	}
+	if len(or) == 0 {
+		return false, nil
+	}
+
+	count, err := revocationCollection().Find(bson.M{
+		"$or":          or,
+		"time_expires": bson.M{"$gt": time.Now()},
+	}).Count()
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
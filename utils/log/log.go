@@ -46,3 +46,9 @@ func Critical(ctx context.Context, format string, data ...interface{}) {
 		baseLog.Println("Invalid logger for message:", format)
 	}
 }
+
+// Detach returns a context with the logger of ctx that isn't cancelled with it, for work that carries on after the
+// response has been sent
+func Detach(ctx context.Context) context.Context {
+	return goa.WithLogger(context.Background(), goa.ContextLogger(ctx))
+}
@@ -0,0 +1,48 @@
+package password
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// BreachedList reports whether a password has appeared in a data breach
+type BreachedList interface {
+	Contains(password string) (bool, error)
+}
+
+// RangeDir is a directory of SHA-1 range files in the format served by the Pwned Passwords k-anonymity
+// API. Each file is named after the first 5 hex characters of the hashes in it, like 21BD1.txt, and each
+// line is the other 35 characters of a hash followed by a colon and how many times it has been seen. Only
+// the file for the password's prefix is read, so the full list never has to be loaded into memory.
+type RangeDir string
+
+func (d RangeDir) Contains(password string) (bool, error) {
+	sum := sha1.Sum([]byte(password))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+
+	f, err := os.Open(filepath.Join(string(d), hash[:5]+".txt"))
+	if os.IsNotExist(err) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	defer f.Close()
+
+	suffix := hash[5:]
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if i := strings.IndexByte(line, ':'); i >= 0 {
+			line = line[:i]
+		}
+		if strings.EqualFold(line, suffix) {
+			return true, nil
+		}
+	}
+
+	return false, scanner.Err()
+}
@@ -0,0 +1,152 @@
+package password
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Rules that a password can break
+const (
+	RuleLength   = "length"
+	RuleClasses  = "character-classes"
+	RuleSimilar  = "similar-to-account"
+	RuleHistory  = "reused"
+	RuleBreached = "breached"
+)
+
+// minSimilarLength is the shortest part of an email or name that a password is checked for
+const minSimilarLength = 3
+
+// Violation is a rule that a password broke
+type Violation struct {
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+}
+
+// Policy is the rules that new passwords have to follow
+type Policy struct {
+	// MinLength is the minimum number of characters
+	MinLength int
+	// MinClasses is how many of lowercase letters, uppercase letters, numbers and symbols have to be used
+	MinClasses int
+	// History is how many previous passwords cannot be reused
+	History int
+	// Verify checks a password against one of the hashes in the history, the history is skipped when nil
+	Verify func(password, hash string) (bool, error)
+	// Breached is checked for passwords that have appeared in data breaches, it is skipped when nil
+	Breached BreachedList
+}
+
+// Default is used by Check, it is replaced with the configured policy in main
+var Default = &Policy{MinLength: 8, MinClasses: 2, History: 5}
+
+// Check checks password against Default
+func Check(password string, history []string, personal ...string) ([]Violation, error) {
+	return Default.Check(password, history, personal...)
+}
+
+// Check returns the rules that password breaks. history is the hashes of the account's previous
+// passwords, newest first, and personal is details like the account's email and names that the password
+// should not contain.
+func (p *Policy) Check(password string, history []string, personal ...string) ([]Violation, error) {
+	var violations []Violation
+
+	if utf8.RuneCountInString(password) < p.MinLength {
+		violations = append(violations, Violation{RuleLength, fmt.Sprintf("Must be at least %d characters long", p.MinLength)})
+	}
+
+	if characterClasses(password) < p.MinClasses {
+		violations = append(violations, Violation{RuleClasses, fmt.Sprintf("Must use at least %d of lowercase letters, uppercase letters, numbers and symbols", p.MinClasses)})
+	}
+
+	if similar(password, personal) {
+		violations = append(violations, Violation{RuleSimilar, "Must not contain your name or email address"})
+	}
+
+	if p.Verify != nil {
+		found, err := reused(password, history, p.History, p.Verify)
+		if err != nil {
+			return nil, err
+		}
+		if found {
+			violations = append(violations, Violation{RuleHistory, fmt.Sprintf("Must not be one of your last %d passwords", p.History)})
+		}
+	}
+
+	if p.Breached != nil {
+		found, err := p.Breached.Contains(password)
+		if err != nil {
+			return nil, err
+		}
+		if found {
+			violations = append(violations, Violation{RuleBreached, "Has appeared in a data breach, choose a different password"})
+		}
+	}
+
+	return violations, nil
+}
+
+// characterClasses counts how many of lowercase letters, uppercase letters, numbers and symbols are in password
+func characterClasses(password string) int {
+	var lower, upper, digit, symbol int
+
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			upper = 1
+		case unicode.IsLetter(r):
+			lower = 1
+		case unicode.IsDigit(r):
+			digit = 1
+		default:
+			symbol = 1
+		}
+	}
+
+	return lower + upper + digit + symbol
+}
+
+// similar reports whether password contains any word from personal, emails are split into the words of their
+// local part
+func similar(password string, personal []string) bool {
+	password = strings.ToLower(password)
+
+	for _, info := range personal {
+		info = strings.ToLower(info)
+		if i := strings.LastIndex(info, "@"); i >= 0 {
+			info = info[:i]
+		}
+
+		words := strings.FieldsFunc(info, func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		})
+		for _, word := range words {
+			if utf8.RuneCountInString(word) >= minSimilarLength && strings.Contains(password, word) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// reused reports whether password matches any of the newest n hashes in history
+func reused(password string, history []string, n int, verify func(password, hash string) (bool, error)) (bool, error) {
+	for i, hash := range history {
+		if i >= n {
+			break
+		}
+		if hash == "" {
+			continue
+		}
+		if ok, err := verify(password, hash); err != nil {
+			return false, err
+		} else if ok {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
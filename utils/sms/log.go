@@ -0,0 +1,43 @@
+package sms
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"time"
+)
+
+// LogSender writes text messages to a file instead of sending them, for development and tests
+type LogSender struct {
+	path string
+	mu   sync.Mutex
+}
+
+// NewLogSender creates a LogSender that appends to the file at path, or writes to the standard
+// logger if path is empty
+func NewLogSender(path string) *LogSender {
+	return &LogSender{path: path}
+}
+
+func (s *LogSender) Send(to, body string) error {
+	if s.path == "" {
+		log.Printf("SMS to %s: %s", to, body)
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+
+	if _, err := fmt.Fprintf(f, "%s\t%s\t%s\n", time.Now().Format(time.RFC3339), to, body); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
@@ -0,0 +1,14 @@
+package sms
+
+// SMSSender sends a text message to a phone number in E.164 format
+type SMSSender interface {
+	Send(to, body string) error
+}
+
+// Sender is used by SendSMS, it logs messages until a real sender is set up in main
+var Sender SMSSender = NewLogSender("")
+
+// SendSMS sends a text message using Sender
+func SendSMS(to, body string) error {
+	return Sender.Send(to, body)
+}
@@ -0,0 +1,72 @@
+package sms
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const twilioBaseURL = "https://api.twilio.com"
+
+// TwilioSender sends text messages with the Twilio messages API, or any API that accepts the same requests
+type TwilioSender struct {
+	AccountSID string
+	AuthToken  string
+	From       string
+	// BaseURL defaults to the Twilio API
+	BaseURL string
+	Client  *http.Client
+}
+
+// NewTwilioSender creates a TwilioSender that sends from the given number
+func NewTwilioSender(accountSID, authToken, from string) *TwilioSender {
+	return &TwilioSender{
+		AccountSID: accountSID,
+		AuthToken:  authToken,
+		From:       from,
+		BaseURL:    twilioBaseURL,
+		Client:     &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (s *TwilioSender) Send(to, body string) error {
+	form := url.Values{}
+	form.Set("To", to)
+	form.Set("From", s.From)
+	form.Set("Body", body)
+
+	endpoint := s.BaseURL + "/2010-04-01/Accounts/" + url.PathEscape(s.AccountSID) + "/Messages.json"
+	req, err := http.NewRequest("POST", endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(s.AccountSID, s.AuthToken)
+
+	client := s.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		var apiErr struct {
+			Code    int    `json:"code"`
+			Message string `json:"message"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&apiErr); err != nil || apiErr.Message == "" {
+			return fmt.Errorf("sms: sending failed with status %d", resp.StatusCode)
+		}
+		return fmt.Errorf("sms: sending failed with status %d: %s (code %d)", resp.StatusCode, apiErr.Message, apiErr.Code)
+	}
+
+	return nil
+}